@@ -0,0 +1,144 @@
+package application
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	customerApplication "flamingo.me/flamingo-commerce/v3/customer/application"
+	customerDomain "flamingo.me/flamingo-commerce/v3/customer/domain"
+)
+
+type (
+	// AddressBookService connects the cart addresses with the address book of the logged in customer
+	AddressBookService struct {
+		cartService         *CartService
+		cartReceiverService *CartReceiverService
+		customerService     *customerApplication.Service
+		logger              flamingo.Logger
+	}
+)
+
+// Inject dependencies
+func (s *AddressBookService) Inject(
+	cartService *CartService,
+	cartReceiverService *CartReceiverService,
+	customerService *customerApplication.Service,
+	logger flamingo.Logger,
+) *AddressBookService {
+	s.cartService = cartService
+	s.cartReceiverService = cartReceiverService
+	s.customerService = customerService
+	s.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "addressbook")
+
+	return s
+}
+
+// PrefillCustomerAddresses sets the default billing and shipping address of the logged in customer
+// for the billing address and all address based deliveries of the cart that don't have an address yet
+func (s *AddressBookService) PrefillCustomerAddresses(ctx context.Context, request *web.Request) error {
+	customer, err := s.customerService.GetForIdentity(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	cart, err := s.cartReceiverService.ViewCart(ctx, request.Session())
+	if err != nil {
+		return err
+	}
+
+	if cart.BillingAddress == nil {
+		if defaultBillingAddress := customer.GetDefaultBillingAddress(); defaultBillingAddress != nil {
+			billingAddress := MapCustomerAddressToCartAddress(*defaultBillingAddress)
+			err = s.cartService.UpdateBillingAddress(ctx, request.Session(), &billingAddress)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	defaultShippingAddress := customer.GetDefaultShippingAddress()
+	if defaultShippingAddress == nil {
+		return nil
+	}
+
+	for _, delivery := range cart.Deliveries {
+		deliveryInfo := delivery.DeliveryInfo
+		if deliveryInfo.DeliveryLocation.Address != nil || deliveryInfo.DeliveryLocation.UseBillingAddress {
+			continue
+		}
+		if deliveryInfo.Workflow != "" && deliveryInfo.Workflow != cartDomain.DeliveryWorkflowDelivery {
+			continue
+		}
+
+		shippingAddress := MapCustomerAddressToCartAddress(*defaultShippingAddress)
+		deliveryInfo.DeliveryLocation.Address = &shippingAddress
+		err = s.cartService.UpdateDeliveryInfo(ctx, request.Session(), deliveryInfo.Code, cartDomain.CreateDeliveryInfoUpdateCommand(deliveryInfo))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetAddressFromAddressBook returns the address with the given reference from the address book of the logged in customer
+func (s *AddressBookService) GetAddressFromAddressBook(ctx context.Context, request *web.Request, addressID string) (*cartDomain.Address, error) {
+	customerAddress, err := s.customerService.GetAddressForIdentity(ctx, request, addressID)
+	if err != nil {
+		return nil, err
+	}
+
+	address := MapCustomerAddressToCartAddress(*customerAddress)
+	return &address, nil
+}
+
+// SaveToAddressBook stores the cart address in the address book of the logged in customer
+func (s *AddressBookService) SaveToAddressBook(ctx context.Context, request *web.Request, address cartDomain.Address) (*cartDomain.Address, error) {
+	customerAddress, err := s.customerService.AddAddressForIdentity(ctx, request, MapCartAddressToCustomerAddress(address))
+	if err != nil {
+		s.logger.WithContext(ctx).Error("address could not be saved to address book: ", err)
+		return nil, err
+	}
+
+	savedAddress := MapCustomerAddressToCartAddress(*customerAddress)
+	return &savedAddress, nil
+}
+
+// MapCustomerAddressToCartAddress converts an address of the customer address book to a cart address
+func MapCustomerAddressToCartAddress(address customerDomain.Address) cartDomain.Address {
+	return cartDomain.Address{
+		Firstname:              address.Firstname,
+		Lastname:               address.Lastname,
+		Company:                address.Company,
+		Street:                 address.Street,
+		StreetNr:               address.StreetNr,
+		AdditionalAddressLines: address.AdditionalAddressLines,
+		PostCode:               address.PostCode,
+		City:                   address.City,
+		RegionCode:             address.RegionCode,
+		CountryCode:            address.CountryCode,
+		Telephone:              address.Telephone,
+		Email:                  address.Email,
+	}
+}
+
+// MapCartAddressToCustomerAddress converts a cart address to an address of the customer address book
+func MapCartAddressToCustomerAddress(address cartDomain.Address) customerDomain.Address {
+	return customerDomain.Address{
+		Firstname:              address.Firstname,
+		Lastname:               address.Lastname,
+		Company:                address.Company,
+		Street:                 address.Street,
+		StreetNr:               address.StreetNr,
+		AdditionalAddressLines: address.AdditionalAddressLines,
+		PostCode:               address.PostCode,
+		City:                   address.City,
+		RegionCode:             address.RegionCode,
+		CountryCode:            address.CountryCode,
+		Telephone:              address.Telephone,
+		Email:                  address.Email,
+	}
+}
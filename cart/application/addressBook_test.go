@@ -0,0 +1,240 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	authMock "flamingo.me/flamingo/v3/core/auth/mock"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cartApplication "flamingo.me/flamingo-commerce/v3/cart/application"
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart/mocks"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
+	customerApplication "flamingo.me/flamingo-commerce/v3/customer/application"
+	customerDomain "flamingo.me/flamingo-commerce/v3/customer/domain"
+)
+
+type (
+	mockAddressBookCustomer struct {
+		addresses       []customerDomain.Address
+		defaultBilling  *customerDomain.Address
+		defaultShipping *customerDomain.Address
+	}
+
+	mockCustomerIdentityService struct {
+		customer customerDomain.Customer
+	}
+
+	mockCustomerAddressBookService struct {
+		added []customerDomain.Address
+	}
+)
+
+func (m *mockAddressBookCustomer) GetID() string {
+	return "customer"
+}
+
+func (m *mockAddressBookCustomer) GetPersonalData() customerDomain.PersonData {
+	return customerDomain.PersonData{}
+}
+
+func (m *mockAddressBookCustomer) GetAddresses() []customerDomain.Address {
+	return m.addresses
+}
+
+func (m *mockAddressBookCustomer) GetDefaultShippingAddress() *customerDomain.Address {
+	return m.defaultShipping
+}
+
+func (m *mockAddressBookCustomer) GetDefaultBillingAddress() *customerDomain.Address {
+	return m.defaultBilling
+}
+
+func (m *mockCustomerIdentityService) GetByIdentity(context.Context, auth.Identity) (customerDomain.Customer, error) {
+	return m.customer, nil
+}
+
+func (m *mockCustomerAddressBookService) AddAddress(_ context.Context, _ auth.Identity, address customerDomain.Address) (*customerDomain.Address, error) {
+	address.ID = "new"
+	m.added = append(m.added, address)
+	return &address, nil
+}
+
+// provideAddressBookService returns the service for a logged in customer with the given customer cart
+func provideAddressBookService(t *testing.T, loggedIn bool, customer customerDomain.Customer, cart *cartDomain.Cart, behaviour cartDomain.ModifyBehaviour, addressBook customerDomain.CustomerAddressBookService) *cartApplication.AddressBookService {
+	t.Helper()
+
+	identifier := new(authMock.Identifier).SetIdentifyMethod(
+		func(identifier *authMock.Identifier, ctx context.Context, request *web.Request) (auth.Identity, error) {
+			if !loggedIn {
+				return nil, errors.New("not logged in")
+			}
+			return &authMock.Identity{Sub: "customer"}, nil
+		},
+	)
+	webIdentityService := new(auth.WebIdentityService).Inject([]auth.RequestIdentifier{identifier}, nil, nil, nil)
+
+	customerCartService := new(mocks.CustomerCartService)
+	customerCartService.On("GetCart", mock.Anything, mock.Anything, "me").Return(cart, nil)
+	customerCartService.On("GetModifyBehaviour", mock.Anything, mock.Anything).Return(behaviour, nil)
+
+	cartReceiverService := new(cartApplication.CartReceiverService)
+	cartReceiverService.Inject(
+		new(MockGuestCartServiceAdapter),
+		customerCartService,
+		new(decorator.DecoratedCartFactory),
+		webIdentityService,
+		flamingo.NullLogger{},
+		new(MockEventRouter),
+		nil,
+	)
+
+	cartService := new(cartApplication.CartService)
+	cartService.Inject(
+		cartReceiverService,
+		new(MockProductService),
+		new(MockEventPublisher),
+		new(MockEventRouter),
+		new(MockDeliveryInfoBuilder),
+		nil,
+		webIdentityService,
+		flamingo.NullLogger{},
+		nil,
+		nil,
+	)
+
+	customerService := new(customerApplication.Service).Inject(
+		webIdentityService,
+		&mockCustomerIdentityService{customer: customer},
+		&struct {
+			CustomerAddressBookService customerDomain.CustomerAddressBookService `inject:",optional"`
+		}{
+			CustomerAddressBookService: addressBook,
+		},
+	)
+
+	return new(cartApplication.AddressBookService).Inject(cartService, cartReceiverService, customerService, flamingo.NullLogger{})
+}
+
+func TestAddressBookService_PrefillCustomerAddresses(t *testing.T) {
+	customer := &mockAddressBookCustomer{
+		defaultBilling:  &customerDomain.Address{ID: "billing", Firstname: "Billing", City: "Munich"},
+		defaultShipping: &customerDomain.Address{ID: "shipping", Firstname: "Shipping", City: "Berlin"},
+	}
+
+	t.Run("addresses are prefilled", func(t *testing.T) {
+		cart := &cartDomain.Cart{
+			ID: "customer_cart",
+			Deliveries: []cartDomain.Delivery{
+				{DeliveryInfo: cartDomain.DeliveryInfo{Code: "home", Workflow: cartDomain.DeliveryWorkflowDelivery}},
+				{DeliveryInfo: cartDomain.DeliveryInfo{Code: "unknown"}},
+			},
+		}
+
+		behaviour := new(mocks.ModifyBehaviour)
+		behaviour.On("UpdateBillingAddress", mock.Anything, cart, cartDomain.Address{Firstname: "Billing", City: "Munich"}).Return(cart, nil, nil).Once()
+		behaviour.On("UpdateDeliveryInfo", mock.Anything, cart, "home", mock.MatchedBy(func(command cartDomain.DeliveryInfoUpdateCommand) bool {
+			return command.DeliveryInfo.DeliveryLocation.Address.Firstname == "Shipping"
+		})).Return(cart, nil, nil).Once()
+		behaviour.On("UpdateDeliveryInfo", mock.Anything, cart, "unknown", mock.MatchedBy(func(command cartDomain.DeliveryInfoUpdateCommand) bool {
+			return command.DeliveryInfo.DeliveryLocation.Address.City == "Berlin"
+		})).Return(cart, nil, nil).Once()
+
+		service := provideAddressBookService(t, true, customer, cart, behaviour, nil)
+		session := web.EmptySession()
+		request := web.CreateRequest(nil, session)
+
+		err := service.PrefillCustomerAddresses(web.ContextWithRequest(context.Background(), request), request)
+		require.NoError(t, err)
+		behaviour.AssertExpectations(t)
+	})
+
+	t.Run("existing addresses and pickup deliveries are kept", func(t *testing.T) {
+		cart := &cartDomain.Cart{
+			ID:             "customer_cart",
+			BillingAddress: &cartDomain.Address{Firstname: "Existing"},
+			Deliveries: []cartDomain.Delivery{
+				{DeliveryInfo: cartDomain.DeliveryInfo{Code: "home", DeliveryLocation: cartDomain.DeliveryLocation{Address: &cartDomain.Address{Firstname: "Existing"}}}},
+				{DeliveryInfo: cartDomain.DeliveryInfo{Code: "billing", DeliveryLocation: cartDomain.DeliveryLocation{UseBillingAddress: true}}},
+				{DeliveryInfo: cartDomain.DeliveryInfo{Code: "store", Workflow: cartDomain.DeliveryWorkflowPickup}},
+			},
+		}
+
+		behaviour := new(mocks.ModifyBehaviour)
+		service := provideAddressBookService(t, true, customer, cart, behaviour, nil)
+		session := web.EmptySession()
+		request := web.CreateRequest(nil, session)
+
+		err := service.PrefillCustomerAddresses(web.ContextWithRequest(context.Background(), request), request)
+		require.NoError(t, err)
+		behaviour.AssertNotCalled(t, "UpdateBillingAddress", mock.Anything, mock.Anything, mock.Anything)
+		behaviour.AssertNotCalled(t, "UpdateDeliveryInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("no identity", func(t *testing.T) {
+		service := provideAddressBookService(t, false, customer, nil, nil, nil)
+		request := web.CreateRequest(nil, web.EmptySession())
+
+		err := service.PrefillCustomerAddresses(web.ContextWithRequest(context.Background(), request), request)
+		assert.Equal(t, customerApplication.ErrNoIdentity, err)
+	})
+}
+
+func TestAddressBookService_GetAddressFromAddressBook(t *testing.T) {
+	customer := &mockAddressBookCustomer{
+		addresses: []customerDomain.Address{
+			{ID: "home", Firstname: "Home", CountryCode: "DE"},
+			{ID: "office", Firstname: "Office", CountryCode: "AT"},
+		},
+	}
+	service := provideAddressBookService(t, true, customer, nil, nil, nil)
+	request := web.CreateRequest(nil, web.EmptySession())
+	ctx := web.ContextWithRequest(context.Background(), request)
+
+	address, err := service.GetAddressFromAddressBook(ctx, request, "office")
+	require.NoError(t, err)
+	assert.Equal(t, &cartDomain.Address{Firstname: "Office", CountryCode: "AT"}, address)
+
+	_, err = service.GetAddressFromAddressBook(ctx, request, "unknown")
+	assert.Equal(t, customerDomain.ErrAddressNotFound, err)
+
+	service = provideAddressBookService(t, false, customer, nil, nil, nil)
+	_, err = service.GetAddressFromAddressBook(ctx, request, "office")
+	assert.Equal(t, customerApplication.ErrNoIdentity, err)
+}
+
+func TestAddressBookService_SaveToAddressBook(t *testing.T) {
+	request := web.CreateRequest(nil, web.EmptySession())
+	ctx := web.ContextWithRequest(context.Background(), request)
+
+	t.Run("address is stored", func(t *testing.T) {
+		addressBook := new(mockCustomerAddressBookService)
+		service := provideAddressBookService(t, true, &mockAddressBookCustomer{}, nil, nil, addressBook)
+
+		saved, err := service.SaveToAddressBook(ctx, request, cartDomain.Address{Firstname: "New", City: "Hamburg"})
+		require.NoError(t, err)
+		assert.Equal(t, &cartDomain.Address{Firstname: "New", City: "Hamburg"}, saved)
+		assert.Equal(t, []customerDomain.Address{{ID: "new", Firstname: "New", City: "Hamburg"}}, addressBook.added)
+	})
+
+	t.Run("no address book bound", func(t *testing.T) {
+		service := provideAddressBookService(t, true, &mockAddressBookCustomer{}, nil, nil, nil)
+
+		_, err := service.SaveToAddressBook(ctx, request, cartDomain.Address{Firstname: "New"})
+		assert.Equal(t, customerApplication.ErrNoAddressBook, err)
+	})
+
+	t.Run("no identity", func(t *testing.T) {
+		service := provideAddressBookService(t, false, &mockAddressBookCustomer{}, nil, nil, new(mockCustomerAddressBookService))
+
+		_, err := service.SaveToAddressBook(ctx, request, cartDomain.Address{Firstname: "New"})
+		assert.Equal(t, customerApplication.ErrNoIdentity, err)
+	})
+}
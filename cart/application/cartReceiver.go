@@ -32,11 +32,17 @@ type (
 var (
 	//ErrTemporaryCartService - should be returned if it is likely that the backend service will return a cart on a next try
 	ErrTemporaryCartService = errors.New("the cart could not be received currently - try again later")
+	// ErrExpressCartNotSupported is returned if the customer cart service can't create additional carts of a customer
+	ErrExpressCartNotSupported = errors.New("the customer cart service does not support express carts")
 )
 
+type contextKeyTyp string
+
 const (
 	// GuestCartSessionKey is a prefix
 	GuestCartSessionKey = "cart.guestid"
+
+	expressCartKey contextKeyTyp = "cart.expressid"
 )
 
 // Inject the dependencies
@@ -88,6 +94,10 @@ func (cs *CartReceiverService) RestoreCart(ctx context.Context, session *web.Ses
 
 // ShouldHaveCart - checks if there should be a cart. Indicated if a call to GetCart should return a real cart
 func (cs *CartReceiverService) ShouldHaveCart(ctx context.Context, session *web.Session) bool {
+	if _, ok := ExpressCartIDFromContext(ctx); ok {
+		return true
+	}
+
 	if cs.webIdentityService.Identify(ctx, web.RequestFromContext(ctx)) != nil {
 		return true
 	}
@@ -101,6 +111,48 @@ func (cs *CartReceiverService) ShouldHaveGuestCart(session *web.Session) bool {
 	return ok
 }
 
+// ContextWithExpressCart returns a context in which the express cart replaces the cart of the session,
+// the guest or customer cart of the session is not touched by calls with this context
+func ContextWithExpressCart(ctx context.Context, expressCartID string) context.Context {
+	return context.WithValue(ctx, expressCartKey, expressCartID)
+}
+
+// ExpressCartIDFromContext returns the id of the express cart of the context, if there is one
+func ExpressCartIDFromContext(ctx context.Context) (string, bool) {
+	expressCartID, ok := ctx.Value(expressCartKey).(string)
+	return expressCartID, ok && expressCartID != ""
+}
+
+// NewExpressCart creates a new cart next to the cart of the session, a customer cart for authenticated users and a guest cart otherwise.
+// The session doesn't know the express cart, use ContextWithExpressCart to work on it
+func (cs *CartReceiverService) NewExpressCart(ctx context.Context) (*cartDomain.Cart, error) {
+	identity := cs.webIdentityService.Identify(ctx, web.RequestFromContext(ctx))
+	if identity == nil {
+		expressCart, err := cs.guestCartService.GetNewCart(ctx)
+		if err != nil {
+			cs.logger.WithContext(ctx).Error("cart.application.cartservice: Cannot create a new express cart. Error %s", err)
+
+			return nil, err
+		}
+
+		return expressCart, nil
+	}
+
+	additionalCartService, ok := cs.customerCartService.(cartDomain.AdditionalCustomerCartService)
+	if !ok {
+		return nil, ErrExpressCartNotSupported
+	}
+
+	expressCart, err := additionalCartService.GetNewCart(ctx, identity)
+	if err != nil {
+		cs.logger.WithContext(ctx).Error("cart.application.cartservice: Cannot create a new express cart. Error %s", err)
+
+		return nil, err
+	}
+
+	return expressCart, nil
+}
+
 // ViewDecoratedCart  return a Cart for view
 func (cs *CartReceiverService) ViewDecoratedCart(ctx context.Context, session *web.Session) (*decorator.DecoratedCart, error) {
 	cart, err := cs.ViewCart(ctx, session)
@@ -140,6 +192,11 @@ func (cs *CartReceiverService) storeCartInCacheIfCacheIsEnabled(ctx context.Cont
 		return errors.New("no cache")
 	}
 
+	// the cache identifier belongs to the cart of the session, express carts are never cached
+	if _, ok := ExpressCartIDFromContext(ctx); ok {
+		return nil
+	}
+
 	id, err := cs.cartCache.BuildIdentifier(ctx, session)
 	if err != nil {
 		return err
@@ -150,6 +207,9 @@ func (cs *CartReceiverService) storeCartInCacheIfCacheIsEnabled(ctx context.Cont
 
 // GetCart Get the correct Cart (either Guest or User)
 func (cs *CartReceiverService) GetCart(ctx context.Context, session *web.Session) (*cartDomain.Cart, cartDomain.ModifyBehaviour, error) {
+	if expressCartID, ok := ExpressCartIDFromContext(ctx); ok {
+		return cs.getExpressCart(ctx, expressCartID)
+	}
 	if cs.webIdentityService.Identify(ctx, web.RequestFromContext(ctx)) != nil {
 		return cs.getCustomerCart(ctx, session)
 	}
@@ -264,6 +324,36 @@ func (cs *CartReceiverService) getExistingGuestCart(ctx context.Context, session
 	return cart, behaviour, nil
 }
 
+// getExpressCart returns the express cart with the customer behaviour for authenticated users and the guest behaviour otherwise
+func (cs *CartReceiverService) getExpressCart(ctx context.Context, expressCartID string) (*cartDomain.Cart, cartDomain.ModifyBehaviour, error) {
+	identity := cs.webIdentityService.Identify(ctx, web.RequestFromContext(ctx))
+	if identity != nil {
+		expressCart, err := cs.customerCartService.GetCart(ctx, identity, expressCartID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		behaviour, err := cs.customerCartService.GetModifyBehaviour(ctx, identity)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return expressCart, behaviour, nil
+	}
+
+	expressCart, err := cs.guestCartService.GetCart(ctx, expressCartID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	behaviour, err := cs.guestCartService.GetModifyBehaviour(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return expressCart, behaviour, nil
+}
+
 // getNewGuestCart
 func (cs *CartReceiverService) getNewGuestCart(ctx context.Context, session *web.Session) (*cartDomain.Cart, cartDomain.ModifyBehaviour, error) {
 	guestCart, err := cs.guestCartService.GetNewCart(ctx)
@@ -298,6 +388,11 @@ func (cs *CartReceiverService) GetCartWithoutCache(ctx context.Context, session
 		cs.eventRouter.Dispatch(ctx, &cart.InvalidateCartEvent{Session: session})
 	}
 
+	if expressCartID, ok := ExpressCartIDFromContext(ctx); ok {
+		expressCart, _, err := cs.getExpressCart(ctx, expressCartID)
+		return expressCart, err
+	}
+
 	identitiy := cs.webIdentityService.Identify(ctx, web.RequestFromContext(ctx))
 	if identitiy != nil {
 		return cs.customerCartService.GetCart(ctx, identitiy, "me")
@@ -236,6 +236,7 @@ func TestCartReceiverService_ShouldHaveGuestCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -257,6 +258,7 @@ func TestCartReceiverService_ShouldHaveGuestCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -328,6 +330,7 @@ func TestCartReceiverService_ViewGuestCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -352,6 +355,7 @@ func TestCartReceiverService_ViewGuestCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -376,6 +380,7 @@ func TestCartReceiverService_ViewGuestCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -463,6 +468,7 @@ func TestCartReceiverService_DecorateCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -487,6 +493,7 @@ func TestCartReceiverService_DecorateCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -587,6 +594,7 @@ func TestCartReceiverService_GetDecoratedCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -611,6 +619,7 @@ func TestCartReceiverService_GetDecoratedCart(t *testing.T) {
 					result.Inject(
 						&MockProductService{},
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -934,3 +943,106 @@ func TestCartReceiverService_ModifyBehaviour(t *testing.T) {
 		assert.Same(t, behaviour, mockBehaviour)
 	})
 }
+
+func TestCartReceiverService_ExpressCart(t *testing.T) {
+	newCartReceiverService := func(identify bool, cartCache cartApplication.CartCache) *cartApplication.CartReceiverService {
+		behaviour := &cartInfrastructure.DefaultCartBehaviour{}
+		behaviour.Inject(&cartInfrastructure.InMemoryCartStorage{}, nil, flamingo.NullLogger{}, nil, nil, nil, nil, nil, nil, nil)
+		guestCartService := &cartInfrastructure.DefaultGuestCartService{}
+		guestCartService.Inject(behaviour, flamingo.NullLogger{})
+		customerCartService := &cartInfrastructure.DefaultCustomerCartService{}
+		customerCartService.Inject(behaviour, flamingo.NullLogger{})
+
+		webIdentityService := &auth.WebIdentityService{}
+		if identify {
+			mockIdentifier := new(authMock.Identifier).SetIdentifyMethod(
+				func(identifier *authMock.Identifier, ctx context.Context, request *web.Request) (auth.Identity, error) {
+					return &authMock.Identity{Sub: "foo"}, nil
+				},
+			)
+			webIdentityService.Inject([]auth.RequestIdentifier{mockIdentifier}, nil, nil, nil)
+		}
+
+		cs := &cartApplication.CartReceiverService{}
+		cs.Inject(
+			guestCartService,
+			customerCartService,
+			&decorator.DecoratedCartFactory{},
+			webIdentityService,
+			flamingo.NullLogger{},
+			nil,
+			&struct {
+				CartCache cartApplication.CartCache `inject:",optional"`
+			}{
+				CartCache: cartCache,
+			},
+		)
+
+		return cs
+	}
+
+	t.Run("guest cart for guests", func(t *testing.T) {
+		cartCache := &MockCartCache{}
+		cs := newCartReceiverService(false, cartCache)
+		session := web.EmptySession()
+		ctx := web.ContextWithSession(context.Background(), session)
+
+		expressCart, err := cs.NewExpressCart(ctx)
+		require.NoError(t, err)
+		assert.False(t, expressCart.BelongsToAuthenticatedUser)
+		assert.False(t, cs.ShouldHaveCart(ctx, session), "the session doesn't know the express cart")
+
+		expressCtx := cartApplication.ContextWithExpressCart(ctx, expressCart.ID)
+		assert.True(t, cs.ShouldHaveCart(expressCtx, session))
+
+		got, _, err := cs.GetCart(expressCtx, session)
+		require.NoError(t, err)
+		assert.Equal(t, expressCart.ID, got.ID)
+		assert.Nil(t, cartCache.CachedCart, "express carts must not be cached")
+		assert.False(t, cs.ShouldHaveGuestCart(session))
+
+		got, _, err = cs.GetCart(ctx, session)
+		require.NoError(t, err)
+		assert.NotEqual(t, expressCart.ID, got.ID, "the cart of the session is used outside of the express context")
+	})
+
+	t.Run("customer cart for authenticated users", func(t *testing.T) {
+		cs := newCartReceiverService(true, nil)
+		session := web.EmptySession()
+		ctx := web.ContextWithSession(context.Background(), session)
+
+		expressCart, err := cs.NewExpressCart(ctx)
+		require.NoError(t, err)
+		assert.True(t, expressCart.BelongsToAuthenticatedUser)
+		assert.Equal(t, "foo", expressCart.AuthenticatedUserID)
+
+		got, _, err := cs.GetCart(cartApplication.ContextWithExpressCart(ctx, expressCart.ID), session)
+		require.NoError(t, err)
+		assert.Equal(t, expressCart.ID, got.ID)
+
+		got, _, err = cs.GetCart(ctx, session)
+		require.NoError(t, err)
+		assert.Equal(t, "foo", got.ID, "the main cart of the customer stays untouched")
+	})
+
+	t.Run("customer cart service without additional carts", func(t *testing.T) {
+		mockIdentifier := new(authMock.Identifier).SetIdentifyMethod(
+			func(identifier *authMock.Identifier, ctx context.Context, request *web.Request) (auth.Identity, error) {
+				return &authMock.Identity{Sub: "foo"}, nil
+			},
+		)
+		cs := &cartApplication.CartReceiverService{}
+		cs.Inject(
+			&MockGuestCartServiceAdapter{},
+			&MockCustomerCartService{},
+			&decorator.DecoratedCartFactory{},
+			new(auth.WebIdentityService).Inject([]auth.RequestIdentifier{mockIdentifier}, nil, nil, nil),
+			flamingo.NullLogger{},
+			nil,
+			nil,
+		)
+
+		_, err := cs.NewExpressCart(web.ContextWithSession(context.Background(), web.EmptySession()))
+		assert.Equal(t, cartApplication.ErrExpressCartNotSupported, err)
+	})
+}
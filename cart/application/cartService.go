@@ -12,6 +12,7 @@ import (
 	"flamingo.me/flamingo/v3/framework/web"
 
 	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/coupon"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/events"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/placeorder"
@@ -34,10 +35,12 @@ type (
 		restrictionService  *validation.RestrictionService
 		deleteEmptyDelivery bool
 		// optionals - these may be nil
-		cartValidator     validation.Validator
-		itemValidator     validation.ItemValidator
-		cartCache         CartCache
-		placeOrderService placeorder.Service
+		cartValidator       validation.Validator
+		itemValidator       validation.ItemValidator
+		cartCache           CartCache
+		placeOrderService   placeorder.Service
+		codeAttemptLimiter  *CodeAttemptLimiter
+		couponUsageReserver coupon.UsageReserver
 	}
 
 	// RestrictionError error enriched with result of restrictions
@@ -47,6 +50,12 @@ type (
 		RestrictionResult validation.RestrictionResult
 	}
 
+	// CurrencyMismatchError is returned if a product can not be added since its price is in another currency than the cart
+	CurrencyMismatchError struct {
+		CartCurrency    string
+		ProductCurrency string
+	}
+
 	// QtyAdjustmentResult restriction result enriched with the respective item
 	QtyAdjustmentResult struct {
 		OriginalItem          cartDomain.Item
@@ -66,6 +75,7 @@ type (
 
 func init() {
 	gob.Register(RestrictionError{})
+	gob.Register(CurrencyMismatchError{})
 	gob.Register(QtyAdjustmentResults{})
 }
 
@@ -74,6 +84,16 @@ func (e *RestrictionError) Error() string {
 	return e.message
 }
 
+// Error returns the error message
+func (e *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("product price currency %q does not match the cart currency %q", e.ProductCurrency, e.CartCurrency)
+}
+
+// MessageCode returns a code that can be used to show a translated message
+func (e *CurrencyMismatchError) MessageCode() string {
+	return "currency_mismatch"
+}
+
 // Inject dependencies
 func (cs *CartService) Inject(
 	cartReceiverService *CartReceiverService,
@@ -89,10 +109,12 @@ func (cs *CartService) Inject(
 		DeleteEmptyDelivery bool   `inject:"config:commerce.cart.deleteEmptyDelivery,optional"`
 	},
 	optionals *struct {
-		CartValidator     validation.Validator     `inject:",optional"`
-		ItemValidator     validation.ItemValidator `inject:",optional"`
-		CartCache         CartCache                `inject:",optional"`
-		PlaceOrderService placeorder.Service       `inject:",optional"`
+		CartValidator       validation.Validator     `inject:",optional"`
+		ItemValidator       validation.ItemValidator `inject:",optional"`
+		CartCache           CartCache                `inject:",optional"`
+		PlaceOrderService   placeorder.Service       `inject:",optional"`
+		CodeAttemptLimiter  *CodeAttemptLimiter      `inject:",optional"`
+		CouponUsageReserver coupon.UsageReserver     `inject:",optional"`
 	},
 ) {
 	cs.cartReceiverService = cartReceiverService
@@ -112,6 +134,8 @@ func (cs *CartService) Inject(
 		cs.itemValidator = optionals.ItemValidator
 		cs.cartCache = optionals.CartCache
 		cs.placeOrderService = optionals.PlaceOrderService
+		cs.codeAttemptLimiter = optionals.CodeAttemptLimiter
+		cs.couponUsageReserver = optionals.CouponUsageReserver
 	}
 }
 
@@ -142,6 +166,29 @@ func (cs *CartService) ValidateCurrentCart(ctx context.Context, session *web.Ses
 	return cs.ValidateCart(ctx, session, decoratedCart), nil
 }
 
+// ValidateQtyRestrictions checks the quantity restrictions of all items of the cart, e.g. the purchase limits, right before the order is placed.
+// Items that exceed a restriction are reported with the error message key "qty_restricted"
+func (cs *CartService) ValidateQtyRestrictions(ctx context.Context, session *web.Session, cart *cartDomain.Cart) (validation.Result, error) {
+	if cs.restrictionService == nil || cs.productService == nil || cart == nil {
+		return validation.Result{}, nil
+	}
+
+	qtyAdjustmentResults, err := cs.generateRestrictedQtyAdjustmentsForCart(ctx, session, cart)
+	if err != nil {
+		return validation.Result{}, err
+	}
+
+	result := validation.Result{}
+	for _, qtyAdjustmentResult := range qtyAdjustmentResults {
+		result.ItemResults = append(result.ItemResults, validation.ItemValidationError{
+			ItemID:          qtyAdjustmentResult.OriginalItem.ID,
+			ErrorMessageKey: "qty_restricted",
+		})
+	}
+
+	return result, nil
+}
+
 // UpdatePaymentSelection updates the paymentselection in the cart
 func (cs *CartService) UpdatePaymentSelection(ctx context.Context, session *web.Session, paymentSelection cartDomain.PaymentSelection) error {
 	cart, behaviour, err := cs.cartReceiverService.GetCart(ctx, session)
@@ -157,7 +204,7 @@ func (cs *CartService) UpdatePaymentSelection(ctx context.Context, session *web.
 
 	cart, defers, err = behaviour.UpdatePaymentSelection(ctx, cart, paymentSelection)
 	if err != nil {
-		cs.handleCartNotFound(session, err)
+		cs.handleCartNotFound(ctx, session, err)
 		cs.logger.WithContext(ctx).WithField("subCategory", "UpdatePaymentSelection").Error(err)
 
 		return err
@@ -184,7 +231,7 @@ func (cs *CartService) UpdateBillingAddress(ctx context.Context, session *web.Se
 
 	cart, defers, err = behaviour.UpdateBillingAddress(ctx, cart, *billingAddress)
 	if err != nil {
-		cs.handleCartNotFound(session, err)
+		cs.handleCartNotFound(ctx, session, err)
 		cs.logger.WithContext(ctx).WithField("subCategory", "UpdateBillingAddress").Error(err)
 
 		return err
@@ -212,7 +259,7 @@ func (cs *CartService) UpdateDeliveryInfo(ctx context.Context, session *web.Sess
 
 	cart, defers, err = behaviour.UpdateDeliveryInfo(ctx, cart, deliveryCode, deliveryInfo)
 	if err != nil {
-		cs.handleCartNotFound(session, err)
+		cs.handleCartNotFound(ctx, session, err)
 		cs.logger.WithContext(ctx).WithField("subCategory", "UpdateDeliveryInfo").Error(err)
 
 		return err
@@ -236,7 +283,7 @@ func (cs *CartService) UpdatePurchaser(ctx context.Context, session *web.Session
 
 	cart, defers, err = behaviour.UpdatePurchaser(ctx, cart, purchaser, additionalData)
 	if err != nil {
-		cs.handleCartNotFound(session, err)
+		cs.handleCartNotFound(ctx, session, err)
 		cs.logger.WithContext(ctx).WithField("subCategory", "UpdatePurchaser").Error(err)
 
 		return err
@@ -301,7 +348,7 @@ func (cs *CartService) UpdateItemQty(ctx context.Context, session *web.Session,
 
 	cart, defers, err = behaviour.UpdateItem(ctx, cart, itemUpdate)
 	if err != nil {
-		cs.handleCartNotFound(session, err)
+		cs.handleCartNotFound(ctx, session, err)
 		cs.logger.WithContext(ctx).WithField("subCategory", "UpdateItemQty").Error(err)
 
 		return err
@@ -349,7 +396,7 @@ func (cs *CartService) UpdateItemSourceID(ctx context.Context, session *web.Sess
 
 	cart, defers, err = behaviour.UpdateItem(ctx, cart, itemUpdate)
 	if err != nil {
-		cs.handleCartNotFound(session, err)
+		cs.handleCartNotFound(ctx, session, err)
 		cs.logger.WithContext(ctx).WithField("subCategory", "UpdateItemSourceId").Error(err)
 
 		return err
@@ -381,7 +428,7 @@ func (cs *CartService) UpdateItems(ctx context.Context, session *web.Session, up
 
 	cart, defers, err = behaviour.UpdateItems(ctx, cart, updateCommands)
 	if err != nil {
-		cs.handleCartNotFound(session, err)
+		cs.handleCartNotFound(ctx, session, err)
 		cs.logger.WithContext(ctx).WithField("subCategory", "UpdateItemSourceId").Error(err)
 
 		return err
@@ -419,7 +466,7 @@ func (cs *CartService) DeleteItem(ctx context.Context, session *web.Session, ite
 	qtyBefore := item.Qty
 	cart, defers, err = behaviour.DeleteItem(ctx, cart, itemID, deliveryCode)
 	if err != nil {
-		cs.handleCartNotFound(session, err)
+		cs.handleCartNotFound(ctx, session, err)
 		cs.logger.WithContext(ctx).WithField("subCategory", "DeleteItem").Error(errors.Wrap(err, "Trying to delete SKU :"+item.MarketplaceCode))
 
 		return err
@@ -470,7 +517,7 @@ func (cs *CartService) DeleteAllItems(ctx context.Context, session *web.Session)
 
 			cart, defers, err = behaviour.DeleteItem(ctx, cart, item.ID, delivery.DeliveryInfo.Code)
 			if err != nil {
-				cs.handleCartNotFound(session, err)
+				cs.handleCartNotFound(ctx, session, err)
 				cs.logger.WithContext(ctx).WithField("subCategory", "DeleteAllItems").Error(err)
 
 				return err
@@ -504,7 +551,7 @@ func (cs *CartService) CompleteCurrentCart(ctx context.Context) (*cartDomain.Car
 	var completedCart *cartDomain.Cart
 	completedCart, defers, err = completeBehaviour.Complete(ctx, cart)
 	if err != nil {
-		cs.handleCartNotFound(web.SessionFromContext(ctx), err)
+		cs.handleCartNotFound(ctx, web.SessionFromContext(ctx), err)
 		cs.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "CloseCurrentCart").Error(err)
 
 		return nil, err
@@ -512,7 +559,7 @@ func (cs *CartService) CompleteCurrentCart(ctx context.Context) (*cartDomain.Car
 	cs.DeleteCartInCache(ctx, web.SessionFromContext(ctx), nil)
 
 	session := web.SessionFromContext(ctx)
-	if !cart.BelongsToAuthenticatedUser {
+	if !cart.BelongsToAuthenticatedUser && !isExpressCart(ctx) {
 		session.Delete(GuestCartSessionKey)
 	}
 
@@ -543,13 +590,13 @@ func (cs *CartService) RestoreCart(ctx context.Context, cart *cartDomain.Cart) (
 	restoredCart, defers, err = completeBehaviour.Restore(ctx, cart)
 
 	if err != nil {
-		cs.handleCartNotFound(web.SessionFromContext(ctx), err)
+		cs.handleCartNotFound(ctx, web.SessionFromContext(ctx), err)
 		cs.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "RestoreCart").Error(err)
 
 		return nil, err
 	}
 
-	if !restoredCart.BelongsToAuthenticatedUser {
+	if !restoredCart.BelongsToAuthenticatedUser && !isExpressCart(ctx) {
 		session.Store(GuestCartSessionKey, restoredCart.ID)
 	}
 
@@ -641,6 +688,27 @@ func (cs *CartService) DeleteDelivery(ctx context.Context, session *web.Session,
 	return cart, nil
 }
 
+// DeleteEmptyDeliveries removes all deliveries without items from the current cart, e.g. before the deliveries are validated
+func (cs *CartService) DeleteEmptyDeliveries(ctx context.Context, session *web.Session) (*cartDomain.Cart, error) {
+	cart, err := cs.cartReceiverService.ViewCart(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, delivery := range cart.Deliveries {
+		if len(delivery.Cartitems) > 0 {
+			continue
+		}
+
+		cart, err = cs.DeleteDelivery(ctx, session, delivery.DeliveryInfo.Code)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cart, nil
+}
+
 // BuildAddRequest Helper to build
 func (cs *CartService) BuildAddRequest(_ context.Context, marketplaceCode string, variantMarketplaceCode string, qty int, additionalData map[string]string) cartDomain.AddRequest {
 	if qty < 0 {
@@ -680,7 +748,7 @@ func (cs *CartService) AddProduct(ctx context.Context, session *web.Session, del
 
 	switch err.(type) {
 	case nil:
-	case *validation.AddToCartNotAllowed:
+	case *validation.AddToCartNotAllowed, *CurrencyMismatchError:
 		cs.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "AddProduct").Info(err)
 		return nil, err
 	default:
@@ -706,7 +774,7 @@ func (cs *CartService) AddProduct(ctx context.Context, session *web.Session, del
 
 	cart, defers, err = behaviour.AddToCart(ctx, cart, deliveryCode, addRequest)
 	if err != nil {
-		cs.handleCartNotFound(session, err)
+		cs.handleCartNotFound(ctx, session, err)
 		cs.logger.WithContext(ctx).WithField("subCategory", "AddProduct").Error(err)
 
 		return nil, err
@@ -765,7 +833,7 @@ func (cs *CartService) ApplyVoucher(ctx context.Context, session *web.Session, c
 	if err != nil {
 		return nil, err
 	}
-	return cs.executeVoucherBehaviour(ctx, session, cart, couponCode, behaviour.ApplyVoucher)
+	return cs.executeLimitedVoucherBehaviour(ctx, session, cart, couponCode, behaviour.ApplyVoucher)
 }
 
 // ApplyAny applies a voucher or giftcard to the cart
@@ -775,7 +843,7 @@ func (cs *CartService) ApplyAny(ctx context.Context, session *web.Session, anyCo
 		return nil, err
 	}
 	if giftCardAndVoucherBehaviour, ok := behaviour.(cartDomain.GiftCardAndVoucherBehaviour); ok {
-		return cs.executeVoucherBehaviour(ctx, session, cart, anyCode, giftCardAndVoucherBehaviour.ApplyAny)
+		return cs.executeLimitedVoucherBehaviour(ctx, session, cart, anyCode, giftCardAndVoucherBehaviour.ApplyAny)
 	}
 	return nil, errors.New("ApplyAny not supported")
 }
@@ -796,7 +864,7 @@ func (cs *CartService) ApplyGiftCard(ctx context.Context, session *web.Session,
 		return nil, err
 	}
 	if giftCartBehaviour, ok := behaviour.(cartDomain.GiftCardBehaviour); ok {
-		return cs.executeVoucherBehaviour(ctx, session, cart, couponCode, giftCartBehaviour.ApplyGiftCard)
+		return cs.executeLimitedVoucherBehaviour(ctx, session, cart, couponCode, giftCartBehaviour.ApplyGiftCard)
 	}
 	return nil, errors.New("ApplyGiftCard not supported")
 }
@@ -813,6 +881,66 @@ func (cs *CartService) RemoveGiftCard(ctx context.Context, session *web.Session,
 	return nil, errors.New("RemoveGiftCard not supported")
 }
 
+// UpdateItemGiftWrap sets the gift wrap of a cart item, nil removes the gift wrap
+func (cs *CartService) UpdateItemGiftWrap(ctx context.Context, session *web.Session, itemID string, giftWrap *cartDomain.GiftWrap) error {
+	cart, behaviour, err := cs.getCartAndBehaviour(ctx, session, "UpdateItemGiftWrap")
+	if err != nil {
+		return err
+	}
+
+	giftWrapBehaviour, ok := behaviour.(cartDomain.GiftWrapBehaviour)
+	if !ok {
+		return errors.New("UpdateItemGiftWrap not supported")
+	}
+
+	// cart cache must be updated - with the current value of cart
+	var defers cartDomain.DeferEvents
+	defer func() {
+		cs.updateCartInCacheIfCacheIsEnabled(ctx, session, cart)
+		cs.dispatchAllEvents(ctx, defers)
+	}()
+
+	cart, defers, err = giftWrapBehaviour.UpdateItemGiftWrap(ctx, cart, itemID, giftWrap)
+	if err != nil {
+		cs.handleCartNotFound(ctx, session, err)
+		cs.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "UpdateItemGiftWrap").Error(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// SwitchCurrency re-prices the cart with the prices of the command, see CurrencyService for building the command
+func (cs *CartService) SwitchCurrency(ctx context.Context, session *web.Session, command cartDomain.CurrencySwitchCommand) error {
+	cart, behaviour, err := cs.getCartAndBehaviour(ctx, session, "SwitchCurrency")
+	if err != nil {
+		return err
+	}
+
+	currencyBehaviour, ok := behaviour.(cartDomain.CurrencyBehaviour)
+	if !ok {
+		return errors.New("SwitchCurrency not supported")
+	}
+
+	// cart cache must be updated - with the current value of cart
+	var defers cartDomain.DeferEvents
+	defer func() {
+		cs.updateCartInCacheIfCacheIsEnabled(ctx, session, cart)
+		cs.dispatchAllEvents(ctx, defers)
+	}()
+
+	cart, defers, err = currencyBehaviour.SwitchCurrency(ctx, cart, command)
+	if err != nil {
+		cs.handleCartNotFound(ctx, session, err)
+		cs.logger.WithContext(ctx).WithField(flamingo.LogKeySubCategory, "SwitchCurrency").Error(err)
+
+		return err
+	}
+
+	return nil
+}
+
 // Get current cart from session and corresponding behaviour
 func (cs *CartService) getCartAndBehaviour(ctx context.Context, session *web.Session, logKey string) (*cartDomain.Cart, cartDomain.ModifyBehaviour, error) {
 	cart, behaviour, err := cs.cartReceiverService.GetCart(ctx, session)
@@ -837,10 +965,40 @@ func (cs *CartService) executeVoucherBehaviour(ctx context.Context, session *web
 	return cart, err
 }
 
-func (cs *CartService) handleCartNotFound(session *web.Session, err error) {
-	if err == cartDomain.ErrCartNotFound {
-		_ = cs.DeleteSavedSessionGuestCartID(session)
+// executeLimitedVoucherBehaviour executes the behaviour if the code entry is not blocked, only failed codes stay counted
+func (cs *CartService) executeLimitedVoucherBehaviour(ctx context.Context, session *web.Session, cart *cartDomain.Cart, couponCode string, fn promotionFunc) (*cartDomain.Cart, error) {
+	if !cs.codeAttemptLimiter.IsActive() {
+		return cs.executeVoucherBehaviour(ctx, session, cart, couponCode, fn)
+	}
+
+	keys := cs.codeAttemptLimiter.AttemptKeys(ctx, session, cart.ID)
+	if err := cs.codeAttemptLimiter.Attempt(ctx, keys...); err != nil {
+		return nil, err
+	}
+
+	updatedCart, err := cs.executeVoucherBehaviour(ctx, session, cart, couponCode, fn)
+	if err == nil {
+		if recordErr := cs.codeAttemptLimiter.RecordSuccess(ctx, keys...); recordErr != nil {
+			cs.logger.WithContext(ctx).Error("successful code attempt not recorded: ", recordErr)
+		}
+	}
+
+	return updatedCart, err
+}
+
+// isExpressCart checks if the express cart of the context replaces the cart of the session
+func isExpressCart(ctx context.Context) bool {
+	_, ok := ExpressCartIDFromContext(ctx)
+	return ok
+}
+
+func (cs *CartService) handleCartNotFound(ctx context.Context, session *web.Session, err error) {
+	// the session doesn't know the express cart, its guest cart stays
+	if err != cartDomain.ErrCartNotFound || isExpressCart(ctx) {
+		return
 	}
+
+	_ = cs.DeleteSavedSessionGuestCartID(session)
 }
 
 // checkProductForAddRequest existence and validate with productService
@@ -866,6 +1024,15 @@ func (cs *CartService) checkProductForAddRequest(ctx context.Context, session *w
 		}
 	}
 
+	// Mixed currencies are not supported within one cart, the cart needs to be switched to the currency of the product first
+	productCurrency := product.SaleableData().ActivePrice.GetFinalPrice().Currency()
+	if cart != nil && cart.Currency() != "" && productCurrency != "" && cart.Currency() != productCurrency {
+		return addRequest, nil, &CurrencyMismatchError{
+			CartCurrency:    cart.Currency(),
+			ProductCurrency: productCurrency,
+		}
+	}
+
 	// Now Validate the Item with the optional registered ItemValidator
 	if cs.itemValidator != nil {
 		decoratedCart, _ := cs.cartReceiverService.DecorateCart(ctx, cart)
@@ -892,6 +1059,11 @@ func (cs *CartService) checkProductQtyRestrictions(ctx context.Context, sess *we
 }
 
 func (cs *CartService) updateCartInCacheIfCacheIsEnabled(ctx context.Context, session *web.Session, cart *cartDomain.Cart) {
+	// the cache identifier belongs to the cart of the session, express carts are never cached
+	if isExpressCart(ctx) {
+		return
+	}
+
 	if cs.cartCache != nil && cart != nil {
 		id, err := cs.cartCache.BuildIdentifier(ctx, session)
 		if err != nil {
@@ -911,7 +1083,7 @@ func (cs *CartService) updateCartInCacheIfCacheIsEnabled(ctx context.Context, se
 
 // DeleteCartInCache removes the cart from cache
 func (cs *CartService) DeleteCartInCache(ctx context.Context, session *web.Session, _ *cartDomain.Cart) {
-	if cs.cartCache != nil {
+	if cs.cartCache != nil && !isExpressCart(ctx) {
 		id, err := cs.cartCache.BuildIdentifier(ctx, session)
 		if err != nil {
 			return
@@ -995,6 +1167,13 @@ func (cs *CartService) placeOrder(ctx context.Context, session *web.Session, car
 
 	identity := cs.webIdentityService.Identify(ctx, web.RequestFromContext(ctx))
 
+	// the coupon limits are checked again, the usages are reserved until the orders are placed
+	if cs.couponUsageReserver != nil {
+		if err := cs.couponUsageReserver.ReserveUsages(ctx, cart); err != nil {
+			return nil, err
+		}
+	}
+
 	if identity != nil {
 		placeOrderInfos, errPlaceOrder = cs.placeOrderService.PlaceCustomerCart(ctx, identity, cart, payment)
 	} else {
@@ -1002,12 +1181,25 @@ func (cs *CartService) placeOrder(ctx context.Context, session *web.Session, car
 	}
 
 	if errPlaceOrder != nil {
-		cs.handleCartNotFound(session, errPlaceOrder)
+		if cs.couponUsageReserver != nil {
+			if err := cs.couponUsageReserver.ReleaseReservedUsages(ctx, cart.ID); err != nil {
+				cs.logger.WithContext(ctx).Error("reserved coupon usages not released: ", err)
+			}
+		}
+		cs.handleCartNotFound(ctx, session, errPlaceOrder)
 		return nil, errPlaceOrder
 	}
 
+	if cs.couponUsageReserver != nil {
+		if err := cs.couponUsageReserver.ConfirmUsages(ctx, cart.ID, placeOrderInfos.OrderNumbers()); err != nil {
+			cs.logger.WithContext(ctx).Error("coupon usages of placed order not confirmed: ", err)
+		}
+	}
+
 	cs.eventPublisher.PublishOrderPlacedEvent(ctx, cart, placeOrderInfos)
-	_ = cs.DeleteSavedSessionGuestCartID(session)
+	if !isExpressCart(ctx) {
+		_ = cs.DeleteSavedSessionGuestCartID(session)
+	}
 	cs.DeleteCartInCache(ctx, session, cart)
 
 	return placeOrderInfos, nil
@@ -1047,6 +1239,11 @@ func (cs *CartService) cancelOrder(ctx context.Context, session *web.Session, or
 		cs.logger.Error(fmt.Sprintf("couldn't cancel order %q, err: %v", orderInfos, cancelErr))
 		return cancelErr
 	}
+
+	if cs.eventRouter != nil {
+		cs.eventRouter.Dispatch(ctx, &events.OrderCancelledEvent{PlacedOrderInfos: orderInfos})
+	}
+
 	return nil
 }
 
@@ -1133,6 +1330,11 @@ func (cs *CartService) generateRestrictedQtyAdjustments(ctx context.Context, ses
 		return nil, err
 	}
 
+	return cs.generateRestrictedQtyAdjustmentsForCart(ctx, session, cart)
+}
+
+// generateRestrictedQtyAdjustmentsForCart checks the quantity restrictions for each item of the given cart
+func (cs *CartService) generateRestrictedQtyAdjustmentsForCart(ctx context.Context, session *web.Session, cart *cartDomain.Cart) (QtyAdjustmentResults, error) {
 	result := make([]QtyAdjustmentResult, 0)
 	for _, delivery := range cart.Deliveries {
 		for _, item := range delivery.Cartitems {
@@ -12,6 +12,7 @@ import (
 
 	"flamingo.me/flamingo-commerce/v3/cart/infrastructure"
 
+	"flamingo.me/flamingo-commerce/v3/cart/domain/coupon"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/events"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/placeorder"
@@ -64,6 +65,7 @@ func TestCartService_DeleteSavedSessionGuestCartID(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -172,6 +174,7 @@ func TestCartService_AdjustItemsToRestrictedQty(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -229,6 +232,7 @@ func TestCartService_AdjustItemsToRestrictedQty(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -302,6 +306,7 @@ func TestCartService_AdjustItemsToRestrictedQty(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -430,6 +435,7 @@ func TestCartService_ReserveOrderIDAndSave(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -480,6 +486,7 @@ func TestCartService_ReserveOrderIDAndSave(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -533,10 +540,12 @@ func TestCartService_ReserveOrderIDAndSave(t *testing.T) {
 				tt.fields.Logger,
 				tt.fields.config,
 				&struct {
-					CartValidator     validation.Validator      `inject:",optional"`
-					ItemValidator     validation.ItemValidator  `inject:",optional"`
-					CartCache         cartApplication.CartCache `inject:",optional"`
-					PlaceOrderService placeorder.Service        `inject:",optional"`
+					CartValidator       validation.Validator                `inject:",optional"`
+					ItemValidator       validation.ItemValidator            `inject:",optional"`
+					CartCache           cartApplication.CartCache           `inject:",optional"`
+					PlaceOrderService   placeorder.Service                  `inject:",optional"`
+					CodeAttemptLimiter  *cartApplication.CodeAttemptLimiter `inject:",optional"`
+					CouponUsageReserver coupon.UsageReserver                `inject:",optional"`
 				}{
 					PlaceOrderService: tt.fields.PlaceOrderService,
 				},
@@ -593,6 +602,7 @@ func TestCartService_ForceReserveOrderIDAndSave(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -643,6 +653,7 @@ func TestCartService_ForceReserveOrderIDAndSave(t *testing.T) {
 							result.Inject(
 								&MockProductService{},
 								flamingo.NullLogger{},
+								nil,
 							)
 
 							return result
@@ -696,10 +707,12 @@ func TestCartService_ForceReserveOrderIDAndSave(t *testing.T) {
 				tt.fields.Logger,
 				tt.fields.config,
 				&struct {
-					CartValidator     validation.Validator      `inject:",optional"`
-					ItemValidator     validation.ItemValidator  `inject:",optional"`
-					CartCache         cartApplication.CartCache `inject:",optional"`
-					PlaceOrderService placeorder.Service        `inject:",optional"`
+					CartValidator       validation.Validator                `inject:",optional"`
+					ItemValidator       validation.ItemValidator            `inject:",optional"`
+					CartCache           cartApplication.CartCache           `inject:",optional"`
+					PlaceOrderService   placeorder.Service                  `inject:",optional"`
+					CodeAttemptLimiter  *cartApplication.CodeAttemptLimiter `inject:",optional"`
+					CouponUsageReserver coupon.UsageReserver                `inject:",optional"`
 				}{
 					PlaceOrderService: tt.fields.PlaceOrderService,
 				},
@@ -927,6 +940,7 @@ func TestCartService_CartInEvent(t *testing.T) {
 				result.Inject(
 					&MockProductService{},
 					flamingo.NullLogger{},
+					nil,
 				)
 
 				return result
@@ -0,0 +1,90 @@
+package application
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// TrustedProxies are the networks of the proxies that may forward the IP of the client in a header (e.g. X-Forwarded-For)
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses the IPs or CIDRs of the trusted proxies
+func ParseTrustedProxies(values []string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(values))
+	for _, value := range values {
+		network, err := parseNetwork(value)
+		if err != nil {
+			return nil, err
+		}
+		proxies = append(proxies, network)
+	}
+
+	return proxies, nil
+}
+
+// ClientIP returns the IP of the client of the request. The header is only taken into account if the request comes from
+// a trusted proxy, the client is the last address of the header (from the right) that isn't a trusted proxy itself.
+func (p TrustedProxies) ClientIP(request *http.Request, header string) string {
+	if request == nil {
+		return ""
+	}
+
+	ip := request.RemoteAddr
+	if host, _, err := net.SplitHostPort(request.RemoteAddr); err == nil {
+		ip = host
+	}
+
+	if header == "" {
+		return ip
+	}
+
+	forwardedFor := strings.Split(request.Header.Get(header), ",")
+	for i := len(forwardedFor) - 1; i >= 0 && p.Contains(ip); i-- {
+		forwarded := strings.TrimSpace(forwardedFor[i])
+		if net.ParseIP(forwarded) == nil {
+			break
+		}
+		ip = forwarded
+	}
+
+	return ip
+}
+
+// Contains checks if the ip belongs to one of the trusted proxies
+func (p TrustedProxies) Contains(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+
+	for _, network := range p {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseNetwork parses a CIDR or a single IP
+func parseNetwork(value string) (*net.IPNet, error) {
+	if !strings.Contains(value, "/") {
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return nil, fmt.Errorf("%q is no IP or CIDR", value)
+		}
+
+		bits := 8 * net.IPv4len
+		if ip.To4() == nil {
+			bits = 8 * net.IPv6len
+		}
+
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+
+	_, network, err := net.ParseCIDR(value)
+
+	return network, err
+}
@@ -0,0 +1,67 @@
+package application_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"flamingo.me/flamingo-commerce/v3/cart/application"
+)
+
+func TestTrustedProxies_ClientIP(t *testing.T) {
+	trustedProxies, err := application.ParseTrustedProxies([]string{"10.0.0.0/8", "192.0.2.10"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		remoteAddr     string
+		forwardedFor   string
+		trustedProxies application.TrustedProxies
+		expected       string
+	}{
+		{
+			name:         "forwarded for is ignored without trusted proxies",
+			remoteAddr:   "203.0.113.5:1234",
+			forwardedFor: "198.51.100.1",
+			expected:     "203.0.113.5",
+		},
+		{
+			name:           "forwarded for of an untrusted remote address is ignored",
+			remoteAddr:     "203.0.113.5:1234",
+			forwardedFor:   "198.51.100.1",
+			trustedProxies: trustedProxies,
+			expected:       "203.0.113.5",
+		},
+		{
+			name:           "spoofed first entry is skipped",
+			remoteAddr:     "10.0.0.2:1234",
+			forwardedFor:   "198.51.100.1, 203.0.113.7, 192.0.2.10",
+			trustedProxies: trustedProxies,
+			expected:       "203.0.113.7",
+		},
+		{
+			name:           "only trusted proxies",
+			remoteAddr:     "10.0.0.2:1234",
+			forwardedFor:   "10.0.0.3",
+			trustedProxies: trustedProxies,
+			expected:       "10.0.0.3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpRequest := httptest.NewRequest("PUT", "/api/v1/checkout/placeorder", nil)
+			httpRequest.RemoteAddr = tt.remoteAddr
+			httpRequest.Header.Set("X-Forwarded-For", tt.forwardedFor)
+
+			assert.Equal(t, tt.expected, tt.trustedProxies.ClientIP(httpRequest, "X-Forwarded-For"))
+		})
+	}
+
+	t.Run("invalid proxy", func(t *testing.T) {
+		_, err := application.ParseTrustedProxies([]string{"10.0.0.0/8", "proxy"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,309 @@
+package application
+
+import (
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/opencensus"
+	"flamingo.me/flamingo/v3/framework/web"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+)
+
+type (
+	// CodeAttemptStore is the secondary port that stores the code attempts per key,
+	// bind a shared store (e.g. redis) if the attempts should be limited across multiple instances
+	CodeAttemptStore interface {
+		// Get returns the lockout of the key, an empty CodeAttempts if there is none
+		Get(ctx context.Context, key string) (CodeAttempts, error)
+		// Set stores the lockout of the key, it can be dropped after the given ttl
+		Set(ctx context.Context, key string, attempts CodeAttempts, ttl time.Duration) error
+		// IncrementAttempts atomically counts an attempt of the key and returns the attempts within the sliding window,
+		// each attempt counts for the duration of the window
+		IncrementAttempts(ctx context.Context, key string, window time.Duration) (int, error)
+		// DecrementAttempts atomically removes the latest counted attempt of the key, e.g. for a valid code
+		DecrementAttempts(ctx context.Context, key string) error
+		// ExpireAttempts lets all counted attempts of the key drop out of the window after the given duration, e.g. at the end of a lockout
+		ExpireAttempts(ctx context.Context, key string, after time.Duration, window time.Duration) error
+	}
+
+	// CodeAttempts holds the lockout of a key (session, cart or client ip)
+	CodeAttempts struct {
+		// Lockouts is the number of lockouts so far, each lockout doubles the lockout duration
+		Lockouts int
+		// LockedUntil blocks all attempts until the given time
+		LockedUntil time.Time
+	}
+
+	// CodeAttemptLimiter protects the code entry of vouchers and gift cards against enumeration, it counts the failed
+	// attempts per session, cart and client ip within a window and locks the code entry with an exponential duration
+	CodeAttemptLimiter struct {
+		logger         flamingo.Logger
+		store          CodeAttemptStore
+		enabled        bool
+		maxAttempts    int
+		window         time.Duration
+		lockout        time.Duration
+		maxLockout     time.Duration
+		lockoutMemory  time.Duration
+		clientIPHeader string
+		trustedProxies TrustedProxies
+		now            func() time.Time
+	}
+
+	// CodeAttemptsBlockedError is returned if the code entry is locked because of too many failed attempts
+	CodeAttemptsBlockedError struct {
+		RetryAfter time.Duration
+	}
+)
+
+var (
+	// codeAttemptsBlockedCount counts the code attempts rejected because of a lockout
+	codeAttemptsBlockedCount = stats.Int64("flamingo-commerce/cart/code_attempts/blocked", "Count of voucher and gift card code attempts that were blocked", stats.UnitDimensionless)
+
+	// codeAttemptsLockoutCount counts the lockouts of sessions, carts and client ips
+	codeAttemptsLockoutCount = stats.Int64("flamingo-commerce/cart/code_attempts/lockouts", "Count of lockouts because of too many failed voucher and gift card code attempts", stats.UnitDimensionless)
+)
+
+func init() {
+	gob.Register(CodeAttemptsBlockedError{})
+	openCensusViews := map[string]*stats.Int64Measure{
+		"flamingo-commerce/cart/code_attempts/blocked":  codeAttemptsBlockedCount,
+		"flamingo-commerce/cart/code_attempts/lockouts": codeAttemptsLockoutCount,
+	}
+
+	for name, measure := range openCensusViews {
+		err := opencensus.View(name, measure, view.Count())
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Error returns the error message
+func (e *CodeAttemptsBlockedError) Error() string {
+	return fmt.Sprintf("too many invalid codes, retry after %d seconds", e.RetryAfterSeconds())
+}
+
+// MessageCode returns a code that can be used to show a translated message
+func (e *CodeAttemptsBlockedError) MessageCode() string {
+	return "code_attempts_blocked"
+}
+
+// RetryAfterSeconds returns the seconds until the next attempt is allowed, e.g. for the Retry-After header
+func (e *CodeAttemptsBlockedError) RetryAfterSeconds() int {
+	return int(math.Ceil(e.RetryAfter.Seconds()))
+}
+
+// Inject dependencies
+func (l *CodeAttemptLimiter) Inject(
+	logger flamingo.Logger,
+	config *struct {
+		Enabled        bool         `inject:"config:commerce.cart.codeAttempts.enabled,optional"`
+		MaxAttempts    float64      `inject:"config:commerce.cart.codeAttempts.maxAttempts,optional"`
+		Window         float64      `inject:"config:commerce.cart.codeAttempts.window,optional"`        // in seconds
+		Lockout        float64      `inject:"config:commerce.cart.codeAttempts.lockout,optional"`       // in seconds
+		MaxLockout     float64      `inject:"config:commerce.cart.codeAttempts.maxLockout,optional"`    // in seconds
+		LockoutMemory  float64      `inject:"config:commerce.cart.codeAttempts.lockoutMemory,optional"` // in seconds
+		ClientIPHeader string       `inject:"config:commerce.cart.codeAttempts.clientIPHeader,optional"`
+		TrustedProxies config.Slice `inject:"config:commerce.cart.codeAttempts.trustedProxies,optional"`
+	},
+	optionals *struct {
+		Store CodeAttemptStore `inject:",optional"`
+	},
+) *CodeAttemptLimiter {
+	l.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "CodeAttemptLimiter")
+	l.now = time.Now
+	if config != nil {
+		l.enabled = config.Enabled
+		l.maxAttempts = int(config.MaxAttempts)
+		l.window = time.Duration(config.Window) * time.Second
+		l.lockout = time.Duration(config.Lockout) * time.Second
+		l.maxLockout = time.Duration(config.MaxLockout) * time.Second
+		l.lockoutMemory = time.Duration(config.LockoutMemory) * time.Second
+		l.clientIPHeader = config.ClientIPHeader
+
+		var trustedProxies []string
+		if config.TrustedProxies != nil {
+			if err := config.TrustedProxies.MapInto(&trustedProxies); err != nil {
+				panic(fmt.Errorf("invalid config commerce.cart.codeAttempts.trustedProxies: %w", err))
+			}
+		}
+
+		var err error
+		l.trustedProxies, err = ParseTrustedProxies(trustedProxies)
+		if err != nil {
+			panic(fmt.Errorf("invalid config commerce.cart.codeAttempts.trustedProxies: %w", err))
+		}
+	}
+
+	if optionals != nil {
+		l.store = optionals.Store
+	}
+
+	if l.enabled && l.store == nil {
+		panic(errors.New("invalid config commerce.cart.codeAttempts: enabled without a bound application.CodeAttemptStore, bind an own store for store \"custom\""))
+	}
+
+	return l
+}
+
+// IsActive returns true if the limiter is enabled and a store is bound
+func (l *CodeAttemptLimiter) IsActive() bool {
+	return l != nil && l.enabled && l.store != nil && l.maxAttempts > 0
+}
+
+// AttemptKeys returns the keys the attempts are counted for: the session, the cart and the client ip of the request
+func (l *CodeAttemptLimiter) AttemptKeys(ctx context.Context, session *web.Session, cartID string) []string {
+	var keys []string
+	if session != nil && session.ID() != "" {
+		keys = append(keys, "session:"+session.ID())
+	}
+
+	if cartID != "" {
+		keys = append(keys, "cart:"+cartID)
+	}
+
+	if clientIP := l.clientIP(ctx); clientIP != "" {
+		keys = append(keys, "ip:"+clientIP)
+	}
+
+	return keys
+}
+
+// Attempt counts the attempt for all keys before the code is checked, so parallel attempts can't pass the limit.
+// It returns a CodeAttemptsBlockedError if one of the keys is locked or exceeds the max attempts within the window,
+// the exceeding keys are locked with an exponential duration. Call RecordSuccess if the code was valid.
+func (l *CodeAttemptLimiter) Attempt(ctx context.Context, keys ...string) error {
+	if !l.IsActive() {
+		return nil
+	}
+
+	now := l.now()
+	var retryAfter time.Duration
+	for _, key := range keys {
+		attempts, err := l.store.Get(ctx, key)
+		if err != nil {
+			// a failing store should not block the code entry
+			l.logger.WithContext(ctx).Error("code attempts not readable: ", err)
+			continue
+		}
+
+		if wait := attempts.LockedUntil.Sub(now); wait > retryAfter {
+			retryAfter = wait
+		}
+	}
+
+	if retryAfter <= 0 {
+		for _, key := range keys {
+			count, err := l.store.IncrementAttempts(ctx, key, l.window)
+			if err != nil {
+				l.logger.WithContext(ctx).Error("code attempt not counted: ", err)
+				continue
+			}
+
+			if count <= l.maxAttempts {
+				continue
+			}
+
+			// only the first exceeding attempt locks the key, parallel attempts are blocked by this lock
+			wait := l.lockout
+			if count == l.maxAttempts+1 {
+				wait = l.lock(ctx, key, now)
+			} else if attempts, err := l.store.Get(ctx, key); err == nil && attempts.LockedUntil.After(now) {
+				wait = attempts.LockedUntil.Sub(now)
+			}
+
+			if wait > retryAfter {
+				retryAfter = wait
+			}
+		}
+	}
+
+	if retryAfter <= 0 {
+		return nil
+	}
+
+	stats.Record(ctx, codeAttemptsBlockedCount.M(1))
+	l.logger.WithContext(ctx).Info(fmt.Sprintf("code attempt blocked for %v, keys: %v", retryAfter, keys))
+
+	return &CodeAttemptsBlockedError{RetryAfter: retryAfter}
+}
+
+// RecordSuccess removes the attempt counted by Attempt for all keys, only failed attempts are limited
+func (l *CodeAttemptLimiter) RecordSuccess(ctx context.Context, keys ...string) error {
+	if !l.IsActive() {
+		return nil
+	}
+
+	for _, key := range keys {
+		err := l.store.DecrementAttempts(ctx, key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lock locks the key with the duration of its next lockout and returns the duration
+func (l *CodeAttemptLimiter) lock(ctx context.Context, key string, now time.Time) time.Duration {
+	attempts, err := l.store.Get(ctx, key)
+	if err != nil {
+		l.logger.WithContext(ctx).Error("code attempts not readable: ", err)
+	}
+
+	attempts.Lockouts++
+	lockout := l.lockoutDuration(attempts.Lockouts)
+	attempts.LockedUntil = now.Add(lockout)
+	stats.Record(ctx, codeAttemptsLockoutCount.M(1))
+
+	ttl := l.lockoutMemory
+	if lockout > ttl {
+		ttl = lockout
+	}
+
+	if err := l.store.Set(ctx, key, attempts, ttl); err != nil {
+		l.logger.WithContext(ctx).Error("code attempt lockout not stored: ", err)
+	}
+
+	// the attempts are counted again after the lockout
+	if err := l.store.ExpireAttempts(ctx, key, lockout, l.window); err != nil {
+		l.logger.WithContext(ctx).Error("code attempts not expired: ", err)
+	}
+
+	return lockout
+}
+
+// lockoutDuration doubles the configured lockout with each lockout up to the max lockout
+func (l *CodeAttemptLimiter) lockoutDuration(lockouts int) time.Duration {
+	duration := l.lockout
+	for i := 1; i < lockouts; i++ {
+		duration *= 2
+		if duration >= l.maxLockout {
+			break
+		}
+	}
+
+	if l.maxLockout > 0 && duration > l.maxLockout {
+		return l.maxLockout
+	}
+
+	return duration
+}
+
+// clientIP returns the ip of the client, the configured header is only taken into account behind the trusted proxies
+func (l *CodeAttemptLimiter) clientIP(ctx context.Context) string {
+	request := web.RequestFromContext(ctx)
+	if request == nil || request.Request() == nil {
+		return ""
+	}
+
+	return l.trustedProxies.ClientIP(request.Request(), l.clientIPHeader)
+}
@@ -0,0 +1,194 @@
+package application_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"flamingo.me/flamingo-commerce/v3/cart/application"
+)
+
+func newCodeAttemptLimiter(store application.CodeAttemptStore) *application.CodeAttemptLimiter {
+	return new(application.CodeAttemptLimiter).Inject(
+		flamingo.NullLogger{},
+		&struct {
+			Enabled        bool         `inject:"config:commerce.cart.codeAttempts.enabled,optional"`
+			MaxAttempts    float64      `inject:"config:commerce.cart.codeAttempts.maxAttempts,optional"`
+			Window         float64      `inject:"config:commerce.cart.codeAttempts.window,optional"`
+			Lockout        float64      `inject:"config:commerce.cart.codeAttempts.lockout,optional"`
+			MaxLockout     float64      `inject:"config:commerce.cart.codeAttempts.maxLockout,optional"`
+			LockoutMemory  float64      `inject:"config:commerce.cart.codeAttempts.lockoutMemory,optional"`
+			ClientIPHeader string       `inject:"config:commerce.cart.codeAttempts.clientIPHeader,optional"`
+			TrustedProxies config.Slice `inject:"config:commerce.cart.codeAttempts.trustedProxies,optional"`
+		}{
+			Enabled:        true,
+			MaxAttempts:    3,
+			Window:         600,
+			Lockout:        60,
+			MaxLockout:     150,
+			LockoutMemory:  3600,
+			ClientIPHeader: "X-Forwarded-For",
+			TrustedProxies: config.Slice{"10.0.0.0/8"},
+		},
+		&struct {
+			Store application.CodeAttemptStore `inject:",optional"`
+		}{Store: store},
+	)
+}
+
+func TestCodeAttemptLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("enabled without store", func(t *testing.T) {
+		assert.Panics(t, func() {
+			newCodeAttemptLimiter(nil)
+		})
+	})
+
+	t.Run("inactive if disabled", func(t *testing.T) {
+		limiter := new(application.CodeAttemptLimiter).Inject(flamingo.NullLogger{}, nil, nil)
+		assert.False(t, limiter.IsActive())
+		assert.NoError(t, limiter.Attempt(ctx, "cart:1"))
+		assert.NoError(t, limiter.RecordSuccess(ctx, "cart:1"))
+	})
+
+	t.Run("keys of session, cart and client ip", func(t *testing.T) {
+		request := httptest.NewRequest("POST", "/api/v1/cart/applygiftcard", nil)
+		request.RemoteAddr = "10.0.0.1:1234"
+		request.Header.Set("X-Forwarded-For", "192.0.2.1, 10.0.0.1")
+		requestCtx := web.ContextWithRequest(ctx, web.CreateRequest(request, nil))
+
+		limiter := newCodeAttemptLimiter(new(application.InMemoryCodeAttemptStore).Inject())
+		assert.Equal(t, []string{"cart:cart-1", "ip:192.0.2.1"}, limiter.AttemptKeys(requestCtx, nil, "cart-1"))
+
+		request.Header.Set("X-Forwarded-For", "192.0.2.1")
+		request.RemoteAddr = "203.0.113.5:1234"
+		assert.Equal(t, []string{"ip:203.0.113.5"}, limiter.AttemptKeys(requestCtx, nil, ""), "header of an untrusted client is ignored")
+
+		request.Header.Del("X-Forwarded-For")
+		request.RemoteAddr = "10.0.0.1:1234"
+		assert.Equal(t, []string{"ip:10.0.0.1"}, limiter.AttemptKeys(requestCtx, nil, ""))
+	})
+
+	t.Run("successful attempts are not counted", func(t *testing.T) {
+		limiter := newCodeAttemptLimiter(new(application.InMemoryCodeAttemptStore).Inject())
+
+		for i := 0; i < 10; i++ {
+			require.NoError(t, limiter.Attempt(ctx, "cart:1"))
+			require.NoError(t, limiter.RecordSuccess(ctx, "cart:1"))
+		}
+	})
+
+	t.Run("parallel attempts are limited", func(t *testing.T) {
+		limiter := newCodeAttemptLimiter(new(application.InMemoryCodeAttemptStore).Inject())
+
+		var wg sync.WaitGroup
+		var blocked int32
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if limiter.Attempt(ctx, "cart:1") != nil {
+					atomic.AddInt32(&blocked, 1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(17), blocked, "only the max attempts may pass")
+	})
+
+	t.Run("failures lock the keys with exponential duration", func(t *testing.T) {
+		store := new(application.InMemoryCodeAttemptStore).Inject()
+		limiter := newCodeAttemptLimiter(store)
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, limiter.Attempt(ctx, "cart:1", "ip:1"))
+		}
+
+		err := limiter.Attempt(ctx, "ip:1", "session:other")
+		blockedErr, ok := err.(*application.CodeAttemptsBlockedError)
+		require.True(t, ok, "expected CodeAttemptsBlockedError, got %v", err)
+		assert.Equal(t, 60, blockedErr.RetryAfterSeconds())
+		assert.Equal(t, "code_attempts_blocked", blockedErr.MessageCode())
+		assert.NoError(t, limiter.Attempt(ctx, "session:other"))
+
+		// the fourth attempt locks the cart as well
+		err = limiter.Attempt(ctx, "cart:1")
+		require.IsType(t, &application.CodeAttemptsBlockedError{}, err)
+
+		// let the lockout expire, the next lockout doubles the duration
+		attempts, err := store.Get(ctx, "cart:1")
+		require.NoError(t, err)
+		assert.Equal(t, 1, attempts.Lockouts)
+		attempts.LockedUntil = time.Now().Add(-time.Second)
+		require.NoError(t, store.Set(ctx, "cart:1", attempts, time.Hour))
+		require.NoError(t, store.ExpireAttempts(ctx, "cart:1", 0, 10*time.Minute))
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, limiter.Attempt(ctx, "cart:1"))
+		}
+		err = limiter.Attempt(ctx, "cart:1")
+		require.IsType(t, &application.CodeAttemptsBlockedError{}, err)
+		assert.Equal(t, 120, err.(*application.CodeAttemptsBlockedError).RetryAfterSeconds())
+
+		// the third lockout is limited by the max lockout
+		attempts, _ = store.Get(ctx, "cart:1")
+		attempts.LockedUntil = time.Now().Add(-time.Second)
+		require.NoError(t, store.Set(ctx, "cart:1", attempts, time.Hour))
+		require.NoError(t, store.ExpireAttempts(ctx, "cart:1", 0, 10*time.Minute))
+		for i := 0; i < 3; i++ {
+			require.NoError(t, limiter.Attempt(ctx, "cart:1"))
+		}
+		err = limiter.Attempt(ctx, "cart:1")
+		require.IsType(t, &application.CodeAttemptsBlockedError{}, err)
+		assert.Equal(t, 150, err.(*application.CodeAttemptsBlockedError).RetryAfterSeconds())
+	})
+}
+
+func TestInMemoryCodeAttemptStore_IncrementAttempts(t *testing.T) {
+	ctx := context.Background()
+	window := 200 * time.Millisecond
+
+	t.Run("attempts count within the sliding window", func(t *testing.T) {
+		store := new(application.InMemoryCodeAttemptStore).Inject()
+
+		count, err := store.IncrementAttempts(ctx, "ip:1", window)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		time.Sleep(120 * time.Millisecond)
+		count, err = store.IncrementAttempts(ctx, "ip:1", window)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		// the first attempt dropped out of the window, the second one still counts
+		time.Sleep(120 * time.Millisecond)
+		count, err = store.IncrementAttempts(ctx, "ip:1", window)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("expired attempts drop out of the window", func(t *testing.T) {
+		store := new(application.InMemoryCodeAttemptStore).Inject()
+
+		for i := 0; i < 3; i++ {
+			_, err := store.IncrementAttempts(ctx, "ip:1", window)
+			require.NoError(t, err)
+		}
+		require.NoError(t, store.ExpireAttempts(ctx, "ip:1", 0, window))
+
+		count, err := store.IncrementAttempts(ctx, "ip:1", window)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
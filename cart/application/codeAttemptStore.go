@@ -0,0 +1,144 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type (
+	// InMemoryCodeAttemptStore keeps the code attempts in memory of the running instance
+	InMemoryCodeAttemptStore struct {
+		mutex    sync.Mutex
+		entries  map[string]codeAttemptEntry
+		counters map[string]*codeAttemptCounter
+		now      func() time.Time
+	}
+
+	codeAttemptEntry struct {
+		attempts  CodeAttempts
+		expiresAt time.Time
+	}
+
+	// codeAttemptCounter holds the times of the attempts in ascending order, an attempt counts until its time plus the window
+	codeAttemptCounter struct {
+		times  []time.Time
+		window time.Duration
+	}
+)
+
+var _ CodeAttemptStore = (*InMemoryCodeAttemptStore)(nil)
+
+// Inject dependencies
+func (s *InMemoryCodeAttemptStore) Inject() *InMemoryCodeAttemptStore {
+	s.entries = make(map[string]codeAttemptEntry)
+	s.counters = make(map[string]*codeAttemptCounter)
+	s.now = time.Now
+
+	return s
+}
+
+// Get returns the attempts of the key
+func (s *InMemoryCodeAttemptStore) Get(_ context.Context, key string) (CodeAttempts, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entry, found := s.entries[key]
+	if !found || !entry.expiresAt.After(s.now()) {
+		return CodeAttempts{}, nil
+	}
+
+	return entry.attempts, nil
+}
+
+// Set stores the attempts of the key and removes all expired entries
+func (s *InMemoryCodeAttemptStore) Set(_ context.Context, key string, attempts CodeAttempts, ttl time.Duration) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	s.removeExpired(now)
+	s.entries[key] = codeAttemptEntry{attempts: attempts, expiresAt: now.Add(ttl)}
+
+	return nil
+}
+
+// IncrementAttempts counts an attempt of the key and returns the attempts within the sliding window before now
+func (s *InMemoryCodeAttemptStore) IncrementAttempts(_ context.Context, key string, window time.Duration) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	s.removeExpired(now)
+	counter, found := s.counters[key]
+	if !found {
+		counter = new(codeAttemptCounter)
+		s.counters[key] = counter
+	}
+
+	counter.window = window
+	counter.times = append(counter.times, now)
+
+	return len(counter.times), nil
+}
+
+// DecrementAttempts removes the latest counted attempt of the key
+func (s *InMemoryCodeAttemptStore) DecrementAttempts(_ context.Context, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.removeExpired(s.now())
+	counter, found := s.counters[key]
+	if !found {
+		return nil
+	}
+
+	counter.times = counter.times[:len(counter.times)-1]
+	if len(counter.times) == 0 {
+		delete(s.counters, key)
+	}
+
+	return nil
+}
+
+// ExpireAttempts lets all counted attempts of the key drop out of the window after the given duration
+func (s *InMemoryCodeAttemptStore) ExpireAttempts(_ context.Context, key string, after time.Duration, window time.Duration) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	counter, found := s.counters[key]
+	if !found {
+		return nil
+	}
+
+	latest := s.now().Add(after).Add(-window)
+	for i, attemptTime := range counter.times {
+		if attemptTime.After(latest) {
+			counter.times[i] = latest
+		}
+	}
+
+	return nil
+}
+
+// removeExpired removes all expired entries and the attempts outside of their window, the mutex must be held
+func (s *InMemoryCodeAttemptStore) removeExpired(now time.Time) {
+	for key, entry := range s.entries {
+		if !entry.expiresAt.After(now) {
+			delete(s.entries, key)
+		}
+	}
+
+	for key, counter := range s.counters {
+		windowStart := now.Add(-counter.window)
+		expired := 0
+		for expired < len(counter.times) && !counter.times[expired].After(windowStart) {
+			expired++
+		}
+
+		counter.times = counter.times[expired:]
+		if len(counter.times) == 0 {
+			delete(s.counters, key)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package application
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/coupon"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/events"
+)
+
+type (
+	// CouponUsageRecorder releases the coupon usages of cancelled orders, the usages are reserved and confirmed by the CartService while the order is placed
+	CouponUsageRecorder struct {
+		logger        flamingo.Logger
+		couponService *coupon.Service
+	}
+)
+
+// Inject dependencies
+func (r *CouponUsageRecorder) Inject(
+	logger flamingo.Logger,
+	couponService *coupon.Service,
+) *CouponUsageRecorder {
+	r.logger = logger.WithField(flamingo.LogKeyCategory, "cart").WithField(flamingo.LogKeySubCategory, "coupon-usage")
+	r.couponService = couponService
+
+	return r
+}
+
+// Notify should get called by flamingo Eventlogic
+func (r *CouponUsageRecorder) Notify(ctx context.Context, event flamingo.Event) {
+	if currentEvent, ok := event.(*events.OrderCancelledEvent); ok {
+		if err := r.couponService.ReleaseUsages(ctx, currentEvent.PlacedOrderInfos.OrderNumbers()); err != nil {
+			r.logger.WithContext(ctx).Error("coupon usages of cancelled order not released: ", err)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package application
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	priceApplication "flamingo.me/flamingo-commerce/v3/price/application"
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+	productDomain "flamingo.me/flamingo-commerce/v3/product/domain"
+)
+
+type (
+	// CurrencyService switches the currency of carts and values payment selections in the currency of the cart
+	CurrencyService struct {
+		cartService         *CartService
+		cartReceiverService *CartReceiverService
+		productService      productDomain.ProductService
+		currencyConverter   *priceApplication.CurrencyConverter
+	}
+)
+
+// Inject dependencies
+func (s *CurrencyService) Inject(
+	cartService *CartService,
+	cartReceiverService *CartReceiverService,
+	productService productDomain.ProductService,
+	currencyConverter *priceApplication.CurrencyConverter,
+) *CurrencyService {
+	s.cartService = cartService
+	s.cartReceiverService = cartReceiverService
+	s.productService = productService
+	s.currencyConverter = currencyConverter
+
+	return s
+}
+
+// SwitchCurrency re-prices the current cart in the given currency
+// Item prices are fetched again from the product service and converted if the product service delivers another currency,
+// gift wrap and shipping prices are converted with the current exchange rates
+func (s *CurrencyService) SwitchCurrency(ctx context.Context, session *web.Session, currency string) error {
+	cart, err := s.cartReceiverService.ViewCart(ctx, session)
+	if err != nil {
+		return err
+	}
+
+	if cart.Currency() == currency {
+		return nil
+	}
+
+	command, err := s.buildCurrencySwitchCommand(ctx, cart, currency)
+	if err != nil {
+		return err
+	}
+
+	return s.cartService.SwitchCurrency(ctx, session, command)
+}
+
+// ValuePaymentSelection fills the Value of all charges of the selection in the currency of the cart,
+// this is required for charges that are paid in another currency, e.g. with a gift card of another currency
+func (s *CurrencyService) ValuePaymentSelection(ctx context.Context, cart *cartDomain.Cart, selection cartDomain.PaymentSelection) (cartDomain.PaymentSelection, error) {
+	if selection == nil || cart.Currency() == "" {
+		return selection, nil
+	}
+
+	builder := &cartDomain.PaymentSplitByItemBuilder{}
+	splits := []struct {
+		items map[string]cartDomain.PaymentSplit
+		add   func(string, string, priceDomain.Charge) *cartDomain.PaymentSplitByItemBuilder
+	}{
+		{items: selection.ItemSplit().CartItems, add: builder.AddCartItem},
+		{items: selection.ItemSplit().ShippingItems, add: builder.AddShippingItem},
+		{items: selection.ItemSplit().TotalItems, add: builder.AddTotalItem},
+	}
+
+	for _, split := range splits {
+		for id, paymentSplit := range split.items {
+			for qualifier, charge := range paymentSplit {
+				valuedCharge, err := s.currencyConverter.ValueCharge(ctx, charge, cart.Currency())
+				if err != nil {
+					return nil, err
+				}
+				split.add(id, qualifier.Method, valuedCharge)
+			}
+		}
+	}
+
+	return cartDomain.NewPaymentSelection(selection.Gateway(), builder.Build()), nil
+}
+
+func (s *CurrencyService) buildCurrencySwitchCommand(ctx context.Context, cart *cartDomain.Cart, currency string) (cartDomain.CurrencySwitchCommand, error) {
+	command := cartDomain.CurrencySwitchCommand{
+		Currency:       currency,
+		SinglePrices:   make(map[string]priceDomain.Price),
+		GiftWrapPrices: make(map[string]priceDomain.Price),
+		ShippingItems:  make(map[string]cartDomain.ShippingItem),
+	}
+
+	for _, delivery := range cart.Deliveries {
+		for _, item := range delivery.Cartitems {
+			price, err := s.productPrice(ctx, item, currency)
+			if err != nil {
+				return command, err
+			}
+			command.SinglePrices[item.ID] = price
+
+			if item.HasGiftWrap() {
+				giftWrapPrice, err := s.currencyConverter.Convert(ctx, item.GiftWrap.Price, currency)
+				if err != nil {
+					return command, err
+				}
+				command.GiftWrapPrices[item.ID] = giftWrapPrice
+			}
+		}
+
+		shippingItem, err := s.convertShippingItem(ctx, delivery.ShippingItem, currency)
+		if err != nil {
+			return command, err
+		}
+		command.ShippingItems[delivery.DeliveryInfo.Code] = shippingItem
+	}
+
+	return command, nil
+}
+
+// productPrice returns the current final price of the item's product in the given currency
+func (s *CurrencyService) productPrice(ctx context.Context, item cartDomain.Item, currency string) (priceDomain.Price, error) {
+	product, err := s.productService.Get(ctx, item.MarketplaceCode)
+	if err != nil {
+		return priceDomain.Price{}, err
+	}
+
+	if configurableProduct, ok := product.(productDomain.ConfigurableProduct); ok && item.VariantMarketPlaceCode != "" {
+		product, err = configurableProduct.GetConfigurableWithActiveVariant(item.VariantMarketPlaceCode)
+		if err != nil {
+			return priceDomain.Price{}, err
+		}
+	}
+
+	return s.currencyConverter.Convert(ctx, product.SaleableData().ActivePrice.GetFinalPrice(), currency)
+}
+
+func (s *CurrencyService) convertShippingItem(ctx context.Context, shippingItem cartDomain.ShippingItem, currency string) (cartDomain.ShippingItem, error) {
+	var err error
+
+	shippingItem.PriceNet, err = s.currencyConverter.Convert(ctx, shippingItem.PriceNet, currency)
+	if err != nil {
+		return shippingItem, err
+	}
+
+	shippingItem.TaxAmount, err = s.currencyConverter.Convert(ctx, shippingItem.TaxAmount, currency)
+	if err != nil {
+		return shippingItem, err
+	}
+
+	if len(shippingItem.AppliedDiscounts) == 0 {
+		return shippingItem, nil
+	}
+
+	discounts := make(cartDomain.AppliedDiscounts, 0, len(shippingItem.AppliedDiscounts))
+	for _, discount := range shippingItem.AppliedDiscounts {
+		discount.Applied, err = s.currencyConverter.Convert(ctx, discount.Applied, currency)
+		if err != nil {
+			return shippingItem, err
+		}
+		discounts = append(discounts, discount)
+	}
+	shippingItem.AppliedDiscounts = discounts
+
+	return shippingItem, nil
+}
@@ -10,6 +10,7 @@ import (
 	"flamingo.me/flamingo/v3/framework/flamingo"
 
 	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/events"
 )
 
 type (
@@ -20,6 +21,7 @@ type (
 		cartReceiverService *CartReceiverService
 		cartCache           CartCache
 		webIdentityService  *auth.WebIdentityService
+		addressBookService  *AddressBookService
 	}
 )
 
@@ -29,6 +31,7 @@ func (e *EventReceiver) Inject(
 	cartService *CartService,
 	cartReceiverService *CartReceiverService,
 	webIdentityService *auth.WebIdentityService,
+	addressBookService *AddressBookService,
 	optionals *struct {
 		CartCache CartCache `inject:",optional"`
 	},
@@ -37,6 +40,7 @@ func (e *EventReceiver) Inject(
 	e.cartService = cartService
 	e.cartReceiverService = cartReceiverService
 	e.webIdentityService = webIdentityService
+	e.addressBookService = addressBookService
 	if optionals != nil {
 		e.cartCache = optionals.CartCache
 	}
@@ -58,6 +62,7 @@ func (e *EventReceiver) Notify(ctx context.Context, event flamingo.Event) {
 			}
 			session := currentEvent.Request.Session()
 			if !e.cartReceiverService.ShouldHaveGuestCart(session) {
+				e.prefillCustomerAddresses(ctx, currentEvent.Request)
 				return
 			}
 			guestCart, err := e.cartReceiverService.ViewGuestCart(ctx, session)
@@ -130,6 +135,8 @@ func (e *EventReceiver) Notify(ctx context.Context, event flamingo.Event) {
 				}
 			}
 
+			e.prefillCustomerAddresses(ctx, currentEvent.Request)
+
 			if e.cartCache != nil {
 				session := web.SessionFromContext(ctx)
 				cacheID, err := e.cartCache.BuildIdentifier(ctx, session)
@@ -141,6 +148,14 @@ func (e *EventReceiver) Notify(ctx context.Context, event flamingo.Event) {
 				}
 			}
 		})
+	// Prefill the addresses of deliveries created by adding a product to the customer cart
+	case *events.AddToCartEvent:
+		if currentEvent.Cart == nil || !currentEvent.Cart.BelongsToAuthenticatedUser || !hasDeliveryWithoutLocation(currentEvent.Cart) {
+			return
+		}
+		if request := web.RequestFromContext(ctx); request != nil {
+			e.prefillCustomerAddresses(ctx, request)
+		}
 	// Handle Event to Invalidate the Cart Cache
 	case *cartDomain.InvalidateCartEvent:
 		if e.cartCache != nil {
@@ -151,3 +166,26 @@ func (e *EventReceiver) Notify(ctx context.Context, event flamingo.Event) {
 		}
 	}
 }
+
+// prefillCustomerAddresses takes over the default addresses of the customer for a customer cart without addresses
+func (e *EventReceiver) prefillCustomerAddresses(ctx context.Context, request *web.Request) {
+	if e.addressBookService == nil {
+		return
+	}
+	err := e.addressBookService.PrefillCustomerAddresses(ctx, request)
+	if err != nil {
+		e.logger.WithContext(ctx).Info("customerCart addresses not prefilled from address book: ", err)
+	}
+}
+
+// hasDeliveryWithoutLocation checks if the cart has a delivery without address that doesn't use the billing address
+func hasDeliveryWithoutLocation(cart *cartDomain.Cart) bool {
+	for _, delivery := range cart.Deliveries {
+		location := delivery.DeliveryInfo.DeliveryLocation
+		if location.Address == nil && !location.UseBillingAddress && location.Code == "" {
+			return true
+		}
+	}
+
+	return false
+}
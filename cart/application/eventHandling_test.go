@@ -0,0 +1,65 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/stretchr/testify/mock"
+
+	cartApplication "flamingo.me/flamingo-commerce/v3/cart/application"
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart/mocks"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/events"
+	customerDomain "flamingo.me/flamingo-commerce/v3/customer/domain"
+)
+
+func TestEventReceiver_AddToCartEvent(t *testing.T) {
+	customer := &mockAddressBookCustomer{
+		defaultShipping: &customerDomain.Address{ID: "shipping", Firstname: "Shipping", City: "Berlin"},
+	}
+
+	newReceiver := func(cart *cartDomain.Cart, behaviour cartDomain.ModifyBehaviour) *cartApplication.EventReceiver {
+		receiver := new(cartApplication.EventReceiver)
+		receiver.Inject(flamingo.NullLogger{}, nil, nil, nil, provideAddressBookService(t, true, customer, cart, behaviour, nil), nil)
+		return receiver
+	}
+
+	request := web.CreateRequest(nil, web.EmptySession())
+	ctx := web.ContextWithRequest(context.Background(), request)
+
+	t.Run("new delivery of the customer cart is prefilled", func(t *testing.T) {
+		cart := &cartDomain.Cart{
+			ID:                         "customer_cart",
+			BelongsToAuthenticatedUser: true,
+			Deliveries:                 []cartDomain.Delivery{{DeliveryInfo: cartDomain.DeliveryInfo{Code: "home"}}},
+		}
+
+		behaviour := new(mocks.ModifyBehaviour)
+		behaviour.On("UpdateDeliveryInfo", mock.Anything, cart, "home", mock.MatchedBy(func(command cartDomain.DeliveryInfoUpdateCommand) bool {
+			return command.DeliveryInfo.DeliveryLocation.Address.City == "Berlin"
+		})).Return(cart, nil, nil).Once()
+
+		newReceiver(cart, behaviour).Notify(ctx, &events.AddToCartEvent{Cart: cart})
+		behaviour.AssertExpectations(t)
+	})
+
+	t.Run("guest carts and deliveries with location are skipped", func(t *testing.T) {
+		behaviour := new(mocks.ModifyBehaviour)
+		guestCart := &cartDomain.Cart{ID: "guest_cart", Deliveries: []cartDomain.Delivery{{DeliveryInfo: cartDomain.DeliveryInfo{Code: "home"}}}}
+		customerCart := &cartDomain.Cart{
+			ID:                         "customer_cart",
+			BelongsToAuthenticatedUser: true,
+			Deliveries: []cartDomain.Delivery{
+				{DeliveryInfo: cartDomain.DeliveryInfo{Code: "home", DeliveryLocation: cartDomain.DeliveryLocation{Address: &cartDomain.Address{Firstname: "Existing"}}}},
+				{DeliveryInfo: cartDomain.DeliveryInfo{Code: "store", DeliveryLocation: cartDomain.DeliveryLocation{Code: "store-1"}}},
+			},
+		}
+
+		receiver := newReceiver(customerCart, behaviour)
+		receiver.Notify(ctx, &events.AddToCartEvent{Cart: guestCart})
+		receiver.Notify(ctx, &events.AddToCartEvent{Cart: customerCart})
+		behaviour.AssertNotCalled(t, "UpdateDeliveryInfo", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
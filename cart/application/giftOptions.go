@@ -0,0 +1,149 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/web"
+
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+type (
+	// GiftOptionsService handles the gift wrapping of cart items and the gift messages of deliveries
+	GiftOptionsService struct {
+		cartService          *CartService
+		cartReceiverService  *CartReceiverService
+		giftMessageValidator *validation.GiftMessageValidator
+		wrappings            map[string]giftWrapConfig
+	}
+
+	// giftWrapConfig is the configuration representation of an available gift wrapping
+	giftWrapConfig struct {
+		Title string
+		Price float64
+	}
+)
+
+var (
+	// ErrGiftWrapNotFound is returned if the requested gift wrapping is not configured
+	ErrGiftWrapNotFound = errors.New("gift wrap not found")
+)
+
+// Inject dependencies
+func (s *GiftOptionsService) Inject(
+	cartService *CartService,
+	cartReceiverService *CartReceiverService,
+	giftMessageValidator *validation.GiftMessageValidator,
+	config *struct {
+		Wrappings config.Map `inject:"config:commerce.cart.giftOptions.wrappings,optional"`
+	},
+) *GiftOptionsService {
+	s.cartService = cartService
+	s.cartReceiverService = cartReceiverService
+	s.giftMessageValidator = giftMessageValidator
+
+	s.wrappings = make(map[string]giftWrapConfig)
+	if config != nil && config.Wrappings != nil {
+		config.Wrappings.MapInto(&s.wrappings)
+	}
+
+	return s
+}
+
+// GetGiftWrapOptions returns the available gift wrappings with prices in the currency of the current cart
+func (s *GiftOptionsService) GetGiftWrapOptions(ctx context.Context, session *web.Session) ([]cartDomain.GiftWrap, error) {
+	cart, err := s.cartReceiverService.ViewCart(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := cart.DefaultCurrency
+	if currency == "" {
+		currency = cart.GrandTotal().Currency()
+	}
+
+	options := make([]cartDomain.GiftWrap, 0, len(s.wrappings))
+	for code := range s.wrappings {
+		options = append(options, s.giftWrap(code, currency))
+	}
+
+	sort.Slice(options, func(i, j int) bool {
+		return options[i].Code < options[j].Code
+	})
+
+	return options, nil
+}
+
+// GetGiftMessageMaxLength returns the maximum number of characters of a gift message
+func (s *GiftOptionsService) GetGiftMessageMaxLength() int {
+	return s.giftMessageValidator.MaxLength()
+}
+
+// UpdateItemGiftWrap wraps the cart item with the configured gift wrap, an empty code removes the gift wrap
+func (s *GiftOptionsService) UpdateItemGiftWrap(ctx context.Context, session *web.Session, itemID string, giftWrapCode string) error {
+	if giftWrapCode == "" {
+		return s.cartService.UpdateItemGiftWrap(ctx, session, itemID, nil)
+	}
+
+	if _, found := s.wrappings[giftWrapCode]; !found {
+		return ErrGiftWrapNotFound
+	}
+
+	cart, err := s.cartReceiverService.ViewCart(ctx, session)
+	if err != nil {
+		return err
+	}
+
+	item, err := cart.GetByItemID(itemID)
+	if err != nil {
+		return err
+	}
+
+	giftWrap := s.giftWrap(giftWrapCode, item.SinglePriceGross.Currency())
+
+	return s.cartService.UpdateItemGiftWrap(ctx, session, itemID, &giftWrap)
+}
+
+// UpdateDeliveryGiftMessage validates and sets the gift message of the delivery, nil or an empty message removes it
+func (s *GiftOptionsService) UpdateDeliveryGiftMessage(ctx context.Context, session *web.Session, deliveryCode string, giftMessage *cartDomain.GiftMessage) error {
+	if giftMessage != nil && giftMessage.IsEmpty() {
+		giftMessage = nil
+	}
+
+	if giftMessage != nil {
+		err := s.giftMessageValidator.Validate(*giftMessage)
+		if err != nil {
+			return err
+		}
+	}
+
+	cart, err := s.cartReceiverService.ViewCart(ctx, session)
+	if err != nil {
+		return err
+	}
+
+	delivery, found := cart.GetDeliveryByCode(deliveryCode)
+	if !found {
+		return cartDomain.ErrDeliveryCodeNotFound
+	}
+
+	deliveryInfo := delivery.DeliveryInfo
+	deliveryInfo.GiftMessage = giftMessage
+
+	return s.cartService.UpdateDeliveryInfo(ctx, session, deliveryCode, cartDomain.CreateDeliveryInfoUpdateCommand(deliveryInfo))
+}
+
+func (s *GiftOptionsService) giftWrap(code string, currency string) cartDomain.GiftWrap {
+	wrapping := s.wrappings[code]
+
+	return cartDomain.GiftWrap{
+		Code:  code,
+		Title: wrapping.Title,
+		Price: priceDomain.NewFromFloat(wrapping.Price, currency).GetPayable(),
+	}
+}
@@ -0,0 +1,64 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/events"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+)
+
+type (
+	// PurchaseHistoryRecorder records the items of placed orders in the purchase history, used by the purchase limits
+	PurchaseHistoryRecorder struct {
+		logger  flamingo.Logger
+		history validation.PurchaseHistory
+	}
+)
+
+// Inject dependencies
+func (r *PurchaseHistoryRecorder) Inject(
+	logger flamingo.Logger,
+	history validation.PurchaseHistory,
+) *PurchaseHistoryRecorder {
+	r.logger = logger.WithField(flamingo.LogKeyCategory, "cart").WithField(flamingo.LogKeySubCategory, "purchase-history")
+	r.history = history
+
+	return r
+}
+
+// Notify should get called by flamingo Eventlogic
+func (r *PurchaseHistoryRecorder) Notify(ctx context.Context, event flamingo.Event) {
+	orderPlacedEvent, ok := event.(*events.OrderPlacedEvent)
+	if !ok || orderPlacedEvent.Cart == nil {
+		return
+	}
+
+	customer := validation.PurchaseCustomerFromCart(orderPlacedEvent.Cart)
+	if customer.ID == "" && customer.Email == "" {
+		return
+	}
+
+	now := time.Now()
+	var purchases []validation.Purchase
+	for _, delivery := range orderPlacedEvent.Cart.Deliveries {
+		for _, item := range delivery.Cartitems {
+			purchases = append(purchases, validation.Purchase{
+				Customer:        customer,
+				MarketplaceCode: validation.PurchasedMarketplaceCode(item),
+				Qty:             item.Qty,
+				PurchasedAt:     now,
+			})
+		}
+	}
+
+	if len(purchases) == 0 {
+		return
+	}
+
+	if err := r.history.Record(ctx, purchases); err != nil {
+		r.logger.WithContext(ctx).Error("purchases of placed order not recorded: ", err)
+	}
+}
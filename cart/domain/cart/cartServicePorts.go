@@ -37,6 +37,13 @@ type (
 		RestoreCart(ctx context.Context, identity auth.Identity, cart Cart) (*Cart, error)
 	}
 
+	// AdditionalCustomerCartService can be implemented by a customer cart service to create carts of a customer
+	// next to the main cart, e.g. for express checkouts. GetCart must return these carts by their id
+	AdditionalCustomerCartService interface {
+		// GetNewCart - should return a new cart of the customer (including the id of the cart)
+		GetNewCart(ctx context.Context, identity auth.Identity) (*Cart, error)
+	}
+
 	// DeferEvents represents events that should be dispatched after a cart modify call
 	DeferEvents []flamingo.Event
 
@@ -79,6 +86,18 @@ type (
 		ApplyAny(ctx context.Context, cart *Cart, anyCode string) (*Cart, DeferEvents, error)
 	}
 
+	//GiftWrapBehaviour - additional interface that can be implemented to support gift wrapping of cart items
+	GiftWrapBehaviour interface {
+		// UpdateItemGiftWrap sets the gift wrap of the item, nil removes the gift wrap
+		UpdateItemGiftWrap(ctx context.Context, cart *Cart, itemID string, giftWrap *GiftWrap) (*Cart, DeferEvents, error)
+	}
+
+	//CurrencyBehaviour - additional interface that can be implemented to support switching the currency of a cart
+	CurrencyBehaviour interface {
+		// SwitchCurrency re-prices the cart with the prices of the command and sets the new default currency
+		SwitchCurrency(ctx context.Context, cart *Cart, command CurrencySwitchCommand) (*Cart, DeferEvents, error)
+	}
+
 	// AddRequest defines add to cart request
 	AddRequest struct {
 		MarketplaceCode        string
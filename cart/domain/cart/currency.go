@@ -0,0 +1,72 @@
+package cart
+
+import (
+	"errors"
+
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+type (
+	// CurrencySwitchCommand contains the prices of the cart in a new currency
+	CurrencySwitchCommand struct {
+		// Currency - the new currency of the cart
+		Currency string
+		// SinglePrices - the new single price by item id, gross or net depending on commerce.product.priceIsGross
+		SinglePrices map[string]domain.Price
+		// GiftWrapPrices - the new price per unit of the gift wrap by item id
+		GiftWrapPrices map[string]domain.Price
+		// ShippingItems - the new shipping item by delivery code
+		ShippingItems map[string]ShippingItem
+	}
+)
+
+var (
+	// ErrMissingPriceForCurrencySwitch is returned if the currency switch command does not contain a price for every item of the cart
+	ErrMissingPriceForCurrencySwitch = errors.New("currency switch command misses a price of the cart")
+)
+
+// Currency returns the currency of the cart: the default currency if set, otherwise the currency of the first priced item
+func (c Cart) Currency() string {
+	if c.DefaultCurrency != "" {
+		return c.DefaultCurrency
+	}
+
+	for _, delivery := range c.Deliveries {
+		for _, item := range delivery.Cartitems {
+			if currency := item.SinglePriceGross.Currency(); currency != "" {
+				return currency
+			}
+		}
+	}
+
+	return ""
+}
+
+// Validate checks that the command contains a new price for every item, gift wrap and shipping item of the cart
+func (c CurrencySwitchCommand) Validate(cart Cart) error {
+	for _, delivery := range cart.Deliveries {
+		shippingCurrency := delivery.ShippingItem.PriceNet.Currency()
+		if shippingCurrency != "" && shippingCurrency != c.Currency {
+			shippingItem, found := c.ShippingItems[delivery.DeliveryInfo.Code]
+			if !found || shippingItem.PriceNet.Currency() != c.Currency {
+				return ErrMissingPriceForCurrencySwitch
+			}
+		}
+
+		for _, item := range delivery.Cartitems {
+			price, found := c.SinglePrices[item.ID]
+			if !found || price.Currency() != c.Currency {
+				return ErrMissingPriceForCurrencySwitch
+			}
+
+			if item.HasGiftWrap() {
+				giftWrapPrice, found := c.GiftWrapPrices[item.ID]
+				if !found || giftWrapPrice.Currency() != c.Currency {
+					return ErrMissingPriceForCurrencySwitch
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package cart_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+func TestCart_Currency(t *testing.T) {
+	assert.Equal(t, "", cart.Cart{}.Currency())
+
+	c := cart.Cart{
+		Deliveries: []cart.Delivery{
+			{Cartitems: []cart.Item{{ID: "zero"}, {ID: "priced", SinglePriceGross: domain.NewFromFloat(1, "USD")}}},
+		},
+	}
+	assert.Equal(t, "USD", c.Currency())
+
+	c.DefaultCurrency = "EUR"
+	assert.Equal(t, "EUR", c.Currency())
+}
+
+func TestCurrencySwitchCommand_Validate(t *testing.T) {
+	c := cart.Cart{
+		Deliveries: []cart.Delivery{
+			{
+				DeliveryInfo: cart.DeliveryInfo{Code: "delivery"},
+				Cartitems: []cart.Item{
+					{ID: "item", GiftWrap: &cart.GiftWrap{Code: "paper", Price: domain.NewFromFloat(1, "EUR")}},
+				},
+				ShippingItem: cart.ShippingItem{PriceNet: domain.NewFromFloat(5, "EUR")},
+			},
+		},
+	}
+
+	command := cart.CurrencySwitchCommand{
+		Currency:       "USD",
+		SinglePrices:   map[string]domain.Price{"item": domain.NewFromFloat(10, "USD")},
+		GiftWrapPrices: map[string]domain.Price{"item": domain.NewFromFloat(1, "USD")},
+		ShippingItems:  map[string]cart.ShippingItem{"delivery": {PriceNet: domain.NewFromFloat(6, "USD")}},
+	}
+	assert.NoError(t, command.Validate(c))
+
+	command.GiftWrapPrices = nil
+	assert.Equal(t, cart.ErrMissingPriceForCurrencySwitch, command.Validate(c))
+
+	command.GiftWrapPrices = map[string]domain.Price{"item": domain.NewFromFloat(1, "USD")}
+	command.SinglePrices = map[string]domain.Price{"item": domain.NewFromFloat(10, "EUR")}
+	assert.Equal(t, cart.ErrMissingPriceForCurrencySwitch, command.Validate(c))
+}
@@ -38,6 +38,8 @@ type (
 		AdditionalData map[string]string
 		//AdditionalDeliveryInfos - similar to AdditionalData this can be used to store "any" other object on a delivery encoded as json.RawMessage
 		AdditionalDeliveryInfos map[string]json.RawMessage `swaggerignore:"true"`
+		//GiftMessage - Optional a message that should be attached to the delivery as greeting card
+		GiftMessage *GiftMessage
 	}
 
 	// ShippingItem value object
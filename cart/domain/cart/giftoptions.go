@@ -0,0 +1,104 @@
+package cart
+
+import (
+	"sort"
+
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+type (
+	// GiftWrap value object represents a gift wrapping that is available or selected for a cart item
+	GiftWrap struct {
+		// Code - identifier of the wrapping, e.g. "premium_paper"
+		Code  string
+		Title string
+		// Price of the wrapping per unit of the item
+		Price domain.Price
+	}
+
+	// GiftMessage value object represents a greeting card message that is attached to a delivery
+	GiftMessage struct {
+		Recipient string
+		Sender    string
+		Message   string
+	}
+)
+
+// TotalsTypeGiftWrap is the type of the totalitems that contain the gift wrap fees of the cart items
+const TotalsTypeGiftWrap = "totals_type_giftwrap"
+
+// RowPrice returns the gift wrap fee for the given quantity
+func (gw GiftWrap) RowPrice(qty int) domain.Price {
+	return gw.Price.Multiply(qty)
+}
+
+// IsEmpty checks if no text is set in the gift message
+func (gm GiftMessage) IsEmpty() bool {
+	return gm.Recipient == "" && gm.Sender == "" && gm.Message == ""
+}
+
+// GiftWrapTotalitemCode returns the code of the totalitem that contains the gift wrap fee of the given item
+func GiftWrapTotalitemCode(itemID string) string {
+	return "giftwrap_" + itemID
+}
+
+// HasGiftWrap checks if a gift wrap is selected for the item
+func (i Item) HasGiftWrap() bool {
+	return i.GiftWrap != nil
+}
+
+// HasGiftOptions checks if any item of the cart is gift wrapped or any delivery has a gift message
+func (c Cart) HasGiftOptions() bool {
+	for _, delivery := range c.Deliveries {
+		if delivery.DeliveryInfo.GiftMessage != nil {
+			return true
+		}
+		for _, item := range delivery.Cartitems {
+			if item.HasGiftWrap() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// SumGiftWrapFees returns the sum of all gift wrap totalitems of the cart
+func (c Cart) SumGiftWrapFees() domain.Price {
+	prices := make([]domain.Price, 0)
+	for _, totalitem := range c.GetTotalItemsByType(TotalsTypeGiftWrap) {
+		prices = append(prices, totalitem.Price)
+	}
+
+	if len(prices) == 0 {
+		return domain.NewZero(c.DefaultCurrency)
+	}
+
+	sum, _ := domain.SumAll(prices...)
+	return sum
+}
+
+// GiftWrapTotalitems returns one totalitem per gift wrapped item containing the wrapping fee for the item quantity
+// The result is sorted by code, so that it can be used to replace existing gift wrap totalitems of the cart
+func (c Cart) GiftWrapTotalitems() []Totalitem {
+	totalitems := make([]Totalitem, 0)
+	for _, delivery := range c.Deliveries {
+		for _, item := range delivery.Cartitems {
+			if !item.HasGiftWrap() {
+				continue
+			}
+			totalitems = append(totalitems, Totalitem{
+				Code:  GiftWrapTotalitemCode(item.ID),
+				Title: item.GiftWrap.Title,
+				Price: item.GiftWrap.RowPrice(item.Qty),
+				Type:  TotalsTypeGiftWrap,
+			})
+		}
+	}
+
+	sort.Slice(totalitems, func(i, j int) bool {
+		return totalitems[i].Code < totalitems[j].Code
+	})
+
+	return totalitems
+}
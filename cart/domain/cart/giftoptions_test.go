@@ -0,0 +1,59 @@
+package cart_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+func TestCart_GiftWrapTotalitems(t *testing.T) {
+	giftWrap := &cart.GiftWrap{Code: "paper", Title: "Gift paper", Price: domain.NewFromFloat(2.5, "EUR")}
+
+	c := cart.Cart{
+		Deliveries: []cart.Delivery{
+			{
+				DeliveryInfo: cart.DeliveryInfo{Code: "delivery"},
+				Cartitems: []cart.Item{
+					{ID: "b", Qty: 2, GiftWrap: giftWrap},
+					{ID: "c", Qty: 1},
+				},
+			},
+			{
+				DeliveryInfo: cart.DeliveryInfo{Code: "pickup", GiftMessage: &cart.GiftMessage{Message: "Happy Birthday"}},
+				Cartitems: []cart.Item{
+					{ID: "a", Qty: 1, GiftWrap: giftWrap},
+				},
+			},
+		},
+	}
+
+	totalitems := c.GiftWrapTotalitems()
+	assert.Len(t, totalitems, 2)
+	assert.Equal(t, cart.GiftWrapTotalitemCode("a"), totalitems[0].Code)
+	assert.Equal(t, cart.TotalsTypeGiftWrap, totalitems[0].Type)
+	assert.Equal(t, "Gift paper", totalitems[0].Title)
+	assert.True(t, domain.NewFromFloat(2.5, "EUR").Equal(totalitems[0].Price))
+	assert.Equal(t, cart.GiftWrapTotalitemCode("b"), totalitems[1].Code)
+	assert.True(t, domain.NewFromFloat(5, "EUR").Equal(totalitems[1].Price))
+
+	assert.True(t, c.HasGiftOptions())
+	assert.True(t, c.SumGiftWrapFees().IsZero(), "fees are only summed up from existing totalitems")
+
+	c.Totalitems = totalitems
+	assert.True(t, domain.NewFromFloat(7.5, "EUR").Equal(c.SumGiftWrapFees()))
+}
+
+func TestCart_HasGiftOptions(t *testing.T) {
+	c := cart.Cart{
+		Deliveries: []cart.Delivery{
+			{Cartitems: []cart.Item{{ID: "a", Qty: 1}}},
+		},
+	}
+
+	assert.False(t, c.HasGiftOptions())
+	assert.Empty(t, c.GiftWrapTotalitems())
+	assert.True(t, cart.GiftMessage{}.IsEmpty())
+}
@@ -46,6 +46,9 @@ type (
 
 		// AppliedDiscounts contains the details about the discounts applied to this item - they can be "itemrelated" or not
 		AppliedDiscounts AppliedDiscounts
+
+		// GiftWrap - Optional the gift wrapping selected for this item, the fee is part of the cart totalitems
+		GiftWrap *GiftWrap
 	}
 
 	// ItemBuilder can be used to construct an item with a fluent interface
@@ -206,6 +209,7 @@ func (f *ItemBuilder) SetFromItem(item Item) *ItemBuilder {
 	f.AddDiscounts(item.AppliedDiscounts...)
 	f.SetSinglePriceGross(item.SinglePriceGross)
 	f.SetSinglePriceNet(item.SinglePriceNet)
+	f.SetGiftWrap(item.GiftWrap)
 
 	return f
 }
@@ -231,6 +235,13 @@ func (f *ItemBuilder) SetAdditionalData(d map[string]string) *ItemBuilder {
 	return f
 }
 
+// SetGiftWrap - optional, nil removes the gift wrapping
+func (f *ItemBuilder) SetGiftWrap(giftWrap *GiftWrap) *ItemBuilder {
+	f.init()
+	f.itemInBuilding.GiftWrap = giftWrap
+	return f
+}
+
 // SetQty - optional (default 1)
 func (f *ItemBuilder) SetQty(q int) *ItemBuilder {
 	f.init()
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"sort"
 	"strings"
 
@@ -91,6 +92,12 @@ var (
 	// ErrSplitGiftCardsNoChargeTypeMapping indicates that there is no mapping from the gift card charge type to an actual payment method
 	ErrSplitGiftCardsNoChargeTypeMapping = fmt.Errorf("payment method for charge type %q not defined", price.ChargeTypeGiftCard)
 
+	// ErrSplitNoLoyaltyBalance indicates that there is no loyalty balance given to SplitWithLoyaltyPoints
+	ErrSplitNoLoyaltyBalance = errors.New("no loyalty balance available")
+
+	// ErrSplitLoyaltyNoChargeTypeMapping indicates that there is no mapping from a loyalty charge type to an actual payment method
+	ErrSplitLoyaltyNoChargeTypeMapping = errors.New("payment method for loyalty charge type not defined")
+
 	// ErrPaymentSelectionNotSet is used for nil PaymentSelection on cart
 	ErrPaymentSelectionNotSet = errors.New("paymentSelection not set")
 )
@@ -510,6 +517,115 @@ func (service PaymentSplitService) SplitWithGiftCards(chargeTypeToPaymentMethod
 	return &result, nil
 }
 
+// SplitWithLoyaltyPoints creates a PaymentSplitByItem that pays the cart items with the given loyalty charges, e.g. calculated with Saleable.GetLoyaltyChargeSplit,
+// the points of a loyalty type are used up to the given balance of the type, if a charge exceeds the remaining balance its value is reduced proportionally,
+// if it exceeds the remaining value of the item its points are reduced proportionally.
+// The remaining value of the items, shipping and totals is paid with the main charge, a payment method for the main charge is required.
+func (service PaymentSplitService) SplitWithLoyaltyPoints(chargeTypeToPaymentMethod map[string]string, items PricedItems, loyaltyCharges map[string]price.Charges, balances map[string]price.Price) (*PaymentSplitByItem, error) {
+	// guard clause, without balance nothing can be paid with loyalty points
+	if len(balances) == 0 {
+		return nil, ErrSplitNoLoyaltyBalance
+	}
+
+	mainMethod, ok := chargeTypeToPaymentMethod[price.ChargeTypeMain]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrSplitLoyaltyNoChargeTypeMapping, price.ChargeTypeMain)
+	}
+
+	// maps are passed by reference, avoid side effects on passed balances
+	remainingBalances := make(map[string]price.Price, len(balances))
+	for loyaltyType, balance := range balances {
+		remainingBalances[loyaltyType] = balance
+	}
+
+	builder := &PaymentSplitByItemBuilder{}
+	helpers := service.initItemsWithAdd(items, builder)
+	cartItems := helpers[0]
+	for _, k := range service.sortItemsToPayKeys(cartItems.ItemsToPay) {
+		remainingItem := cartItems.ItemsToPay[k]
+
+		for _, charge := range service.sortedLoyaltyCharges(loyaltyCharges[k]) {
+			balance, ok := remainingBalances[charge.Type]
+			if !ok || !balance.IsPositive() || balance.Currency() != charge.Price.Currency() || !remainingItem.IsPositive() {
+				continue
+			}
+
+			method, ok := chargeTypeToPaymentMethod[charge.Type]
+			if !ok {
+				return nil, fmt.Errorf("%w: %q", ErrSplitLoyaltyNoChargeTypeMapping, charge.Type)
+			}
+
+			if charge.Price.IsGreaterThen(balance) {
+				// use the remaining balance and reduce the value in the same ratio
+				ratio := new(big.Float).Quo(balance.Amount(), charge.Price.Amount())
+				charge.Value = price.NewFromBigFloat(*new(big.Float).Mul(charge.Value.Amount(), ratio), charge.Value.Currency()).GetPayable()
+				charge.Price = balance
+			}
+
+			if charge.Value.IsGreaterThen(remainingItem) {
+				// only pay the remaining item and reduce the points in the same ratio
+				ratio := new(big.Float).Quo(remainingItem.Amount(), charge.Value.Amount())
+				charge.Price = price.NewFromBigFloat(*new(big.Float).Mul(charge.Price.Amount(), ratio), charge.Price.Currency()).GetPayable()
+				charge.Value = remainingItem
+			}
+
+			var err error
+			remainingBalances[charge.Type], err = balance.Sub(charge.Price)
+			if err != nil {
+				return nil, err
+			}
+			remainingItem, err = remainingItem.Sub(charge.Value)
+			if err != nil {
+				return nil, err
+			}
+
+			builder = cartItems.AddFunction(k, method, charge)
+		}
+
+		// items paid completely with loyalty points don't need a main charge
+		if remainingItem.IsZero() {
+			continue
+		}
+
+		builder = cartItems.AddFunction(k, mainMethod, price.Charge{
+			Price: remainingItem,
+			Value: remainingItem,
+			Type:  price.ChargeTypeMain,
+		})
+	}
+
+	// shipping and totals are paid with the main charge
+	for _, helper := range helpers[1:] {
+		for k, itemPrice := range helper.ItemsToPay {
+			builder = helper.AddFunction(k, mainMethod, price.Charge{
+				Price: itemPrice,
+				Value: itemPrice,
+				Type:  price.ChargeTypeMain,
+			})
+		}
+	}
+
+	result := builder.Build()
+	return &result, nil
+}
+
+// sortedLoyaltyCharges returns the positive charges that are not of type main, sorted by type to stabilise the usage of the balances
+func (service PaymentSplitService) sortedLoyaltyCharges(charges price.Charges) []price.Charge {
+	var result []price.Charge
+	for _, charge := range charges.Items() {
+		if charge.Type == price.ChargeTypeMain || !charge.Price.IsPositive() {
+			continue
+		}
+		result = append(result, charge)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Type < result[j].Type
+	})
+
+	return result
+}
+
 // clearGiftCardWithItem try to apply complete gift card on item
 // otherwise rest will still be available to spend on applied
 func (service PaymentSplitService) clearGiftCardWithItem(card *AppliedGiftCard, itemPrice *price.Price) (remaining,
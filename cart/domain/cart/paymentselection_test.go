@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/gob"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	price "flamingo.me/flamingo-commerce/v3/price/domain"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPrice_MarshalBinaryForGob(t *testing.T) {
@@ -269,3 +271,83 @@ func TestDefaultPaymentSelection_MarshalJSON(t *testing.T) {
 	actualJSON := fmt.Sprintf("%s", actual)
 	assert.Equal(t, expectedJSON, actualJSON)
 }
+
+func TestPaymentSplitService_SplitWithLoyaltyPoints(t *testing.T) {
+	c := cart.Cart{
+		Deliveries: []cart.Delivery{
+			{
+				DeliveryInfo: cart.DeliveryInfo{Code: "delivery"},
+				Cartitems: []cart.Item{
+					{ID: "a", RowPriceGross: price.NewFromFloat(20, "EUR")},
+					{ID: "b", RowPriceGross: price.NewFromFloat(10, "EUR")},
+				},
+			},
+		},
+		Totalitems: []cart.Totalitem{{Code: "fee", Price: price.NewFromFloat(5, "EUR")}},
+	}
+	chargeTypeToPaymentMethod := map[string]string{price.ChargeTypeMain: "card", "miles": "miles"}
+	loyaltyCharges := map[string]price.Charges{
+		"a": *price.NewCharges(map[string]price.Charge{
+			"miles":              {Type: "miles", Price: price.NewFromFloat(100, "MILES"), Value: price.NewFromFloat(10, "EUR")},
+			price.ChargeTypeMain: {Type: price.ChargeTypeMain, Price: price.NewFromFloat(10, "EUR"), Value: price.NewFromFloat(10, "EUR")},
+		}),
+		"b": *price.NewCharges(map[string]price.Charge{
+			"miles": {Type: "miles", Price: price.NewFromFloat(100, "MILES"), Value: price.NewFromFloat(10, "EUR")},
+		}),
+	}
+	service := cart.PaymentSplitService{}
+
+	t.Run("points are used up to the balance", func(t *testing.T) {
+		balances := map[string]price.Price{"miles": price.NewFromFloat(150, "MILES")}
+		split, err := service.SplitWithLoyaltyPoints(chargeTypeToPaymentMethod, c.GetAllPaymentRequiredItems(), loyaltyCharges, balances)
+		assert.NoError(t, err)
+
+		miles := cart.SplitQualifier{ChargeType: "miles", Method: "miles"}
+		main := cart.SplitQualifier{ChargeType: price.ChargeTypeMain, Method: "card"}
+		assert.Equal(t, 100.0, split.CartItems["a"][miles].Price.FloatAmount())
+		assert.Equal(t, 10.0, split.CartItems["a"][main].Value.FloatAmount())
+		assert.Equal(t, 50.0, split.CartItems["b"][miles].Price.FloatAmount())
+		assert.Equal(t, 5.0, split.CartItems["b"][miles].Value.FloatAmount(), "value is reduced in the ratio of the used points")
+		assert.Equal(t, 5.0, split.CartItems["b"][main].Value.FloatAmount())
+		assert.Equal(t, 5.0, split.TotalItems["fee"][main].Value.FloatAmount())
+		assert.Equal(t, 150.0, balances["miles"].FloatAmount(), "passed balances are not changed")
+		assert.True(t, split.Sum().TotalValue().Equal(c.GrandTotal()))
+	})
+
+	t.Run("points are reduced to the value of the item", func(t *testing.T) {
+		exceedingCharges := map[string]price.Charges{
+			"a": *price.NewCharges(map[string]price.Charge{
+				"miles": {Type: "miles", Price: price.NewFromFloat(300, "MILES"), Value: price.NewFromFloat(30, "EUR")},
+			}),
+		}
+		balances := map[string]price.Price{"miles": price.NewFromFloat(1000, "MILES")}
+		split, err := service.SplitWithLoyaltyPoints(chargeTypeToPaymentMethod, c.GetAllPaymentRequiredItems(), exceedingCharges, balances)
+		require.NoError(t, err)
+
+		miles := cart.SplitQualifier{ChargeType: "miles", Method: "miles"}
+		main := cart.SplitQualifier{ChargeType: price.ChargeTypeMain, Method: "card"}
+		assert.Equal(t, 200.0, split.CartItems["a"][miles].Price.FloatAmount(), "only the points for the value of the item are used")
+		assert.Equal(t, 20.0, split.CartItems["a"][miles].Value.FloatAmount())
+		_, found := split.CartItems["a"][main]
+		assert.False(t, found, "no main charge for items paid completely with points")
+		assert.Equal(t, 10.0, split.CartItems["b"][main].Value.FloatAmount())
+		assert.True(t, split.Sum().TotalValue().Equal(c.GrandTotal()))
+	})
+
+	t.Run("missing payment method for main charge", func(t *testing.T) {
+		balances := map[string]price.Price{"miles": price.NewFromFloat(150, "MILES")}
+		_, err := service.SplitWithLoyaltyPoints(map[string]string{"miles": "miles"}, c.GetAllPaymentRequiredItems(), loyaltyCharges, balances)
+		assert.True(t, errors.Is(err, cart.ErrSplitLoyaltyNoChargeTypeMapping))
+	})
+
+	t.Run("no balance", func(t *testing.T) {
+		_, err := service.SplitWithLoyaltyPoints(chargeTypeToPaymentMethod, c.GetAllPaymentRequiredItems(), loyaltyCharges, nil)
+		assert.Equal(t, cart.ErrSplitNoLoyaltyBalance, err)
+	})
+
+	t.Run("missing payment method for loyalty type", func(t *testing.T) {
+		balances := map[string]price.Price{"miles": price.NewFromFloat(150, "MILES")}
+		_, err := service.SplitWithLoyaltyPoints(map[string]string{price.ChargeTypeMain: "card"}, c.GetAllPaymentRequiredItems(), loyaltyCharges, balances)
+		assert.True(t, errors.Is(err, cart.ErrSplitLoyaltyNoChargeTypeMapping))
+	})
+}
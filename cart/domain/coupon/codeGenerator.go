@@ -0,0 +1,54 @@
+package coupon
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+const (
+	// letters and digits of generated codes, without the easily confused I, O, 0 and 1
+	codeLetters = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+	codeDigits  = "23456789"
+)
+
+var (
+	// ErrInvalidPattern is returned for patterns without placeholders
+	ErrInvalidPattern = errors.New("coupon code pattern needs at least one placeholder")
+)
+
+// GenerateCode generates a random code from the pattern, the placeholders are replaced with random characters:
+// "#" with a digit, "?" with a letter and "*" with a letter or digit, all other characters are kept,
+// e.g. "SUMMER-****-****" generates codes like "SUMMER-K7QD-2XMA"
+func GenerateCode(pattern string) (string, error) {
+	var code strings.Builder
+	placeholders := 0
+	for _, char := range pattern {
+		var charset string
+		switch char {
+		case '#':
+			charset = codeDigits
+		case '?':
+			charset = codeLetters
+		case '*':
+			charset = codeLetters + codeDigits
+		default:
+			code.WriteRune(char)
+			continue
+		}
+
+		placeholders++
+		index, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+		if err != nil {
+			return "", err
+		}
+		code.WriteByte(charset[index.Int64()])
+	}
+
+	if placeholders == 0 {
+		return "", ErrInvalidPattern
+	}
+
+	return code.String(), nil
+}
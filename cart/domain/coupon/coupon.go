@@ -0,0 +1,155 @@
+package coupon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+)
+
+const (
+	// ErrorCodeNotFound is returned for unknown coupon codes
+	ErrorCodeNotFound = "coupon_not_found"
+	// ErrorCodeNotYetValid is returned if the validity window of the coupon has not started
+	ErrorCodeNotYetValid = "coupon_not_yet_valid"
+	// ErrorCodeExpired is returned if the validity window of the coupon is over
+	ErrorCodeExpired = "coupon_expired"
+	// ErrorCodeUsageLimitReached is returned if the coupon has been used the max number of times
+	ErrorCodeUsageLimitReached = "coupon_usage_limit_reached"
+	// ErrorCodeCustomerUsageLimitReached is returned if the customer has used the coupon the max number of times
+	ErrorCodeCustomerUsageLimitReached = "coupon_customer_usage_limit_reached"
+	// ErrorCodeNotCombinable is returned if the coupon can not be combined with the coupons applied to the cart
+	ErrorCodeNotCombinable = "coupon_not_combinable"
+	// ErrorCodeAlreadyApplied is returned if the coupon is already applied to the cart
+	ErrorCodeAlreadyApplied = "coupon_already_applied"
+	// ErrorCodeCustomerRequired is returned if a coupon with a limit per customer is used in an order without customer id or email
+	ErrorCodeCustomerRequired = "coupon_customer_required"
+)
+
+type (
+	// Repository is the secondary port that stores the coupons
+	Repository interface {
+		// FindByCode returns the coupon or ErrNotFound
+		FindByCode(ctx context.Context, code string) (*Coupon, error)
+		// Save adds or replaces the coupons
+		Save(ctx context.Context, coupons ...Coupon) error
+	}
+
+	// UsageStore is the secondary port that counts the usages of coupons in placed orders
+	UsageStore interface {
+		// Reserve atomically checks the usage limits of the rules and stores the usage of the cart,
+		// an *Error is returned if a limit is reached. Reserving the same code for the same cart again is a no-op
+		Reserve(ctx context.Context, usage Usage, rules Rules) error
+		// Confirm assigns the placed orders to the reserved usages of the cart
+		Confirm(ctx context.Context, cartID string, orderNumbers []string) error
+		// ReleaseCart removes the reserved usages of the cart that are not confirmed
+		ReleaseCart(ctx context.Context, cartID string) error
+		// Release removes the usages of cancelled orders, a usage is removed if one of its orders is cancelled
+		Release(ctx context.Context, orderNumbers []string) error
+		// CountUses returns how often the coupon has been used, reserved usages included
+		CountUses(ctx context.Context, code string) (int, error)
+		// CountCustomerUses returns how often the customer used the coupon, matched by customer id or email
+		CountCustomerUses(ctx context.Context, code string, customer validation.PurchaseCustomer) (int, error)
+	}
+
+	// UsageReserver reserves the usages of the coupons applied to a cart while its orders are placed
+	UsageReserver interface {
+		// ReserveUsages validates the applied coupons again and reserves their usages
+		ReserveUsages(ctx context.Context, c *cart.Cart) error
+		// ConfirmUsages assigns the placed orders to the reserved usages
+		ConfirmUsages(ctx context.Context, cartID string, orderNumbers []string) error
+		// ReleaseReservedUsages frees the reserved usages if the orders could not be placed
+		ReleaseReservedUsages(ctx context.Context, cartID string) error
+	}
+
+	// Rules of a coupon, shared by all coupons of a batch
+	Rules struct {
+		// PromotionCode links the coupon to the promotion rule that grants the discount
+		PromotionCode string
+		// ValidFrom and ValidUntil define the validity window, zero values are unlimited
+		ValidFrom  time.Time
+		ValidUntil time.Time
+		// MaxUses in total and per customer, zero is unlimited
+		MaxUses            int
+		MaxUsesPerCustomer int
+		// NotCombinable coupons can not be applied together with other coupons
+		NotCombinable bool
+	}
+
+	// Batch of coupons generated with the same rules
+	Batch struct {
+		Code string
+		// Pattern of the generated codes, see GenerateCode
+		Pattern string
+		Rules
+	}
+
+	// Coupon that can be applied as voucher to the cart
+	Coupon struct {
+		Code      string
+		BatchCode string
+		Rules
+	}
+
+	// Usage of a coupon, reserved for a cart and confirmed with the orders of the placed cart
+	Usage struct {
+		Code         string
+		Customer     validation.PurchaseCustomer
+		CartID       string
+		OrderNumbers []string
+		UsedAt       time.Time
+	}
+
+	// Error with a specific code why the coupon can not be applied
+	Error struct {
+		Code       string
+		CouponCode string
+	}
+)
+
+var (
+	// ErrNotFound is returned by the Repository for unknown codes
+	ErrNotFound = errors.New("coupon not found")
+)
+
+// Error returns the error message
+func (e *Error) Error() string {
+	return fmt.Sprintf("coupon %q can not be applied: %s", e.CouponCode, e.Code)
+}
+
+// MessageCode returns a code that can be used to show a translated message
+func (e *Error) MessageCode() string {
+	return e.Code
+}
+
+// AppliedCouponCode returns the coupon code for the cart with the promotion and batch as custom attributes
+func (c Coupon) AppliedCouponCode() cart.CouponCode {
+	return cart.CouponCode{
+		Code: c.Code,
+		CustomAttributes: map[string]interface{}{
+			CustomAttributePromotionCode: c.PromotionCode,
+			CustomAttributeBatchCode:     c.BatchCode,
+		},
+	}
+}
+
+// IsValidAt checks the validity window of the coupon and returns the error code if the coupon is not valid
+func (r Rules) IsValidAt(now time.Time) (bool, string) {
+	if !r.ValidFrom.IsZero() && now.Before(r.ValidFrom) {
+		return false, ErrorCodeNotYetValid
+	}
+
+	if !r.ValidUntil.IsZero() && now.After(r.ValidUntil) {
+		return false, ErrorCodeExpired
+	}
+
+	return true, ""
+}
+
+// IsUsedBy checks if the usage belongs to the customer, matched by customer id or email
+func (u Usage) IsUsedBy(customer validation.PurchaseCustomer) bool {
+	return (customer.ID != "" && u.Customer.ID == customer.ID) || (customer.Email != "" && u.Customer.Email == customer.Email)
+}
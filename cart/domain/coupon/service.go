@@ -0,0 +1,234 @@
+package coupon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+)
+
+const (
+	// CustomAttributePromotionCode is the key of the promotion code in the custom attributes of the applied coupon code
+	CustomAttributePromotionCode = "promotionCode"
+	// CustomAttributeBatchCode is the key of the batch code in the custom attributes of the applied coupon code
+	CustomAttributeBatchCode = "batchCode"
+
+	// maxGenerateTries limits the tries to find an unused code per generated coupon
+	maxGenerateTries = 10
+)
+
+type (
+	// Service validates coupons against the cart and tracks their usage
+	Service struct {
+		repository Repository
+		usageStore UsageStore
+		now        func() time.Time
+	}
+)
+
+var _ UsageReserver = new(Service)
+
+// Inject dependencies
+func (s *Service) Inject(
+	repository Repository,
+	usageStore UsageStore,
+) *Service {
+	s.repository = repository
+	s.usageStore = usageStore
+	s.now = time.Now
+
+	return s
+}
+
+// Validate checks if the coupon can be applied to the cart, an *Error with a specific code is returned otherwise
+func (s *Service) Validate(ctx context.Context, c *cart.Cart, code string) (*Coupon, error) {
+	for _, applied := range c.AppliedCouponCodes {
+		if strings.EqualFold(applied.Code, code) {
+			return nil, &Error{Code: ErrorCodeAlreadyApplied, CouponCode: code}
+		}
+	}
+
+	coupon, err := s.repository.FindByCode(ctx, code)
+	if errors.Is(err, ErrNotFound) {
+		return nil, &Error{Code: ErrorCodeNotFound, CouponCode: code}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if valid, errorCode := coupon.IsValidAt(s.now()); !valid {
+		return nil, &Error{Code: errorCode, CouponCode: code}
+	}
+
+	if err := s.checkCombination(ctx, c, coupon); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkUsageLimits(ctx, c, coupon); err != nil {
+		return nil, err
+	}
+
+	return coupon, nil
+}
+
+// ReserveUsages validates the coupons applied to the cart again and atomically reserves their usages before the orders are placed.
+// Coupons with a limit per customer require the customer id or email, all reservations of the cart are released if one coupon fails
+func (s *Service) ReserveUsages(ctx context.Context, c *cart.Cart) error {
+	customer := validation.PurchaseCustomerFromCart(c)
+	now := s.now()
+
+	for _, applied := range c.AppliedCouponCodes {
+		coupon, err := s.repository.FindByCode(ctx, applied.Code)
+		if errors.Is(err, ErrNotFound) {
+			// not managed by the coupon subsystem
+			continue
+		}
+		if err == nil {
+			err = s.reserveUsage(ctx, coupon, Usage{Code: applied.Code, Customer: customer, CartID: c.ID, UsedAt: now})
+		}
+
+		if err != nil {
+			if releaseErr := s.usageStore.ReleaseCart(ctx, c.ID); releaseErr != nil {
+				return fmt.Errorf("%w, reserved coupon usages not released: %v", err, releaseErr)
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ConfirmUsages assigns the placed orders to the reserved usages of the cart
+func (s *Service) ConfirmUsages(ctx context.Context, cartID string, orderNumbers []string) error {
+	return s.usageStore.Confirm(ctx, cartID, orderNumbers)
+}
+
+// ReleaseReservedUsages frees the reserved usages of a cart whose orders could not be placed
+func (s *Service) ReleaseReservedUsages(ctx context.Context, cartID string) error {
+	return s.usageStore.ReleaseCart(ctx, cartID)
+}
+
+// ReleaseUsages frees the usages of cancelled orders
+func (s *Service) ReleaseUsages(ctx context.Context, orderNumbers []string) error {
+	return s.usageStore.Release(ctx, orderNumbers)
+}
+
+// GenerateBatch generates and saves count coupons with the pattern and rules of the batch
+func (s *Service) GenerateBatch(ctx context.Context, batch Batch, count int) ([]Coupon, error) {
+	coupons := make([]Coupon, 0, count)
+	generated := make(map[string]bool, count)
+
+	for len(coupons) < count {
+		code, err := s.generateUnusedCode(ctx, batch.Pattern, generated)
+		if err != nil {
+			return nil, err
+		}
+
+		generated[code] = true
+		coupons = append(coupons, Coupon{Code: code, BatchCode: batch.Code, Rules: batch.Rules})
+	}
+
+	if err := s.repository.Save(ctx, coupons...); err != nil {
+		return nil, err
+	}
+
+	return coupons, nil
+}
+
+// generateUnusedCode generates a code that is neither stored nor part of the generated codes
+func (s *Service) generateUnusedCode(ctx context.Context, pattern string, generated map[string]bool) (string, error) {
+	for i := 0; i < maxGenerateTries; i++ {
+		code, err := GenerateCode(pattern)
+		if err != nil {
+			return "", err
+		}
+
+		if generated[code] {
+			continue
+		}
+
+		_, err = s.repository.FindByCode(ctx, code)
+		if errors.Is(err, ErrNotFound) {
+			return code, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return "", fmt.Errorf("no unused code found for pattern %q, use more placeholders", pattern)
+}
+
+// checkCombination ensures that not combinable coupons are the only coupon of the cart
+func (s *Service) checkCombination(ctx context.Context, c *cart.Cart, coupon *Coupon) error {
+	if len(c.AppliedCouponCodes) == 0 {
+		return nil
+	}
+
+	if coupon.NotCombinable {
+		return &Error{Code: ErrorCodeNotCombinable, CouponCode: coupon.Code}
+	}
+
+	for _, applied := range c.AppliedCouponCodes {
+		appliedCoupon, err := s.repository.FindByCode(ctx, applied.Code)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if appliedCoupon.NotCombinable {
+			return &Error{Code: ErrorCodeNotCombinable, CouponCode: coupon.Code}
+		}
+	}
+
+	return nil
+}
+
+// reserveUsage checks the validity window and the customer of the usage, the store checks the usage limits atomically
+func (s *Service) reserveUsage(ctx context.Context, coupon *Coupon, usage Usage) error {
+	if valid, errorCode := coupon.IsValidAt(usage.UsedAt); !valid {
+		return &Error{Code: errorCode, CouponCode: usage.Code}
+	}
+
+	if coupon.MaxUsesPerCustomer > 0 && usage.Customer.ID == "" && usage.Customer.Email == "" {
+		return &Error{Code: ErrorCodeCustomerRequired, CouponCode: usage.Code}
+	}
+
+	return s.usageStore.Reserve(ctx, usage, coupon.Rules)
+}
+
+// checkUsageLimits checks the total usages and the usages of the customer, if the customer of the cart is known.
+// The customer may be entered after the coupon is applied, ReserveUsages requires it when the order is placed
+func (s *Service) checkUsageLimits(ctx context.Context, c *cart.Cart, coupon *Coupon) error {
+	if coupon.MaxUses > 0 {
+		uses, err := s.usageStore.CountUses(ctx, coupon.Code)
+		if err != nil {
+			return err
+		}
+
+		if uses >= coupon.MaxUses {
+			return &Error{Code: ErrorCodeUsageLimitReached, CouponCode: coupon.Code}
+		}
+	}
+
+	customer := validation.PurchaseCustomerFromCart(c)
+	if coupon.MaxUsesPerCustomer > 0 && (customer.ID != "" || customer.Email != "") {
+		uses, err := s.usageStore.CountCustomerUses(ctx, coupon.Code, customer)
+		if err != nil {
+			return err
+		}
+
+		if uses >= coupon.MaxUsesPerCustomer {
+			return &Error{Code: ErrorCodeCustomerUsageLimitReached, CouponCode: coupon.Code}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package coupon_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/coupon"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+)
+
+type (
+	fakeRepository struct {
+		coupons map[string]coupon.Coupon
+	}
+
+	fakeUsageStore struct {
+		usages []coupon.Usage
+	}
+)
+
+func (r *fakeRepository) FindByCode(_ context.Context, code string) (*coupon.Coupon, error) {
+	found, ok := r.coupons[code]
+	if !ok {
+		return nil, coupon.ErrNotFound
+	}
+
+	return &found, nil
+}
+
+func (r *fakeRepository) Save(_ context.Context, coupons ...coupon.Coupon) error {
+	for _, c := range coupons {
+		r.coupons[c.Code] = c
+	}
+
+	return nil
+}
+
+func (s *fakeUsageStore) Reserve(_ context.Context, usage coupon.Usage, rules coupon.Rules) error {
+	uses, customerUses := 0, 0
+	for _, stored := range s.usages {
+		if stored.Code != usage.Code {
+			continue
+		}
+
+		if stored.CartID == usage.CartID && len(stored.OrderNumbers) == 0 {
+			return nil
+		}
+
+		uses++
+		if stored.IsUsedBy(usage.Customer) {
+			customerUses++
+		}
+	}
+
+	if rules.MaxUses > 0 && uses >= rules.MaxUses {
+		return &coupon.Error{Code: coupon.ErrorCodeUsageLimitReached, CouponCode: usage.Code}
+	}
+
+	if rules.MaxUsesPerCustomer > 0 && customerUses >= rules.MaxUsesPerCustomer {
+		return &coupon.Error{Code: coupon.ErrorCodeCustomerUsageLimitReached, CouponCode: usage.Code}
+	}
+
+	s.usages = append(s.usages, usage)
+
+	return nil
+}
+
+func (s *fakeUsageStore) Confirm(_ context.Context, cartID string, orderNumbers []string) error {
+	for i, usage := range s.usages {
+		if usage.CartID == cartID && len(usage.OrderNumbers) == 0 {
+			s.usages[i].OrderNumbers = orderNumbers
+		}
+	}
+
+	return nil
+}
+
+func (s *fakeUsageStore) ReleaseCart(_ context.Context, cartID string) error {
+	var usages []coupon.Usage
+	for _, usage := range s.usages {
+		if usage.CartID != cartID || len(usage.OrderNumbers) > 0 {
+			usages = append(usages, usage)
+		}
+	}
+	s.usages = usages
+
+	return nil
+}
+
+func (s *fakeUsageStore) Release(_ context.Context, orderNumbers []string) error {
+	var usages []coupon.Usage
+	for _, usage := range s.usages {
+		if !containsAny(usage.OrderNumbers, orderNumbers) {
+			usages = append(usages, usage)
+		}
+	}
+	s.usages = usages
+
+	return nil
+}
+
+func (s *fakeUsageStore) CountUses(_ context.Context, code string) (int, error) {
+	uses := 0
+	for _, usage := range s.usages {
+		if usage.Code == code {
+			uses++
+		}
+	}
+
+	return uses, nil
+}
+
+func (s *fakeUsageStore) CountCustomerUses(_ context.Context, code string, customer validation.PurchaseCustomer) (int, error) {
+	uses := 0
+	for _, usage := range s.usages {
+		if usage.Code == code && usage.IsUsedBy(customer) {
+			uses++
+		}
+	}
+
+	return uses, nil
+}
+
+func containsAny(values []string, searched []string) bool {
+	for _, value := range values {
+		for _, search := range searched {
+			if value == search {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func TestService_Validate(t *testing.T) {
+	ctx := context.Background()
+	repository := &fakeRepository{coupons: map[string]coupon.Coupon{
+		"SUMMER":    {Code: "SUMMER", Rules: coupon.Rules{PromotionCode: "summer-sale", MaxUses: 2, MaxUsesPerCustomer: 1}},
+		"FUTURE":    {Code: "FUTURE", Rules: coupon.Rules{ValidFrom: time.Now().Add(time.Hour)}},
+		"EXPIRED":   {Code: "EXPIRED", Rules: coupon.Rules{ValidUntil: time.Now().Add(-time.Hour)}},
+		"EXCLUSIVE": {Code: "EXCLUSIVE", Rules: coupon.Rules{NotCombinable: true}},
+		"OTHER":     {Code: "OTHER"},
+	}}
+	usageStore := &fakeUsageStore{}
+	service := new(coupon.Service).Inject(repository, usageStore)
+
+	guestCart := func(email string, applied ...string) *cart.Cart {
+		c := &cart.Cart{BillingAddress: &cart.Address{Email: email}}
+		for _, code := range applied {
+			c.AppliedCouponCodes = append(c.AppliedCouponCodes, cart.CouponCode{Code: code})
+		}
+
+		return c
+	}
+
+	assertErrorCode := func(t *testing.T, expectedCode string, err error) {
+		t.Helper()
+		couponErr, ok := err.(*coupon.Error)
+		require.True(t, ok, "expected *coupon.Error, got %v", err)
+		assert.Equal(t, expectedCode, couponErr.MessageCode())
+	}
+
+	_, err := service.Validate(ctx, guestCart(""), "UNKNOWN")
+	assertErrorCode(t, coupon.ErrorCodeNotFound, err)
+
+	_, err = service.Validate(ctx, guestCart(""), "FUTURE")
+	assertErrorCode(t, coupon.ErrorCodeNotYetValid, err)
+
+	_, err = service.Validate(ctx, guestCart(""), "EXPIRED")
+	assertErrorCode(t, coupon.ErrorCodeExpired, err)
+
+	_, err = service.Validate(ctx, guestCart("", "summer"), "SUMMER")
+	assertErrorCode(t, coupon.ErrorCodeAlreadyApplied, err)
+
+	_, err = service.Validate(ctx, guestCart("", "OTHER"), "EXCLUSIVE")
+	assertErrorCode(t, coupon.ErrorCodeNotCombinable, err)
+
+	_, err = service.Validate(ctx, guestCart("", "EXCLUSIVE"), "OTHER")
+	assertErrorCode(t, coupon.ErrorCodeNotCombinable, err)
+
+	valid, err := service.Validate(ctx, guestCart("a@example.com", "OTHER"), "SUMMER")
+	require.NoError(t, err)
+	assert.Equal(t, "summer-sale", valid.AppliedCouponCode().CustomAttributes[coupon.CustomAttributePromotionCode])
+
+	// usages are reserved while the order is placed and released if one of its orders is cancelled
+	placedCart := guestCart("a@example.com", "SUMMER", "NOT-MANAGED")
+	placedCart.ID = "cart-1"
+	require.NoError(t, service.ReserveUsages(ctx, placedCart))
+	require.NoError(t, service.ReserveUsages(ctx, placedCart), "reserving the same cart again is a no-op")
+	assert.Len(t, usageStore.usages, 1)
+	require.NoError(t, service.ConfirmUsages(ctx, "cart-1", []string{"order-1", "order-2"}))
+
+	_, err = service.Validate(ctx, guestCart("a@example.com"), "SUMMER")
+	assertErrorCode(t, coupon.ErrorCodeCustomerUsageLimitReached, err)
+
+	secondCart := guestCart("b@example.com", "SUMMER")
+	secondCart.ID = "cart-2"
+	require.NoError(t, service.ReserveUsages(ctx, secondCart))
+	_, err = service.Validate(ctx, guestCart("c@example.com"), "SUMMER")
+	assertErrorCode(t, coupon.ErrorCodeUsageLimitReached, err)
+
+	require.NoError(t, service.ReleaseReservedUsages(ctx, "cart-2"))
+	_, err = service.Validate(ctx, guestCart("c@example.com"), "SUMMER")
+	assert.NoError(t, err)
+
+	require.NoError(t, service.ReleaseUsages(ctx, []string{"order-2"}))
+	_, err = service.Validate(ctx, guestCart("a@example.com"), "SUMMER")
+	assert.NoError(t, err)
+}
+
+func TestService_ReserveUsages(t *testing.T) {
+	ctx := context.Background()
+	repository := &fakeRepository{coupons: map[string]coupon.Coupon{
+		"SUMMER":  {Code: "SUMMER", Rules: coupon.Rules{MaxUses: 1, MaxUsesPerCustomer: 1}},
+		"EXPIRED": {Code: "EXPIRED", Rules: coupon.Rules{ValidUntil: time.Now().Add(-time.Hour)}},
+		"OTHER":   {Code: "OTHER", Rules: coupon.Rules{MaxUses: 1}},
+	}}
+
+	placedCart := func(id string, email string, applied ...string) *cart.Cart {
+		c := &cart.Cart{ID: id, BillingAddress: &cart.Address{Email: email}}
+		for _, code := range applied {
+			c.AppliedCouponCodes = append(c.AppliedCouponCodes, cart.CouponCode{Code: code})
+		}
+
+		return c
+	}
+
+	assertErrorCode := func(t *testing.T, expectedCode string, err error) {
+		t.Helper()
+		couponErr, ok := err.(*coupon.Error)
+		require.True(t, ok, "expected *coupon.Error, got %v", err)
+		assert.Equal(t, expectedCode, couponErr.MessageCode())
+	}
+
+	t.Run("coupons with a limit per customer require the customer", func(t *testing.T) {
+		usageStore := &fakeUsageStore{}
+		service := new(coupon.Service).Inject(repository, usageStore)
+
+		assertErrorCode(t, coupon.ErrorCodeCustomerRequired, service.ReserveUsages(ctx, placedCart("cart-1", "", "SUMMER")))
+		assert.Empty(t, usageStore.usages)
+	})
+
+	t.Run("coupons that expired after they were applied are rejected", func(t *testing.T) {
+		usageStore := &fakeUsageStore{}
+		service := new(coupon.Service).Inject(repository, usageStore)
+
+		assertErrorCode(t, coupon.ErrorCodeExpired, service.ReserveUsages(ctx, placedCart("cart-1", "a@example.com", "EXPIRED")))
+	})
+
+	t.Run("all reservations of the cart are released if one coupon fails", func(t *testing.T) {
+		usageStore := &fakeUsageStore{}
+		service := new(coupon.Service).Inject(repository, usageStore)
+
+		require.NoError(t, service.ReserveUsages(ctx, placedCart("cart-1", "a@example.com", "OTHER")))
+		assertErrorCode(t, coupon.ErrorCodeUsageLimitReached, service.ReserveUsages(ctx, placedCart("cart-2", "b@example.com", "SUMMER", "OTHER")))
+		assert.Len(t, usageStore.usages, 1)
+		assert.Equal(t, "cart-1", usageStore.usages[0].CartID)
+	})
+}
+
+func TestService_GenerateBatch(t *testing.T) {
+	repository := &fakeRepository{coupons: map[string]coupon.Coupon{}}
+	service := new(coupon.Service).Inject(repository, &fakeUsageStore{})
+
+	batch := coupon.Batch{Code: "newsletter", Pattern: "NL-###-**", Rules: coupon.Rules{MaxUses: 1, PromotionCode: "newsletter-10"}}
+	coupons, err := service.GenerateBatch(context.Background(), batch, 20)
+	require.NoError(t, err)
+	assert.Len(t, coupons, 20)
+	assert.Len(t, repository.coupons, 20)
+
+	for _, generated := range coupons {
+		assert.Regexp(t, regexp.MustCompile(`^NL-[2-9]{3}-[A-Z2-9]{2}$`), generated.Code)
+		assert.Equal(t, "newsletter", generated.BatchCode)
+		assert.Equal(t, batch.Rules, generated.Rules)
+	}
+
+	_, err = service.GenerateBatch(context.Background(), coupon.Batch{Pattern: "STATIC"}, 1)
+	assert.Equal(t, coupon.ErrInvalidPattern, err)
+}
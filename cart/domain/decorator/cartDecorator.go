@@ -14,8 +14,9 @@ import (
 type (
 	// DecoratedCartFactory - Factory to be injected: If you need to create a new Decorator then get the factory injected and use the factory
 	DecoratedCartFactory struct {
-		productService domain.ProductService
-		logger         flamingo.Logger
+		productService            domain.ProductService
+		logger                    flamingo.Logger
+		loyaltyEarningsCalculator *LoyaltyEarningsCalculator
 	}
 
 	// DecoratedCart Decorates Access To a Cart
@@ -24,6 +25,8 @@ type (
 		DecoratedDeliveries []DecoratedDelivery
 		Ctx                 context.Context `json:"-"`
 		Logger              flamingo.Logger `json:"-"`
+
+		loyaltyEarningsCalculator *LoyaltyEarningsCalculator
 	}
 
 	// DecoratedDelivery Decorates a CartItem with its Product
@@ -51,14 +54,16 @@ type (
 func (df *DecoratedCartFactory) Inject(
 	productService domain.ProductService,
 	logger flamingo.Logger,
+	loyaltyEarningsCalculator *LoyaltyEarningsCalculator,
 ) {
 	df.productService = productService
 	df.logger = logger
+	df.loyaltyEarningsCalculator = loyaltyEarningsCalculator
 }
 
 // Create Factory method to get Decorated Cart
 func (df *DecoratedCartFactory) Create(ctx context.Context, Cart cart.Cart) *DecoratedCart {
-	decoratedCart := DecoratedCart{Cart: Cart, Logger: df.logger}
+	decoratedCart := DecoratedCart{Cart: Cart, Logger: df.logger, loyaltyEarningsCalculator: df.loyaltyEarningsCalculator}
 	for _, d := range Cart.Deliveries {
 		decoratedCart.DecoratedDeliveries = append(decoratedCart.DecoratedDeliveries, DecoratedDelivery{
 			Delivery:       d,
@@ -221,6 +226,21 @@ func (dc DecoratedCart) GetDecoratedDeliveryByCodeWithoutBool(deliveryCode strin
 	return decoratedDelivery
 }
 
+// LoyaltyEarnings returns the loyalty points the cart earns after discounts, per item and per loyalty type
+func (dc DecoratedCart) LoyaltyEarnings() CartLoyaltyEarnings {
+	calculator := dc.loyaltyEarningsCalculator
+	if calculator == nil {
+		calculator = new(LoyaltyEarningsCalculator).Inject(nil)
+	}
+
+	earnings, err := calculator.Calculate(dc)
+	if err != nil && dc.Logger != nil {
+		dc.Logger.WithField(flamingo.LogKeyCategory, "loyaltyEarnings").Error(err)
+	}
+
+	return earnings
+}
+
 // GetGroupedBy getter
 func (dc DecoratedDelivery) GetGroupedBy(group string, sortGroup bool, params ...string) []*GroupedDecoratedCartItem {
 	groupedItemsCollection := make(map[string]*GroupedDecoratedCartItem)
@@ -0,0 +1,233 @@
+package decorator
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+	"flamingo.me/flamingo-commerce/v3/product/domain"
+)
+
+const (
+	// LoyaltyPaidItemsProportional reduces the earnings of an item by the share that is paid with loyalty points
+	LoyaltyPaidItemsProportional = "proportional"
+	// LoyaltyPaidItemsExclude excludes items that are (partially) paid with loyalty points from earning
+	LoyaltyPaidItemsExclude = "exclude"
+	// LoyaltyPaidItemsInclude ignores the payment with loyalty points
+	LoyaltyPaidItemsInclude = "include"
+
+	defaultLoyaltyEarningsRoundingMode      = priceDomain.RoundingModeFloor
+	defaultLoyaltyEarningsRoundingPrecision = 1
+)
+
+type (
+	// LoyaltyEarningsCalculator aggregates the loyalty earnings of the products in the cart
+	LoyaltyEarningsCalculator struct {
+		roundingMode      string
+		roundingPrecision int
+		loyaltyPaidItems  string
+	}
+
+	// LoyaltyEarning is the amount of points of a loyalty type that is earned
+	LoyaltyEarning struct {
+		Type    string
+		Earning priceDomain.Price
+	}
+
+	// ItemLoyaltyEarnings are the loyalty earnings of a single cart item
+	ItemLoyaltyEarnings struct {
+		ItemID       string
+		DeliveryCode string
+		Earnings     []LoyaltyEarning
+	}
+
+	// CartLoyaltyEarnings is the aggregated view of the points the cart earns, per item and per loyalty type
+	CartLoyaltyEarnings struct {
+		Items  []ItemLoyaltyEarnings
+		Totals []LoyaltyEarning
+	}
+)
+
+// Inject dependencies
+func (c *LoyaltyEarningsCalculator) Inject(
+	config *struct {
+		RoundingMode      string  `inject:"config:commerce.cart.loyaltyEarnings.roundingMode,optional"`
+		RoundingPrecision float64 `inject:"config:commerce.cart.loyaltyEarnings.roundingPrecision,optional"`
+		LoyaltyPaidItems  string  `inject:"config:commerce.cart.loyaltyEarnings.loyaltyPaidItems,optional"`
+	},
+) *LoyaltyEarningsCalculator {
+	c.roundingMode = defaultLoyaltyEarningsRoundingMode
+	c.roundingPrecision = defaultLoyaltyEarningsRoundingPrecision
+	c.loyaltyPaidItems = LoyaltyPaidItemsProportional
+
+	if config != nil {
+		if config.RoundingMode != "" {
+			c.roundingMode = config.RoundingMode
+		}
+		if config.RoundingPrecision > 0 {
+			c.roundingPrecision = int(config.RoundingPrecision)
+		}
+		if config.LoyaltyPaidItems != "" {
+			c.loyaltyPaidItems = config.LoyaltyPaidItems
+		}
+	}
+
+	return c
+}
+
+// Calculate returns the loyalty earnings of all items of the cart
+// The earnings of the products are multiplied with the qty and reduced by the share of the discounts of the item.
+// Earnings of a loyalty type in a different currency can't be summed up, they are left out of the totals and an error is returned.
+func (c *LoyaltyEarningsCalculator) Calculate(dc DecoratedCart) (CartLoyaltyEarnings, error) {
+	result := CartLoyaltyEarnings{
+		Items:  make([]ItemLoyaltyEarnings, 0),
+		Totals: make([]LoyaltyEarning, 0),
+	}
+
+	var err error
+	totals := make(map[string]priceDomain.Price)
+	for _, delivery := range dc.DecoratedDeliveries {
+		for _, item := range delivery.DecoratedItems {
+			earnings := c.calculateItem(dc, item)
+			if len(earnings) == 0 {
+				continue
+			}
+
+			result.Items = append(result.Items, ItemLoyaltyEarnings{
+				ItemID:       item.Item.ID,
+				DeliveryCode: delivery.Delivery.DeliveryInfo.Code,
+				Earnings:     earnings,
+			})
+
+			for _, earning := range earnings {
+				total, found := totals[earning.Type]
+				if !found {
+					totals[earning.Type] = earning.Earning
+					continue
+				}
+				sum, addErr := total.Add(earning.Earning)
+				if addErr != nil {
+					err = fmt.Errorf("loyalty earning of item %q with type %q in currency %q not added to the total in currency %q: %w",
+						item.Item.ID, earning.Type, earning.Earning.Currency(), total.Currency(), addErr)
+					continue
+				}
+				totals[earning.Type] = sum
+			}
+		}
+	}
+
+	for loyaltyType, total := range totals {
+		result.Totals = append(result.Totals, LoyaltyEarning{Type: loyaltyType, Earning: total})
+	}
+	sort.Slice(result.Totals, func(i, j int) bool {
+		return result.Totals[i].Type < result.Totals[j].Type
+	})
+
+	return result, err
+}
+
+func (c *LoyaltyEarningsCalculator) calculateItem(dc DecoratedCart, item DecoratedCartItem) []LoyaltyEarning {
+	if item.Product == nil {
+		return nil
+	}
+
+	saleable := item.Product.SaleableData()
+	if len(saleable.LoyaltyEarnings) == 0 {
+		return nil
+	}
+
+	factor := c.discountFactor(item)
+
+	loyaltyPaidShare := c.loyaltyPaidShare(dc, item.Item.ID, saleable)
+	switch c.loyaltyPaidItems {
+	case LoyaltyPaidItemsExclude:
+		if loyaltyPaidShare.Sign() > 0 {
+			return nil
+		}
+	case LoyaltyPaidItemsProportional:
+		factor.Mul(factor, new(big.Float).Sub(big.NewFloat(1), loyaltyPaidShare))
+	}
+
+	earnings := make([]LoyaltyEarning, 0, len(saleable.LoyaltyEarnings))
+	for _, earningInfo := range saleable.LoyaltyEarnings {
+		rowEarning := earningInfo.Default.Multiply(item.Item.Qty)
+		earning := priceDomain.NewFromBigFloat(*new(big.Float).Mul(rowEarning.Amount(), factor), rowEarning.Currency()).
+			GetPayableByRoundingMode(c.roundingMode, c.roundingPrecision)
+		if !earning.IsPositive() {
+			continue
+		}
+
+		earnings = append(earnings, LoyaltyEarning{Type: earningInfo.Type, Earning: earning})
+	}
+
+	return earnings
+}
+
+// discountFactor returns the share of the row price that is still paid after all discounts of the item
+func (c *LoyaltyEarningsCalculator) discountFactor(item DecoratedCartItem) *big.Float {
+	rowPrice := item.Item.RowPriceGross
+	if !rowPrice.IsPositive() {
+		return big.NewFloat(1)
+	}
+
+	rowPriceWithDiscount := item.Item.RowPriceGrossWithDiscount()
+	if !rowPriceWithDiscount.IsPositive() {
+		return big.NewFloat(0)
+	}
+
+	return new(big.Float).Quo(rowPriceWithDiscount.Amount(), rowPrice.Amount())
+}
+
+// loyaltyPaidShare returns the share of the item value that is paid with loyalty points according to the payment selection
+func (c *LoyaltyEarningsCalculator) loyaltyPaidShare(dc DecoratedCart, itemID string, saleable domain.Saleable) *big.Float {
+	if dc.Cart.PaymentSelection == nil || len(saleable.LoyaltyPrices) == 0 {
+		return big.NewFloat(0)
+	}
+
+	split, found := dc.Cart.PaymentSelection.ItemSplit().CartItems[itemID]
+	if !found {
+		return big.NewFloat(0)
+	}
+
+	total := split.TotalValue()
+	if !total.IsPositive() {
+		return big.NewFloat(0)
+	}
+
+	loyaltyValue := new(big.Float)
+	for _, charge := range split {
+		if _, isLoyaltyCharge := saleable.GetLoyaltyPriceByType(charge.Type); isLoyaltyCharge {
+			loyaltyValue.Add(loyaltyValue, charge.Value.Amount())
+		}
+	}
+
+	share := new(big.Float).Quo(loyaltyValue, total.Amount())
+	if share.Cmp(big.NewFloat(1)) > 0 {
+		return big.NewFloat(1)
+	}
+
+	return share
+}
+
+// ByType returns the total earning of the given loyalty type
+func (e CartLoyaltyEarnings) ByType(loyaltyType string) (LoyaltyEarning, bool) {
+	for _, total := range e.Totals {
+		if total.Type == loyaltyType {
+			return total, true
+		}
+	}
+
+	return LoyaltyEarning{}, false
+}
+
+// ByItemID returns the earnings of the given cart item
+func (e CartLoyaltyEarnings) ByItemID(itemID string) []LoyaltyEarning {
+	for _, item := range e.Items {
+		if item.ItemID == itemID {
+			return item.Earnings
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package decorator_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+	"flamingo.me/flamingo-commerce/v3/product/domain"
+)
+
+func newLoyaltyEarningsCalculator(roundingMode string, loyaltyPaidItems string) *decorator.LoyaltyEarningsCalculator {
+	return new(decorator.LoyaltyEarningsCalculator).Inject(&struct {
+		RoundingMode      string  `inject:"config:commerce.cart.loyaltyEarnings.roundingMode,optional"`
+		RoundingPrecision float64 `inject:"config:commerce.cart.loyaltyEarnings.roundingPrecision,optional"`
+		LoyaltyPaidItems  string  `inject:"config:commerce.cart.loyaltyEarnings.loyaltyPaidItems,optional"`
+	}{
+		RoundingMode:     roundingMode,
+		LoyaltyPaidItems: loyaltyPaidItems,
+	})
+}
+
+func loyaltyProduct(points float64) domain.SimpleProduct {
+	return domain.SimpleProduct{
+		Saleable: domain.Saleable{
+			LoyaltyPrices: []domain.LoyaltyPriceInfo{
+				{Type: "miles", Default: priceDomain.NewFromFloat(100, "MILES")},
+			},
+			LoyaltyEarnings: []domain.LoyaltyEarningInfo{
+				{Type: "points", Default: priceDomain.NewFromFloat(points, "POINTS")},
+				{Type: "miles", Default: priceDomain.NewFromFloat(points/2, "MILES")},
+			},
+		},
+	}
+}
+
+func loyaltyDecoratedCart() decorator.DecoratedCart {
+	discountedItem := cart.Item{
+		ID:            "discounted",
+		Qty:           2,
+		RowPriceGross: priceDomain.NewFromFloat(100, "EUR"),
+		AppliedDiscounts: cart.AppliedDiscounts{
+			{Applied: priceDomain.NewFromFloat(-25, "EUR"), IsItemRelated: true},
+		},
+	}
+	regularItem := cart.Item{
+		ID:            "regular",
+		Qty:           1,
+		RowPriceGross: priceDomain.NewFromFloat(20, "EUR"),
+	}
+	noEarningsItem := cart.Item{
+		ID:            "no-earnings",
+		Qty:           1,
+		RowPriceGross: priceDomain.NewFromFloat(10, "EUR"),
+	}
+
+	return decorator.DecoratedCart{
+		Cart: cart.Cart{
+			Deliveries: []cart.Delivery{{DeliveryInfo: cart.DeliveryInfo{Code: "delivery"}}},
+		},
+		DecoratedDeliveries: []decorator.DecoratedDelivery{
+			{
+				Delivery: cart.Delivery{DeliveryInfo: cart.DeliveryInfo{Code: "delivery"}},
+				DecoratedItems: []decorator.DecoratedCartItem{
+					{Item: discountedItem, Product: loyaltyProduct(10.5)},
+					{Item: regularItem, Product: loyaltyProduct(3)},
+					{Item: noEarningsItem, Product: domain.SimpleProduct{}},
+				},
+			},
+		},
+	}
+}
+
+func TestLoyaltyEarningsCalculator_Calculate(t *testing.T) {
+	t.Run("aggregated per item and type with discounts and qty", func(t *testing.T) {
+		earnings, err := newLoyaltyEarningsCalculator("", "").Calculate(loyaltyDecoratedCart())
+		require.NoError(t, err)
+
+		assert.Len(t, earnings.Items, 2)
+		// 10.5 * 2 * 0.75 = 15.75 floored
+		discounted := earnings.ByItemID("discounted")
+		assert.Len(t, discounted, 2)
+		assert.Equal(t, "points", discounted[0].Type)
+		assert.Equal(t, 15.0, discounted[0].Earning.FloatAmount())
+		assert.Equal(t, "delivery", earnings.Items[0].DeliveryCode)
+
+		points, found := earnings.ByType("points")
+		assert.True(t, found)
+		assert.Equal(t, 18.0, points.Earning.FloatAmount())
+		assert.Equal(t, "POINTS", points.Earning.Currency())
+
+		miles, found := earnings.ByType("miles")
+		assert.True(t, found)
+		// 7 (7.875 floored) + 1 (1.5 floored)
+		assert.Equal(t, 8.0, miles.Earning.FloatAmount())
+
+		assert.Equal(t, "miles", earnings.Totals[0].Type, "totals are sorted by type")
+		assert.Nil(t, earnings.ByItemID("no-earnings"))
+	})
+
+	t.Run("configurable rounding", func(t *testing.T) {
+		earnings, err := newLoyaltyEarningsCalculator(priceDomain.RoundingModeCeil, "").Calculate(loyaltyDecoratedCart())
+		require.NoError(t, err)
+
+		points, _ := earnings.ByType("points")
+		assert.Equal(t, 19.0, points.Earning.FloatAmount())
+	})
+
+	t.Run("items paid with loyalty points", func(t *testing.T) {
+		dc := loyaltyDecoratedCart()
+		dc.Cart.PaymentSelection = cart.NewPaymentSelection("gateway", cart.PaymentSplitByItem{
+			CartItems: map[string]cart.PaymentSplit{
+				"regular": {
+					{ChargeType: "miles", Method: "loyalty"}:                 {Type: "miles", Price: priceDomain.NewFromFloat(50, "MILES"), Value: priceDomain.NewFromFloat(10, "EUR")},
+					{ChargeType: priceDomain.ChargeTypeMain, Method: "card"}: {Type: priceDomain.ChargeTypeMain, Price: priceDomain.NewFromFloat(10, "EUR"), Value: priceDomain.NewFromFloat(10, "EUR")},
+				},
+			},
+		})
+
+		proportional, err := newLoyaltyEarningsCalculator("", decorator.LoyaltyPaidItemsProportional).Calculate(dc)
+		require.NoError(t, err)
+		// 3 * 0.5 = 1.5 floored
+		assert.Equal(t, 1.0, proportional.ByItemID("regular")[0].Earning.FloatAmount())
+
+		excluded, err := newLoyaltyEarningsCalculator("", decorator.LoyaltyPaidItemsExclude).Calculate(dc)
+		require.NoError(t, err)
+		assert.Nil(t, excluded.ByItemID("regular"))
+		points, _ := excluded.ByType("points")
+		assert.Equal(t, 15.0, points.Earning.FloatAmount())
+
+		included, err := newLoyaltyEarningsCalculator("", decorator.LoyaltyPaidItemsInclude).Calculate(dc)
+		require.NoError(t, err)
+		assert.Equal(t, 3.0, included.ByItemID("regular")[0].Earning.FloatAmount())
+	})
+
+	t.Run("earnings in a different currency are not summed up", func(t *testing.T) {
+		dc := loyaltyDecoratedCart()
+		otherCurrency := domain.SimpleProduct{
+			Saleable: domain.Saleable{
+				LoyaltyEarnings: []domain.LoyaltyEarningInfo{
+					{Type: "points", Default: priceDomain.NewFromFloat(5, "BONUS")},
+				},
+			},
+		}
+		dc.DecoratedDeliveries[0].DecoratedItems[2].Product = otherCurrency
+
+		earnings, err := newLoyaltyEarningsCalculator("", "").Calculate(dc)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `"no-earnings"`)
+
+		points, _ := earnings.ByType("points")
+		assert.Equal(t, 18.0, points.Earning.FloatAmount())
+		assert.Equal(t, "POINTS", points.Earning.Currency())
+		assert.Len(t, earnings.ByItemID("no-earnings"), 1, "the item earning is kept")
+	})
+}
+
+func TestDecoratedCart_LoyaltyEarnings(t *testing.T) {
+	points, found := loyaltyDecoratedCart().LoyaltyEarnings().ByType("points")
+	assert.True(t, found)
+	assert.Equal(t, 18.0, points.Earning.FloatAmount())
+}
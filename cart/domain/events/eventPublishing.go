@@ -29,6 +29,7 @@ type (
 var (
 	_ EventPublisher = (*DefaultEventPublisher)(nil)
 	_ flamingo.Event = (*OrderPlacedEvent)(nil)
+	_ flamingo.Event = (*OrderCancelledEvent)(nil)
 	_ flamingo.Event = (*AddToCartEvent)(nil)
 	_ flamingo.Event = (*PaymentSelectionHasBeenResetEvent)(nil)
 	_ flamingo.Event = (*ChangedQtyInCartEvent)(nil)
@@ -12,6 +12,11 @@ type (
 		PlacedOrderInfos placeorder.PlacedOrderInfos
 	}
 
+	// OrderCancelledEvent is dispatched after placed orders have been cancelled
+	OrderCancelledEvent struct {
+		PlacedOrderInfos placeorder.PlacedOrderInfos
+	}
+
 	// AddToCartEvent defines event properties
 	AddToCartEvent struct {
 		Cart                   *cartDomain.Cart
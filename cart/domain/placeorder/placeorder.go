@@ -118,6 +118,16 @@ func (poi PlacedOrderInfos) GetOrderNumberForDeliveryCode(deliveryCode string) s
 	return ""
 }
 
+// OrderNumbers returns the order numbers of all orders of the placed cart
+func (poi PlacedOrderInfos) OrderNumbers() []string {
+	orderNumbers := make([]string, 0, len(poi))
+	for _, v := range poi {
+		orderNumbers = append(orderNumbers, v.OrderNumber)
+	}
+
+	return orderNumbers
+}
+
 //CartItems - return CartItems
 func (c ChargeByItem) CartItems() map[string]price.Charge {
 	return c.cartItems
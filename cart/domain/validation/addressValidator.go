@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"context"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+)
+
+const (
+	// AddressFieldCountryCode references Address.CountryCode in validation results
+	AddressFieldCountryCode = "countryCode"
+	// AddressFieldPostCode references Address.PostCode in validation results
+	AddressFieldPostCode = "postCode"
+	// AddressFieldRegionCode references Address.RegionCode in validation results
+	AddressFieldRegionCode = "regionCode"
+	// AddressFieldTelephone references Address.Telephone in validation results
+	AddressFieldTelephone = "telephone"
+)
+
+type (
+	// AddressValidator checks an address against country specific rules and normalizes it
+	AddressValidator interface {
+		Validate(ctx context.Context, address cart.Address) AddressValidationResult
+	}
+
+	// AddressValidationResult contains the normalized address together with errors and suggestions per field
+	AddressValidationResult struct {
+		// NormalizedAddress is the given address with normalized country code, postcode and phone number
+		NormalizedAddress cart.Address
+		FieldErrors       []AddressFieldError
+		Suggestions       []AddressFieldSuggestion
+	}
+
+	// AddressFieldError describes a rule violation of a single address field
+	AddressFieldError struct {
+		// Field - one of the AddressField* constants
+		Field string
+		// MessageKey - a key of the error message, often used to pass to translation func in the template
+		MessageKey string
+		// DefaultLabel - a speaking error label, used in case no translation exists
+		DefaultLabel string
+	}
+
+	// AddressFieldSuggestion proposes a normalized value for a single address field
+	AddressFieldSuggestion struct {
+		// Field - one of the AddressField* constants
+		Field string
+		// Value is the suggested (normalized) value of the field
+		Value string
+		// MessageKey - a key of the suggestion message
+		MessageKey string
+	}
+)
+
+// IsValid returns true if no field errors occurred
+func (r AddressValidationResult) IsValid() bool {
+	return len(r.FieldErrors) == 0
+}
+
+// HasErrorForField checks if there is an error for the given field
+func (r AddressValidationResult) HasErrorForField(field string) bool {
+	for _, fieldError := range r.FieldErrors {
+		if fieldError.Field == field {
+			return true
+		}
+	}
+	return false
+}
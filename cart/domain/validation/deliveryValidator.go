@@ -0,0 +1,140 @@
+package validation
+
+import (
+	"context"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+)
+
+const (
+	// DeliveryErrorAddressMissing is the error message key of a delivery without address
+	DeliveryErrorAddressMissing = "delivery_address_missing"
+	// DeliveryErrorLocationCodeMissing is the error message key of a pickup without location code
+	DeliveryErrorLocationCodeMissing = "delivery_location_code_missing"
+	// DeliveryErrorMethodMissing is the error message key of a delivery without shipping method
+	DeliveryErrorMethodMissing = "delivery_method_missing"
+	// DeliveryErrorMethodUnavailable is the error message key of a delivery with a shipping method that is not available
+	DeliveryErrorMethodUnavailable = "delivery_method_unavailable"
+)
+
+type (
+	// ShippingMethodProvider returns the shipping methods available for a delivery, e.g. depending on the destination
+	ShippingMethodProvider interface {
+		AvailableMethods(ctx context.Context, cart cart.Cart, delivery cart.Delivery) ([]string, error)
+	}
+
+	// DeliveryValidator checks that every delivery is complete according to its workflow before the order is placed
+	DeliveryValidator struct {
+		shippingMethodProvider ShippingMethodProvider
+	}
+
+	// DeliveryValidationResult groups the errors of all deliveries
+	DeliveryValidationResult struct {
+		DeliveryErrors []DeliveryValidationError
+	}
+
+	// DeliveryValidationError applies for a single delivery
+	DeliveryValidationError struct {
+		DeliveryCode    string
+		ErrorMessageKey string
+	}
+)
+
+// Inject dependencies
+func (v *DeliveryValidator) Inject(
+	optionals *struct {
+		ShippingMethodProvider ShippingMethodProvider `inject:",optional"`
+	},
+) *DeliveryValidator {
+	if optionals != nil {
+		v.shippingMethodProvider = optionals.ShippingMethodProvider
+	}
+
+	return v
+}
+
+// Validate checks the deliveries of the cart: deliveries need an address and pickups a location code,
+// both need a shipping method that is available if a ShippingMethodProvider is bound.
+// Deliveries of other workflows and deliveries without items are not checked.
+func (v *DeliveryValidator) Validate(ctx context.Context, c cart.Cart) (DeliveryValidationResult, error) {
+	var result DeliveryValidationResult
+
+	for _, delivery := range c.Deliveries {
+		if len(delivery.Cartitems) == 0 {
+			continue
+		}
+
+		errorMessageKeys, err := v.validateDelivery(ctx, c, delivery)
+		if err != nil {
+			return DeliveryValidationResult{}, err
+		}
+
+		for _, errorMessageKey := range errorMessageKeys {
+			result.DeliveryErrors = append(result.DeliveryErrors, DeliveryValidationError{
+				DeliveryCode:    delivery.DeliveryInfo.Code,
+				ErrorMessageKey: errorMessageKey,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+func (v *DeliveryValidator) validateDelivery(ctx context.Context, c cart.Cart, delivery cart.Delivery) ([]string, error) {
+	var errorMessageKeys []string
+	location := delivery.DeliveryInfo.DeliveryLocation
+
+	switch delivery.DeliveryInfo.Workflow {
+	case cart.DeliveryWorkflowDelivery:
+		address := location.Address
+		if location.UseBillingAddress {
+			address = c.BillingAddress
+		}
+
+		if address.IsEmpty() {
+			errorMessageKeys = append(errorMessageKeys, DeliveryErrorAddressMissing)
+		}
+	case cart.DeliveryWorkflowPickup:
+		if location.Code == "" {
+			errorMessageKeys = append(errorMessageKeys, DeliveryErrorLocationCodeMissing)
+		}
+	default:
+		return nil, nil
+	}
+
+	if delivery.DeliveryInfo.Method == "" {
+		return append(errorMessageKeys, DeliveryErrorMethodMissing), nil
+	}
+
+	if v.shippingMethodProvider == nil {
+		return errorMessageKeys, nil
+	}
+
+	methods, err := v.shippingMethodProvider.AvailableMethods(ctx, c, delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, method := range methods {
+		if method == delivery.DeliveryInfo.Method {
+			return errorMessageKeys, nil
+		}
+	}
+
+	return append(errorMessageKeys, DeliveryErrorMethodUnavailable), nil
+}
+
+// IsValid is true if no delivery errors occurred
+func (r DeliveryValidationResult) IsValid() bool {
+	return len(r.DeliveryErrors) == 0
+}
+
+// HasErrorForDelivery checks if the delivery with the given code has an error
+func (r DeliveryValidationResult) HasErrorForDelivery(deliveryCode string) bool {
+	for _, deliveryError := range r.DeliveryErrors {
+		if deliveryError.DeliveryCode == deliveryCode {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package validation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+)
+
+type shippingMethodProvider struct {
+	methods []string
+	err     error
+}
+
+func (s shippingMethodProvider) AvailableMethods(context.Context, cart.Cart, cart.Delivery) ([]string, error) {
+	return s.methods, s.err
+}
+
+func delivery(code string, workflow string, method string, location cart.DeliveryLocation) cart.Delivery {
+	return cart.Delivery{
+		DeliveryInfo: cart.DeliveryInfo{Code: code, Workflow: workflow, Method: method, DeliveryLocation: location},
+		Cartitems:    []cart.Item{{ID: "item"}},
+	}
+}
+
+func TestDeliveryValidator_Validate(t *testing.T) {
+	address := &cart.Address{Street: "Street", City: "City"}
+
+	tests := []struct {
+		name       string
+		cart       cart.Cart
+		provider   validation.ShippingMethodProvider
+		wantErrors []validation.DeliveryValidationError
+	}{
+		{
+			name: "complete deliveries",
+			cart: cart.Cart{
+				BillingAddress: address,
+				Deliveries: []cart.Delivery{
+					delivery("home", cart.DeliveryWorkflowDelivery, "standard", cart.DeliveryLocation{Address: address}),
+					delivery("billing", cart.DeliveryWorkflowDelivery, "standard", cart.DeliveryLocation{UseBillingAddress: true}),
+					delivery("store", cart.DeliveryWorkflowPickup, "pickup", cart.DeliveryLocation{Code: "store1"}),
+					delivery("other", cart.DeliveryWorkflowUnspecified, "", cart.DeliveryLocation{}),
+				},
+			},
+		},
+		{
+			name: "incomplete deliveries",
+			cart: cart.Cart{
+				Deliveries: []cart.Delivery{
+					delivery("home", cart.DeliveryWorkflowDelivery, "", cart.DeliveryLocation{Address: &cart.Address{}}),
+					delivery("billing", cart.DeliveryWorkflowDelivery, "standard", cart.DeliveryLocation{UseBillingAddress: true}),
+					delivery("store", cart.DeliveryWorkflowPickup, "pickup", cart.DeliveryLocation{Type: cart.DeliverylocationTypeStore}),
+					{DeliveryInfo: cart.DeliveryInfo{Code: "empty", Workflow: cart.DeliveryWorkflowDelivery}},
+				},
+			},
+			wantErrors: []validation.DeliveryValidationError{
+				{DeliveryCode: "home", ErrorMessageKey: validation.DeliveryErrorAddressMissing},
+				{DeliveryCode: "home", ErrorMessageKey: validation.DeliveryErrorMethodMissing},
+				{DeliveryCode: "billing", ErrorMessageKey: validation.DeliveryErrorAddressMissing},
+				{DeliveryCode: "store", ErrorMessageKey: validation.DeliveryErrorLocationCodeMissing},
+			},
+		},
+		{
+			name: "unavailable method",
+			cart: cart.Cart{
+				Deliveries: []cart.Delivery{
+					delivery("home", cart.DeliveryWorkflowDelivery, "express", cart.DeliveryLocation{Address: address}),
+					delivery("store", cart.DeliveryWorkflowPickup, "standard", cart.DeliveryLocation{Code: "store1"}),
+				},
+			},
+			provider: shippingMethodProvider{methods: []string{"standard"}},
+			wantErrors: []validation.DeliveryValidationError{
+				{DeliveryCode: "home", ErrorMessageKey: validation.DeliveryErrorMethodUnavailable},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := new(validation.DeliveryValidator).Inject(&struct {
+				ShippingMethodProvider validation.ShippingMethodProvider `inject:",optional"`
+			}{
+				ShippingMethodProvider: tt.provider,
+			})
+
+			result, err := validator.Validate(context.Background(), tt.cart)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantErrors, result.DeliveryErrors)
+			assert.Equal(t, len(tt.wantErrors) == 0, result.IsValid())
+		})
+	}
+
+	t.Run("provider error", func(t *testing.T) {
+		validator := new(validation.DeliveryValidator).Inject(&struct {
+			ShippingMethodProvider validation.ShippingMethodProvider `inject:",optional"`
+		}{
+			ShippingMethodProvider: shippingMethodProvider{err: errors.New("unavailable")},
+		})
+
+		_, err := validator.Validate(context.Background(), cart.Cart{Deliveries: []cart.Delivery{
+			delivery("home", cart.DeliveryWorkflowDelivery, "standard", cart.DeliveryLocation{Address: address}),
+		}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,117 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+)
+
+const (
+	// GiftMessageFieldRecipient references GiftMessage.Recipient in validation errors
+	GiftMessageFieldRecipient = "recipient"
+	// GiftMessageFieldSender references GiftMessage.Sender in validation errors
+	GiftMessageFieldSender = "sender"
+	// GiftMessageFieldMessage references GiftMessage.Message in validation errors
+	GiftMessageFieldMessage = "message"
+
+	defaultGiftMessageMaxLength     = 250
+	defaultGiftMessageNameMaxLength = 50
+	// defaultGiftMessageAllowedCharacters allows letters, digits, punctuation, spaces and line breaks, but no symbols like emojis
+	defaultGiftMessageAllowedCharacters = `^[\p{L}\p{M}\p{N}\p{P}\p{Zs}\r\n]*$`
+)
+
+type (
+	// GiftMessageValidator checks the length and the characters of gift messages, so that they can be printed
+	GiftMessageValidator struct {
+		maxLength         int
+		nameMaxLength     int
+		allowedCharacters *regexp.Regexp
+	}
+
+	// GiftMessageValidationError describes a rule violation of a single gift message field
+	GiftMessageValidationError struct {
+		// Field - one of the GiftMessageField* constants
+		Field string
+		// MessageKey - a key of the error message, often used to pass to translation func in the template
+		MessageKey string
+		// DefaultLabel - a speaking error label, used in case no translation exists
+		DefaultLabel string
+	}
+)
+
+// Inject dependencies
+func (v *GiftMessageValidator) Inject(
+	config *struct {
+		MaxLength         float64 `inject:"config:commerce.cart.giftOptions.message.maxLength,optional"`
+		NameMaxLength     float64 `inject:"config:commerce.cart.giftOptions.message.nameMaxLength,optional"`
+		AllowedCharacters string  `inject:"config:commerce.cart.giftOptions.message.allowedCharacters,optional"`
+	},
+) *GiftMessageValidator {
+	v.maxLength = defaultGiftMessageMaxLength
+	v.nameMaxLength = defaultGiftMessageNameMaxLength
+	v.allowedCharacters = regexp.MustCompile(defaultGiftMessageAllowedCharacters)
+
+	if config != nil {
+		if config.MaxLength > 0 {
+			v.maxLength = int(config.MaxLength)
+		}
+		if config.NameMaxLength > 0 {
+			v.nameMaxLength = int(config.NameMaxLength)
+		}
+		if config.AllowedCharacters != "" {
+			v.allowedCharacters = regexp.MustCompile(config.AllowedCharacters)
+		}
+	}
+
+	return v
+}
+
+// MaxLength returns the maximum number of characters allowed in the message
+func (v *GiftMessageValidator) MaxLength() int {
+	return v.maxLength
+}
+
+// Validate checks all fields of the gift message and returns a *GiftMessageValidationError for the first invalid field
+func (v *GiftMessageValidator) Validate(message cart.GiftMessage) error {
+	fields := []struct {
+		name      string
+		value     string
+		maxLength int
+	}{
+		{name: GiftMessageFieldRecipient, value: message.Recipient, maxLength: v.nameMaxLength},
+		{name: GiftMessageFieldSender, value: message.Sender, maxLength: v.nameMaxLength},
+		{name: GiftMessageFieldMessage, value: message.Message, maxLength: v.maxLength},
+	}
+
+	for _, field := range fields {
+		if utf8.RuneCountInString(field.value) > field.maxLength {
+			return &GiftMessageValidationError{
+				Field:        field.name,
+				MessageKey:   fmt.Sprintf("formerror_%s_tooLong", field.name),
+				DefaultLabel: fmt.Sprintf("Please enter at most %d characters", field.maxLength),
+			}
+		}
+
+		if !v.allowedCharacters.MatchString(field.value) {
+			return &GiftMessageValidationError{
+				Field:        field.name,
+				MessageKey:   fmt.Sprintf("formerror_%s_invalidCharacters", field.name),
+				DefaultLabel: "The text contains characters that can not be printed",
+			}
+		}
+	}
+
+	return nil
+}
+
+// MessageCode returns the message key, so that it can be used as error code
+func (e *GiftMessageValidationError) MessageCode() string {
+	return e.MessageKey
+}
+
+// Error returns the error message
+func (e *GiftMessageValidationError) Error() string {
+	return fmt.Sprintf("gift message field %s is invalid: %s", e.Field, e.DefaultLabel)
+}
@@ -0,0 +1,87 @@
+package validation_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+)
+
+func TestGiftMessageValidator_Validate(t *testing.T) {
+	tests := []struct {
+		name           string
+		message        cart.GiftMessage
+		wantField      string
+		wantMessageKey string
+	}{
+		{
+			name:    "valid message with umlauts and line breaks",
+			message: cart.GiftMessage{Recipient: "Jürgen", Sender: "Anna & Ben", Message: "Alles Gute zum Geburtstag!\nBis bald."},
+		},
+		{
+			name:    "empty message",
+			message: cart.GiftMessage{},
+		},
+		{
+			name:           "message too long",
+			message:        cart.GiftMessage{Message: strings.Repeat("a", 251)},
+			wantField:      validation.GiftMessageFieldMessage,
+			wantMessageKey: "formerror_message_tooLong",
+		},
+		{
+			name:           "sender too long",
+			message:        cart.GiftMessage{Sender: strings.Repeat("ä", 51)},
+			wantField:      validation.GiftMessageFieldSender,
+			wantMessageKey: "formerror_sender_tooLong",
+		},
+		{
+			name:           "emoji is not allowed",
+			message:        cart.GiftMessage{Message: "Happy Birthday 🎉"},
+			wantField:      validation.GiftMessageFieldMessage,
+			wantMessageKey: "formerror_message_invalidCharacters",
+		},
+		{
+			name:           "control characters are not allowed",
+			message:        cart.GiftMessage{Recipient: "Max\tMustermann"},
+			wantField:      validation.GiftMessageFieldRecipient,
+			wantMessageKey: "formerror_recipient_invalidCharacters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := new(validation.GiftMessageValidator).Inject(nil)
+			err := validator.Validate(tt.message)
+
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			validationError, ok := err.(*validation.GiftMessageValidationError)
+			if assert.True(t, ok, "expected *GiftMessageValidationError, got %v", err) {
+				assert.Equal(t, tt.wantField, validationError.Field)
+				assert.Equal(t, tt.wantMessageKey, validationError.MessageKey)
+			}
+		})
+	}
+}
+
+func TestGiftMessageValidator_Config(t *testing.T) {
+	validator := new(validation.GiftMessageValidator).Inject(&struct {
+		MaxLength         float64 `inject:"config:commerce.cart.giftOptions.message.maxLength,optional"`
+		NameMaxLength     float64 `inject:"config:commerce.cart.giftOptions.message.nameMaxLength,optional"`
+		AllowedCharacters string  `inject:"config:commerce.cart.giftOptions.message.allowedCharacters,optional"`
+	}{
+		MaxLength:         10,
+		AllowedCharacters: `^[A-Za-z ]*$`,
+	})
+
+	assert.Equal(t, 10, validator.MaxLength())
+	assert.NoError(t, validator.Validate(cart.GiftMessage{Message: "Hello you"}))
+	assert.Error(t, validator.Validate(cart.GiftMessage{Message: "Hello you all"}))
+	assert.Error(t, validator.Validate(cart.GiftMessage{Message: "Hällo"}))
+}
@@ -0,0 +1,188 @@
+package validation
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/product/domain"
+)
+
+type (
+	// PurchaseHistory is a secondary port that knows the quantities customers purchased in placed orders
+	PurchaseHistory interface {
+		// Record stores the purchases of a placed order
+		Record(ctx context.Context, purchases []Purchase) error
+		// PurchasedQty returns the qty of the product the customer purchased since the given time,
+		// a purchase belongs to the customer if either the customer id or the email matches
+		PurchasedQty(ctx context.Context, customer PurchaseCustomer, marketplaceCode string, since time.Time) (int, error)
+	}
+
+	// Purchase of a product by a customer
+	Purchase struct {
+		Customer        PurchaseCustomer
+		MarketplaceCode string
+		Qty             int
+		PurchasedAt     time.Time
+	}
+
+	// PurchaseCustomer identifies a customer by the id of the authenticated identity and / or the email
+	PurchaseCustomer struct {
+		ID    string
+		Email string
+	}
+
+	// PurchaseLimit is the maximum qty a customer can buy of a product within the time window
+	PurchaseLimit struct {
+		MaxQty int
+		// Window of the limit, e.g. 168h for "per week", zero means all purchases count
+		Window time.Duration
+	}
+
+	// PurchaseLimitRestrictor restricts the qty of products based on the configured limits and the purchase history of the customer
+	PurchaseLimitRestrictor struct {
+		logger  flamingo.Logger
+		history PurchaseHistory
+		limits  map[string]PurchaseLimit
+	}
+
+	// purchaseLimitConfig is the configuration representation of a purchase limit
+	purchaseLimitConfig struct {
+		MaxQty float64
+		Window string
+	}
+)
+
+var _ MaxQuantityRestrictor = new(PurchaseLimitRestrictor)
+
+// Inject dependencies
+func (r *PurchaseLimitRestrictor) Inject(
+	logger flamingo.Logger,
+	history PurchaseHistory,
+	config *struct {
+		Products config.Map `inject:"config:commerce.cart.purchaseLimits.products,optional"`
+	},
+) *PurchaseLimitRestrictor {
+	r.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, r.Name())
+	r.history = history
+	r.limits = make(map[string]PurchaseLimit)
+
+	if config != nil && config.Products != nil {
+		var configured map[string]purchaseLimitConfig
+		config.Products.MapInto(&configured)
+		for marketplaceCode, limitConfig := range configured {
+			limit := PurchaseLimit{MaxQty: int(limitConfig.MaxQty)}
+			if limitConfig.Window != "" {
+				window, err := time.ParseDuration(limitConfig.Window)
+				if err != nil {
+					r.logger.Error("invalid purchase limit window for ", marketplaceCode, ": ", err)
+					continue
+				}
+				limit.Window = window
+			}
+			r.limits[marketplaceCode] = limit
+		}
+	}
+
+	return r
+}
+
+// Name returns the code of the restrictor
+func (r *PurchaseLimitRestrictor) Name() string {
+	return "PurchaseLimitRestrictor"
+}
+
+// Restrict the qty of limited products to the limit minus the qty the customer already purchased within the window
+func (r *PurchaseLimitRestrictor) Restrict(ctx context.Context, _ *web.Session, product domain.BasicProduct, cart *cart.Cart, _ string) *RestrictionResult {
+	unrestricted := &RestrictionResult{
+		IsRestricted:        false,
+		MaxAllowed:          math.MaxInt32,
+		RemainingDifference: math.MaxInt32,
+		RestrictorName:      r.Name(),
+	}
+
+	marketplaceCode := product.BaseData().MarketPlaceCode
+	limit, found := r.limits[marketplaceCode]
+	if !found {
+		return unrestricted
+	}
+
+	purchasedQty := 0
+	customer := PurchaseCustomerFromCart(cart)
+	if customer.ID != "" || customer.Email != "" {
+		var since time.Time
+		if limit.Window > 0 {
+			since = time.Now().Add(-limit.Window)
+		}
+
+		var err error
+		purchasedQty, err = r.history.PurchasedQty(ctx, customer, marketplaceCode, since)
+		if err != nil {
+			r.logger.WithContext(ctx).Error(err)
+		}
+	}
+
+	maxAllowed := limit.MaxQty - purchasedQty
+	if maxAllowed < 0 {
+		maxAllowed = 0
+	}
+
+	return &RestrictionResult{
+		IsRestricted:        true,
+		MaxAllowed:          maxAllowed,
+		RemainingDifference: maxAllowed - qtyInCart(cart, marketplaceCode),
+		RestrictorName:      r.Name(),
+	}
+}
+
+// PurchaseCustomerFromCart returns the customer of the cart, the email is taken from the contact data of the cart (trimmed and lowercased)
+func PurchaseCustomerFromCart(c *cart.Cart) PurchaseCustomer {
+	if c == nil {
+		return PurchaseCustomer{}
+	}
+
+	customer := PurchaseCustomer{Email: strings.ToLower(strings.TrimSpace(c.GetContactMail()))}
+	if c.BelongsToAuthenticatedUser {
+		customer.ID = c.AuthenticatedUserID
+	}
+
+	return customer
+}
+
+// PurchasedMarketplaceCode returns the code of the purchased product of the item, the variant for configurable products
+func PurchasedMarketplaceCode(item cart.Item) string {
+	if item.VariantMarketPlaceCode != "" {
+		return item.VariantMarketPlaceCode
+	}
+
+	return item.MarketplaceCode
+}
+
+// Matches returns true if the purchase belongs to the customer, emails are compared case-insensitively
+func (p Purchase) Matches(customer PurchaseCustomer) bool {
+	return (customer.ID != "" && p.Customer.ID == customer.ID) || (customer.Email != "" && strings.EqualFold(p.Customer.Email, customer.Email))
+}
+
+// qtyInCart returns the qty of the product in all deliveries of the cart
+func qtyInCart(c *cart.Cart, marketplaceCode string) int {
+	if c == nil {
+		return 0
+	}
+
+	qty := 0
+	for _, delivery := range c.Deliveries {
+		for _, item := range delivery.Cartitems {
+			if PurchasedMarketplaceCode(item) == marketplaceCode {
+				qty += item.Qty
+			}
+		}
+	}
+
+	return qty
+}
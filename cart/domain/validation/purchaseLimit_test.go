@@ -0,0 +1,111 @@
+package validation_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+	"flamingo.me/flamingo-commerce/v3/product/domain"
+)
+
+type fakePurchaseHistory struct {
+	purchases []validation.Purchase
+}
+
+func (f *fakePurchaseHistory) Record(_ context.Context, purchases []validation.Purchase) error {
+	f.purchases = append(f.purchases, purchases...)
+	return nil
+}
+
+func (f *fakePurchaseHistory) PurchasedQty(_ context.Context, customer validation.PurchaseCustomer, marketplaceCode string, since time.Time) (int, error) {
+	qty := 0
+	for _, purchase := range f.purchases {
+		if purchase.MarketplaceCode == marketplaceCode && !purchase.PurchasedAt.Before(since) && purchase.Matches(customer) {
+			qty += purchase.Qty
+		}
+	}
+	return qty, nil
+}
+
+func TestPurchaseCustomerFromCart(t *testing.T) {
+	c := &cart.Cart{BelongsToAuthenticatedUser: true, AuthenticatedUserID: "customer-1", BillingAddress: &cart.Address{Email: " Customer@Example.COM"}}
+
+	customer := validation.PurchaseCustomerFromCart(c)
+	assert.Equal(t, validation.PurchaseCustomer{ID: "customer-1", Email: "customer@example.com"}, customer)
+	assert.True(t, validation.Purchase{Customer: validation.PurchaseCustomer{Email: "CUSTOMER@example.com"}}.Matches(customer))
+}
+
+func TestPurchaseLimitRestrictor_Restrict(t *testing.T) {
+	history := &fakePurchaseHistory{purchases: []validation.Purchase{
+		{Customer: validation.PurchaseCustomer{ID: "customer"}, MarketplaceCode: "launch", Qty: 1, PurchasedAt: time.Now().Add(-time.Hour)},
+		{Customer: validation.PurchaseCustomer{Email: "guest@example.com"}, MarketplaceCode: "launch", Qty: 2, PurchasedAt: time.Now().Add(-time.Hour)},
+		{Customer: validation.PurchaseCustomer{ID: "customer"}, MarketplaceCode: "launch", Qty: 5, PurchasedAt: time.Now().Add(-30 * 24 * time.Hour)},
+	}}
+
+	restrictor := new(validation.PurchaseLimitRestrictor).Inject(flamingo.NullLogger{}, history, &struct {
+		Products config.Map `inject:"config:commerce.cart.purchaseLimits.products,optional"`
+	}{
+		Products: config.Map{
+			"launch": config.Map{"maxQty": 2.0, "window": "168h"},
+		},
+	})
+
+	launchProduct := domain.SimpleProduct{BasicProductData: domain.BasicProductData{MarketPlaceCode: "launch"}}
+	cartWithLaunchItem := func(qty int) *cart.Cart {
+		return &cart.Cart{
+			Deliveries: []cart.Delivery{{Cartitems: []cart.Item{{ID: "1", MarketplaceCode: "launch", Qty: qty}}}},
+		}
+	}
+
+	t.Run("product without limit is not restricted", func(t *testing.T) {
+		product := domain.SimpleProduct{BasicProductData: domain.BasicProductData{MarketPlaceCode: "other"}}
+		result := restrictor.Restrict(context.Background(), nil, product, cartWithLaunchItem(1), "")
+		assert.False(t, result.IsRestricted)
+		assert.Equal(t, math.MaxInt32, result.MaxAllowed)
+	})
+
+	t.Run("purchases of the authenticated customer within the window are subtracted", func(t *testing.T) {
+		c := cartWithLaunchItem(2)
+		c.BelongsToAuthenticatedUser = true
+		c.AuthenticatedUserID = "customer"
+
+		result := restrictor.Restrict(context.Background(), nil, launchProduct, c, "")
+		assert.True(t, result.IsRestricted)
+		assert.Equal(t, 1, result.MaxAllowed)
+		assert.Equal(t, -1, result.RemainingDifference)
+		assert.Equal(t, "PurchaseLimitRestrictor", result.RestrictorName)
+	})
+
+	t.Run("purchases are matched by email", func(t *testing.T) {
+		c := cartWithLaunchItem(1)
+		c.BillingAddress = &cart.Address{Email: "guest@example.com"}
+
+		result := restrictor.Restrict(context.Background(), nil, launchProduct, c, "")
+		assert.True(t, result.IsRestricted)
+		assert.Equal(t, 0, result.MaxAllowed)
+		assert.Equal(t, -1, result.RemainingDifference)
+	})
+
+	t.Run("purchases are matched by email case-insensitively", func(t *testing.T) {
+		c := cartWithLaunchItem(1)
+		c.BillingAddress = &cart.Address{Email: " Guest@Example.com "}
+
+		result := restrictor.Restrict(context.Background(), nil, launchProduct, c, "")
+		assert.True(t, result.IsRestricted)
+		assert.Equal(t, 0, result.MaxAllowed)
+	})
+
+	t.Run("unknown customer gets the full limit", func(t *testing.T) {
+		result := restrictor.Restrict(context.Background(), nil, launchProduct, cartWithLaunchItem(1), "")
+		assert.True(t, result.IsRestricted)
+		assert.Equal(t, 2, result.MaxAllowed)
+		assert.Equal(t, 1, result.RemainingDifference)
+	})
+}
@@ -0,0 +1,110 @@
+package infrastructure
+
+import (
+	"context"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"go.opencensus.io/trace"
+
+	domaincart "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+)
+
+const (
+	// CalculationStagePricing calculates the row prices of the items
+	CalculationStagePricing = "pricing"
+	// CalculationStageDiscounts applies the discounts
+	CalculationStageDiscounts = "discounts"
+	// CalculationStageShipping calculates the shipping items of the deliveries
+	CalculationStageShipping = "shipping"
+	// CalculationStageTaxes calculates the taxes of the items
+	CalculationStageTaxes = "taxes"
+	// CalculationStageTotals calculates the totalitems of the cart
+	CalculationStageTotals = "totals"
+	// CalculationStageGiftCards calculates the applied amounts of the gift cards
+	CalculationStageGiftCards = "giftcards"
+)
+
+type (
+	// CartCalculator is a stage of the CartCalculationPipeline of the in-memory cart, bind own calculators with
+	// injector.BindMulti(new(infrastructure.CartCalculator)) and add or replace a stage by its name
+	CartCalculator interface {
+		// Name of the stage, referenced in commerce.cart.defaultCartAdapter.calculation.stages
+		Name() string
+		// Calculate modifies the cart
+		Calculate(ctx context.Context, cart *domaincart.Cart) error
+	}
+
+	// CartCalculationPipeline recalculates the in-memory cart after items changed and before it is stored
+	CartCalculationPipeline struct {
+		logger      flamingo.Logger
+		calculators map[string]CartCalculator
+		stages      []string
+	}
+)
+
+// Inject dependencies
+func (p *CartCalculationPipeline) Inject(
+	logger flamingo.Logger,
+	optionals *struct {
+		Calculators []CartCalculator `inject:",optional"`
+	},
+	config *struct {
+		Stages config.Slice `inject:"config:commerce.cart.defaultCartAdapter.calculation.stages,optional"`
+	},
+) *CartCalculationPipeline {
+	p.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "CartCalculationPipeline")
+	p.calculators = make(map[string]CartCalculator)
+	p.stages = nil
+
+	if optionals != nil {
+		// calculators bound later replace the ones with the same name
+		for _, calculator := range optionals.Calculators {
+			p.calculators[calculator.Name()] = calculator
+		}
+	}
+
+	if config != nil && config.Stages != nil {
+		_ = config.Stages.MapInto(&p.stages)
+	}
+
+	return p
+}
+
+// Stages returns the names of the configured stages in the order they run
+func (p *CartCalculationPipeline) Stages() []string {
+	return p.stages
+}
+
+// Calculate runs all configured stages in order, the first failing stage stops the pipeline
+func (p *CartCalculationPipeline) Calculate(ctx context.Context, cart *domaincart.Cart) error {
+	ctx, span := trace.StartSpan(ctx, "cart/CartCalculationPipeline/Calculate")
+	defer span.End()
+
+	for _, stage := range p.stages {
+		calculator, found := p.calculators[stage]
+		if !found {
+			p.logger.WithContext(ctx).Warn("no calculator bound for stage ", stage)
+			continue
+		}
+
+		if err := p.runStage(ctx, calculator, cart); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runStage runs the calculator in its own span and logs the duration
+func (p *CartCalculationPipeline) runStage(ctx context.Context, calculator CartCalculator, cart *domaincart.Cart) error {
+	ctx, span := trace.StartSpan(ctx, "cart/CartCalculationPipeline/"+calculator.Name())
+	defer span.End()
+
+	start := time.Now()
+	err := calculator.Calculate(ctx, cart)
+	p.logger.WithContext(ctx).Debugf("calculation stage %s took %v", calculator.Name(), time.Since(start))
+
+	return err
+}
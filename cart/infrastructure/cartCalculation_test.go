@@ -0,0 +1,173 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+
+	domaincart "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+type recordingCalculator struct {
+	name  string
+	calls *[]string
+	err   error
+}
+
+func (r *recordingCalculator) Name() string {
+	return r.name
+}
+
+func (r *recordingCalculator) Calculate(context.Context, *domaincart.Cart) error {
+	*r.calls = append(*r.calls, r.name)
+	return r.err
+}
+
+func newCartCalculationPipeline(stages config.Slice, calculators ...CartCalculator) *CartCalculationPipeline {
+	return new(CartCalculationPipeline).Inject(
+		flamingo.NullLogger{},
+		&struct {
+			Calculators []CartCalculator `inject:",optional"`
+		}{Calculators: calculators},
+		&struct {
+			Stages config.Slice `inject:"config:commerce.cart.defaultCartAdapter.calculation.stages,optional"`
+		}{Stages: stages},
+	)
+}
+
+func TestCartCalculationPipeline_Calculate(t *testing.T) {
+	t.Run("stages run in configured order, unknown stages are skipped", func(t *testing.T) {
+		var calls []string
+		pipeline := newCartCalculationPipeline(
+			config.Slice{"pricing", "custom", "missing", "totals"},
+			&recordingCalculator{name: "totals", calls: &calls},
+			&recordingCalculator{name: "pricing", calls: &calls},
+			&recordingCalculator{name: "custom", calls: &calls},
+			&recordingCalculator{name: "unused", calls: &calls},
+		)
+
+		assert.NoError(t, pipeline.Calculate(context.Background(), &domaincart.Cart{}))
+		assert.Equal(t, []string{"pricing", "custom", "totals"}, calls)
+		assert.Equal(t, []string{"pricing", "custom", "missing", "totals"}, pipeline.Stages())
+	})
+
+	t.Run("later bound calculators replace stages, errors stop the pipeline", func(t *testing.T) {
+		var calls []string
+		pipeline := newCartCalculationPipeline(
+			config.Slice{"pricing", "taxes", "totals"},
+			&recordingCalculator{name: "pricing", calls: &calls},
+			&recordingCalculator{name: "taxes", calls: &calls},
+			&recordingCalculator{name: "taxes", calls: &calls, err: errors.New("tax service down")},
+			&recordingCalculator{name: "totals", calls: &calls},
+		)
+
+		assert.EqualError(t, pipeline.Calculate(context.Background(), &domaincart.Cart{}), "tax service down")
+		assert.Equal(t, []string{"pricing", "taxes"}, calls)
+	})
+}
+
+func TestDefaultCalculators(t *testing.T) {
+	itemBuilderProvider := func() *domaincart.ItemBuilder {
+		return &domaincart.ItemBuilder{}
+	}
+
+	cart := &domaincart.Cart{
+		Deliveries: []domaincart.Delivery{
+			{
+				DeliveryInfo: domaincart.DeliveryInfo{Code: "delivery"},
+				Cartitems: []domaincart.Item{
+					{
+						ID:               "item-1",
+						Qty:              2,
+						SourceID:         "source",
+						SinglePriceNet:   priceDomain.NewFromInt(1000, 100, "€"),
+						SinglePriceGross: priceDomain.NewFromInt(1000, 100, "€"),
+						GiftWrap:         &domaincart.GiftWrap{Code: "paper", Price: priceDomain.NewFromInt(100, 100, "€")},
+					},
+				},
+			},
+		},
+		AppliedGiftCards: []domaincart.AppliedGiftCard{
+			{Code: "gc-1", Applied: priceDomain.NewFromInt(1000, 100, "€"), Remaining: priceDomain.NewFromInt(2000, 100, "€")},
+			{Code: "gc-2", Applied: priceDomain.NewFromInt(500, 100, "€"), Remaining: priceDomain.NewFromInt(0, 100, "€")},
+		},
+	}
+
+	taxCalculator := new(DefaultTaxCalculator).Inject(itemBuilderProvider, &struct {
+		DefaultTaxRate float64 `inject:"config:commerce.cart.defaultCartAdapter.defaultTaxRate,optional"`
+	}{DefaultTaxRate: 10})
+
+	pipeline := newCartCalculationPipeline(
+		config.Slice{"pricing", "discounts", "shipping", "taxes", "totals", "giftcards"},
+		new(DefaultPricingCalculator).Inject(itemBuilderProvider),
+		new(DefaultDiscountCalculator),
+		new(DefaultShippingCalculator),
+		taxCalculator,
+		new(DefaultTotalsCalculator),
+		new(DefaultGiftCardCalculator),
+	)
+
+	assert.NoError(t, pipeline.Calculate(context.Background(), cart))
+
+	item := cart.Deliveries[0].Cartitems[0]
+	assert.Equal(t, "source", item.SourceID)
+	assert.True(t, priceDomain.NewFromInt(2000, 100, "€").Equal(item.RowPriceNet))
+	assert.True(t, priceDomain.NewFromInt(200, 100, "€").Equal(item.TotalTaxAmount()))
+	assert.True(t, priceDomain.NewFromInt(2200, 100, "€").Equal(item.RowPriceGross))
+
+	assert.Len(t, cart.Totalitems, 1)
+	assert.True(t, priceDomain.NewFromInt(2400, 100, "€").Equal(cart.GrandTotal()))
+
+	// the first gift card covers the whole grand total
+	assert.True(t, priceDomain.NewFromInt(2400, 100, "€").Equal(cart.AppliedGiftCards[0].Applied))
+	assert.True(t, priceDomain.NewFromInt(600, 100, "€").Equal(cart.AppliedGiftCards[0].Remaining))
+	assert.True(t, cart.AppliedGiftCards[1].Applied.IsZero())
+	assert.True(t, priceDomain.NewFromInt(500, 100, "€").Equal(cart.AppliedGiftCards[1].Remaining))
+}
+
+func TestDefaultTaxCalculator_Calculate(t *testing.T) {
+	itemBuilderProvider := func() *domaincart.ItemBuilder {
+		return &domaincart.ItemBuilder{}
+	}
+	taxCalculator := new(DefaultTaxCalculator).Inject(itemBuilderProvider, &struct {
+		DefaultTaxRate float64 `inject:"config:commerce.cart.defaultCartAdapter.defaultTaxRate,optional"`
+	}{DefaultTaxRate: 10})
+
+	reducedTax := domaincart.Tax{Type: "reduced", Rate: big.NewFloat(5), Amount: priceDomain.NewFromInt(100, 100, "€")}
+	cart := &domaincart.Cart{
+		Deliveries: []domaincart.Delivery{
+			{
+				Cartitems: []domaincart.Item{
+					{
+						ID:               "without-taxes",
+						Qty:              2,
+						SinglePriceNet:   priceDomain.NewFromInt(1000, 100, "€"),
+						SinglePriceGross: priceDomain.NewFromInt(1000, 100, "€"),
+					},
+					{
+						ID:               "with-taxes",
+						Qty:              2,
+						SinglePriceNet:   priceDomain.NewFromInt(1000, 100, "€"),
+						SinglePriceGross: priceDomain.NewFromInt(1050, 100, "€"),
+						RowTaxes:         domaincart.Taxes{reducedTax},
+					},
+				},
+			},
+		},
+	}
+
+	assert.NoError(t, taxCalculator.Calculate(context.Background(), cart))
+
+	items := cart.Deliveries[0].Cartitems
+	if assert.Len(t, items[0].RowTaxes, 1) {
+		assert.Equal(t, "default", items[0].RowTaxes[0].Type)
+		assert.True(t, priceDomain.NewFromInt(200, 100, "€").Equal(items[0].TotalTaxAmount()))
+	}
+	assert.Equal(t, domaincart.Taxes{reducedTax}, items[1].RowTaxes, "taxes of the item are kept")
+}
@@ -0,0 +1,200 @@
+package infrastructure
+
+import (
+	"context"
+	"math/big"
+
+	domaincart "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+type (
+	// DefaultPricingCalculator recalculates the row prices of the items from the single prices and qty
+	DefaultPricingCalculator struct {
+		itemBuilderProvider domaincart.ItemBuilderProvider
+	}
+
+	// DefaultDiscountCalculator keeps the discounts of the items, replace it to apply own promotions
+	DefaultDiscountCalculator struct{}
+
+	// DefaultShippingCalculator keeps the shipping items of the deliveries, replace it to calculate shipping costs
+	DefaultShippingCalculator struct{}
+
+	// DefaultTaxCalculator applies the configured default tax rate to all items without taxes
+	DefaultTaxCalculator struct {
+		itemBuilderProvider domaincart.ItemBuilderProvider
+		defaultTaxRate      float64
+	}
+
+	// DefaultTotalsCalculator adds the gift wrap fees as totalitems
+	DefaultTotalsCalculator struct{}
+
+	// DefaultGiftCardCalculator limits the applied amounts of the gift cards to the grand total
+	DefaultGiftCardCalculator struct{}
+)
+
+var (
+	_ CartCalculator = new(DefaultPricingCalculator)
+	_ CartCalculator = new(DefaultDiscountCalculator)
+	_ CartCalculator = new(DefaultShippingCalculator)
+	_ CartCalculator = new(DefaultTaxCalculator)
+	_ CartCalculator = new(DefaultTotalsCalculator)
+	_ CartCalculator = new(DefaultGiftCardCalculator)
+)
+
+// Inject dependencies
+func (c *DefaultPricingCalculator) Inject(itemBuilderProvider domaincart.ItemBuilderProvider) *DefaultPricingCalculator {
+	c.itemBuilderProvider = itemBuilderProvider
+
+	return c
+}
+
+// Name of the stage
+func (c *DefaultPricingCalculator) Name() string {
+	return CalculationStagePricing
+}
+
+// Calculate rebuilds all items, tax amounts are recalculated from the tax rates of the items
+func (c *DefaultPricingCalculator) Calculate(_ context.Context, cart *domaincart.Cart) error {
+	return rebuildItems(cart, func(item domaincart.Item) (*domaincart.Item, error) {
+		return rebuildItem(c.itemBuilderProvider(), item, item.RowTaxes)
+	})
+}
+
+// Name of the stage
+func (c *DefaultDiscountCalculator) Name() string {
+	return CalculationStageDiscounts
+}
+
+// Calculate does nothing, the in-memory cart has no promotions
+func (c *DefaultDiscountCalculator) Calculate(context.Context, *domaincart.Cart) error {
+	return nil
+}
+
+// Name of the stage
+func (c *DefaultShippingCalculator) Name() string {
+	return CalculationStageShipping
+}
+
+// Calculate does nothing, the in-memory cart has no shipping costs
+func (c *DefaultShippingCalculator) Calculate(context.Context, *domaincart.Cart) error {
+	return nil
+}
+
+// Inject dependencies
+func (c *DefaultTaxCalculator) Inject(
+	itemBuilderProvider domaincart.ItemBuilderProvider,
+	config *struct {
+		DefaultTaxRate float64 `inject:"config:commerce.cart.defaultCartAdapter.defaultTaxRate,optional"`
+	},
+) *DefaultTaxCalculator {
+	c.itemBuilderProvider = itemBuilderProvider
+	if config != nil {
+		c.defaultTaxRate = config.DefaultTaxRate
+	}
+
+	return c
+}
+
+// Name of the stage
+func (c *DefaultTaxCalculator) Name() string {
+	return CalculationStageTaxes
+}
+
+// Calculate adds the default tax to all items without taxes, taxes set by other calculators or the item are kept
+func (c *DefaultTaxCalculator) Calculate(_ context.Context, cart *domaincart.Cart) error {
+	defaultTaxes := domaincart.Taxes{{Type: "default", Rate: big.NewFloat(c.defaultTaxRate)}}
+
+	return rebuildItems(cart, func(item domaincart.Item) (*domaincart.Item, error) {
+		if len(item.RowTaxes) > 0 {
+			return &item, nil
+		}
+
+		return rebuildItem(c.itemBuilderProvider(), item, defaultTaxes)
+	})
+}
+
+// Name of the stage
+func (c *DefaultTotalsCalculator) Name() string {
+	return CalculationStageTotals
+}
+
+// Calculate replaces the gift wrap totalitems of the cart
+func (c *DefaultTotalsCalculator) Calculate(_ context.Context, cart *domaincart.Cart) error {
+	replaceGiftWrapTotalitems(cart)
+
+	return nil
+}
+
+// Name of the stage
+func (c *DefaultGiftCardCalculator) Name() string {
+	return CalculationStageGiftCards
+}
+
+// Calculate applies the gift cards in their order until the grand total is covered, gift cards in other currencies are kept as they are
+func (c *DefaultGiftCardCalculator) Calculate(_ context.Context, cart *domaincart.Cart) error {
+	openTotal := cart.GrandTotal()
+	for i, giftCard := range cart.AppliedGiftCards {
+		available, err := giftCard.Applied.Add(giftCard.Remaining)
+		if err != nil || available.Currency() != openTotal.Currency() {
+			continue
+		}
+
+		applied := available
+		if available.IsGreaterThen(openTotal) {
+			applied = openTotal
+		}
+		if applied.IsNegative() {
+			applied = priceDomain.NewZero(available.Currency())
+		}
+
+		remaining, err := available.Sub(applied)
+		if err != nil {
+			return err
+		}
+
+		cart.AppliedGiftCards[i].Applied = applied
+		cart.AppliedGiftCards[i].Remaining = remaining
+		openTotal, err = openTotal.Sub(applied)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rebuildItems replaces all items of the cart with the rebuilt ones
+func rebuildItems(cart *domaincart.Cart, rebuild func(item domaincart.Item) (*domaincart.Item, error)) error {
+	for d, delivery := range cart.Deliveries {
+		for k, item := range delivery.Cartitems {
+			newItem, err := rebuild(item)
+			if err != nil {
+				return err
+			}
+			cart.Deliveries[d].Cartitems[k] = *newItem
+		}
+	}
+
+	return nil
+}
+
+// rebuildItem builds the item again with the given taxes, tax amounts are recalculated if a rate is given
+func rebuildItem(itemBuilder *domaincart.ItemBuilder, item domaincart.Item, taxes domaincart.Taxes) (*domaincart.Item, error) {
+	itemBuilder.
+		SetFromItem(item).
+		SetSourceID(item.SourceID).
+		SetAdditionalData(item.AdditionalData)
+
+	for _, tax := range taxes {
+		if tax.Rate != nil {
+			itemBuilder.AddTaxInfo(tax.Type, tax.Rate, nil)
+			continue
+		}
+
+		amount := tax.Amount
+		itemBuilder.AddTaxInfo(tax.Type, nil, &amount)
+	}
+
+	return itemBuilder.CalculatePricesAndTax().Build()
+}
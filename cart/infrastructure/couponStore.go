@@ -0,0 +1,258 @@
+package infrastructure
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/coupon"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+)
+
+type (
+	// InMemoryCouponRepository keeps the coupons in memory, coupons can be preset with commerce.cart.coupons.codes
+	InMemoryCouponRepository struct {
+		mx      sync.RWMutex
+		coupons map[string]coupon.Coupon
+	}
+
+	// InMemoryCouponUsageStore keeps the coupon usages in memory, the usages are lost on restart
+	InMemoryCouponUsageStore struct {
+		mx     sync.RWMutex
+		usages []coupon.Usage
+	}
+
+	couponConfig struct {
+		PromotionCode      string  `json:"promotionCode"`
+		ValidFrom          string  `json:"validFrom"`
+		ValidUntil         string  `json:"validUntil"`
+		MaxUses            float64 `json:"maxUses"`
+		MaxUsesPerCustomer float64 `json:"maxUsesPerCustomer"`
+		NotCombinable      bool    `json:"notCombinable"`
+	}
+)
+
+var (
+	_ coupon.Repository = new(InMemoryCouponRepository)
+	_ coupon.UsageStore = new(InMemoryCouponUsageStore)
+)
+
+// Inject dependencies
+func (r *InMemoryCouponRepository) Inject(
+	logger flamingo.Logger,
+	config *struct {
+		Codes config.Map `inject:"config:commerce.cart.coupons.codes,optional"`
+	},
+) *InMemoryCouponRepository {
+	r.coupons = make(map[string]coupon.Coupon)
+
+	if config == nil || config.Codes == nil {
+		return r
+	}
+
+	var configured map[string]couponConfig
+	if err := config.Codes.MapInto(&configured); err != nil {
+		logger.WithField(flamingo.LogKeyModule, "cart").Error("invalid coupon config: ", err)
+		return r
+	}
+
+	for code, couponConfig := range configured {
+		rules, err := couponConfig.rules()
+		if err != nil {
+			logger.WithField(flamingo.LogKeyModule, "cart").Error("invalid validity of coupon ", code, ": ", err)
+			continue
+		}
+		r.coupons[normalizeCouponCode(code)] = coupon.Coupon{Code: code, Rules: rules}
+	}
+
+	return r
+}
+
+// FindByCode returns the coupon, codes are case insensitive
+func (r *InMemoryCouponRepository) FindByCode(_ context.Context, code string) (*coupon.Coupon, error) {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	found, ok := r.coupons[normalizeCouponCode(code)]
+	if !ok {
+		return nil, coupon.ErrNotFound
+	}
+
+	return &found, nil
+}
+
+// Save adds or replaces the coupons
+func (r *InMemoryCouponRepository) Save(_ context.Context, coupons ...coupon.Coupon) error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	for _, c := range coupons {
+		r.coupons[normalizeCouponCode(c.Code)] = c
+	}
+
+	return nil
+}
+
+// Reserve checks the usage limits and stores the usage of the cart in one step
+func (s *InMemoryCouponUsageStore) Reserve(_ context.Context, usage coupon.Usage, rules coupon.Rules) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	uses, customerUses := 0, 0
+	for _, stored := range s.usages {
+		if normalizeCouponCode(stored.Code) != normalizeCouponCode(usage.Code) {
+			continue
+		}
+
+		if stored.CartID == usage.CartID && len(stored.OrderNumbers) == 0 {
+			// already reserved for the cart
+			return nil
+		}
+
+		uses++
+		if stored.IsUsedBy(usage.Customer) {
+			customerUses++
+		}
+	}
+
+	if rules.MaxUses > 0 && uses >= rules.MaxUses {
+		return &coupon.Error{Code: coupon.ErrorCodeUsageLimitReached, CouponCode: usage.Code}
+	}
+
+	if rules.MaxUsesPerCustomer > 0 && customerUses >= rules.MaxUsesPerCustomer {
+		return &coupon.Error{Code: coupon.ErrorCodeCustomerUsageLimitReached, CouponCode: usage.Code}
+	}
+
+	s.usages = append(s.usages, usage)
+
+	return nil
+}
+
+// Confirm assigns the orders to the reserved usages of the cart
+func (s *InMemoryCouponUsageStore) Confirm(_ context.Context, cartID string, orderNumbers []string) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	for i, usage := range s.usages {
+		if usage.CartID == cartID && len(usage.OrderNumbers) == 0 {
+			s.usages[i].OrderNumbers = orderNumbers
+		}
+	}
+
+	return nil
+}
+
+// ReleaseCart removes the reserved usages of the cart that are not confirmed
+func (s *InMemoryCouponUsageStore) ReleaseCart(_ context.Context, cartID string) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.remove(func(usage coupon.Usage) bool {
+		return usage.CartID == cartID && len(usage.OrderNumbers) == 0
+	})
+
+	return nil
+}
+
+// Release removes all usages of the cancelled orders
+func (s *InMemoryCouponUsageStore) Release(_ context.Context, orderNumbers []string) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	cancelled := make(map[string]bool, len(orderNumbers))
+	for _, orderNumber := range orderNumbers {
+		cancelled[orderNumber] = true
+	}
+
+	s.remove(func(usage coupon.Usage) bool {
+		for _, orderNumber := range usage.OrderNumbers {
+			if cancelled[orderNumber] {
+				return true
+			}
+		}
+
+		return false
+	})
+
+	return nil
+}
+
+// CountUses returns how often the coupon has been used
+func (s *InMemoryCouponUsageStore) CountUses(_ context.Context, code string) (int, error) {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	uses := 0
+	for _, usage := range s.usages {
+		if normalizeCouponCode(usage.Code) == normalizeCouponCode(code) {
+			uses++
+		}
+	}
+
+	return uses, nil
+}
+
+// CountCustomerUses returns how often the customer used the coupon
+func (s *InMemoryCouponUsageStore) CountCustomerUses(_ context.Context, code string, customer validation.PurchaseCustomer) (int, error) {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	uses := 0
+	for _, usage := range s.usages {
+		if normalizeCouponCode(usage.Code) != normalizeCouponCode(code) {
+			continue
+		}
+
+		if usage.IsUsedBy(customer) {
+			uses++
+		}
+	}
+
+	return uses, nil
+}
+
+// remove deletes the matching usages, the mutex must be held
+func (s *InMemoryCouponUsageStore) remove(matches func(usage coupon.Usage) bool) {
+	usages := s.usages[:0]
+	for _, usage := range s.usages {
+		if !matches(usage) {
+			usages = append(usages, usage)
+		}
+	}
+	s.usages = usages
+}
+
+// rules of the configured coupon, the validity is given as RFC3339 time
+func (c couponConfig) rules() (coupon.Rules, error) {
+	rules := coupon.Rules{
+		PromotionCode:      c.PromotionCode,
+		MaxUses:            int(c.MaxUses),
+		MaxUsesPerCustomer: int(c.MaxUsesPerCustomer),
+		NotCombinable:      c.NotCombinable,
+	}
+
+	var err error
+	if c.ValidFrom != "" {
+		rules.ValidFrom, err = time.Parse(time.RFC3339, c.ValidFrom)
+		if err != nil {
+			return rules, err
+		}
+	}
+
+	if c.ValidUntil != "" {
+		rules.ValidUntil, err = time.Parse(time.RFC3339, c.ValidUntil)
+		if err != nil {
+			return rules, err
+		}
+	}
+
+	return rules, nil
+}
+
+func normalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
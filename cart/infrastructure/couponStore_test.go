@@ -0,0 +1,63 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/coupon"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+)
+
+func TestInMemoryCouponUsageStore_Reserve(t *testing.T) {
+	ctx := context.Background()
+	rules := coupon.Rules{MaxUses: 3}
+
+	t.Run("parallel reservations can't exceed the usage limit", func(t *testing.T) {
+		store := new(InMemoryCouponUsageStore)
+
+		var wg sync.WaitGroup
+		var mx sync.Mutex
+		reserved := 0
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				err := store.Reserve(ctx, coupon.Usage{Code: "summer", CartID: fmt.Sprintf("cart-%d", i)}, rules)
+				if err == nil {
+					mx.Lock()
+					reserved++
+					mx.Unlock()
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		assert.Equal(t, 3, reserved)
+		uses, err := store.CountUses(ctx, "SUMMER")
+		require.NoError(t, err)
+		assert.Equal(t, 3, uses)
+	})
+
+	t.Run("cancelling one order of a cart releases the usage", func(t *testing.T) {
+		store := new(InMemoryCouponUsageStore)
+		customer := validation.PurchaseCustomer{Email: "a@example.com"}
+
+		require.NoError(t, store.Reserve(ctx, coupon.Usage{Code: "SUMMER", Customer: customer, CartID: "cart-1"}, rules))
+		require.NoError(t, store.Confirm(ctx, "cart-1", []string{"order-1", "order-2"}))
+		require.NoError(t, store.ReleaseCart(ctx, "cart-1"), "confirmed usages are not released with the cart")
+
+		uses, err := store.CountCustomerUses(ctx, "SUMMER", customer)
+		require.NoError(t, err)
+		assert.Equal(t, 1, uses)
+
+		require.NoError(t, store.Release(ctx, []string{"order-2"}))
+		uses, err = store.CountCustomerUses(ctx, "SUMMER", customer)
+		require.NoError(t, err)
+		assert.Equal(t, 0, uses)
+	})
+}
@@ -0,0 +1,41 @@
+package infrastructure
+
+import (
+	"context"
+
+	domaincart "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/coupon"
+)
+
+type (
+	// CouponVoucherHandler applies the coupons of the coupon.Repository as vouchers to the in-memory cart
+	CouponVoucherHandler struct {
+		couponService *coupon.Service
+	}
+)
+
+var _ VoucherHandler = (*CouponVoucherHandler)(nil)
+
+// Inject dependencies
+func (h *CouponVoucherHandler) Inject(couponService *coupon.Service) *CouponVoucherHandler {
+	h.couponService = couponService
+
+	return h
+}
+
+// ApplyVoucher validates the coupon and adds it to the cart, a *coupon.Error is returned if the coupon can not be applied
+func (h *CouponVoucherHandler) ApplyVoucher(ctx context.Context, cart *domaincart.Cart, couponCode string) (*domaincart.Cart, error) {
+	validCoupon, err := h.couponService.Validate(ctx, cart, couponCode)
+	if err != nil {
+		return nil, err
+	}
+
+	cart.AppliedCouponCodes = append(cart.AppliedCouponCodes, validCoupon.AppliedCouponCode())
+
+	return cart, nil
+}
+
+// RemoveVoucher removes the coupon from the cart
+func (h *CouponVoucherHandler) RemoveVoucher(ctx context.Context, cart *domaincart.Cart, couponCode string) (*domaincart.Cart, error) {
+	return DefaultVoucherHandler{}.RemoveVoucher(ctx, cart, couponCode)
+}
@@ -0,0 +1,326 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"flamingo.me/flamingo/v3/framework/config"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+)
+
+type (
+	// DefaultAddressValidator validates and normalizes addresses based on built-in and configured country rules
+	DefaultAddressValidator struct {
+		rules map[string]addressCountryRule
+	}
+
+	// addressCountryRuleConfig is the configuration representation of a country rule
+	addressCountryRuleConfig struct {
+		PostCodePattern string
+		RegionRequired  *bool
+		RegionCodes     []string
+		CallingCode     string
+		// TrunkPrefix is removed from national phone numbers, empty for countries that keep the leading digit (e.g. IT)
+		TrunkPrefix string
+	}
+
+	addressCountryRule struct {
+		postCodePattern *regexp.Regexp
+		regionRequired  bool
+		regionCodes     []string
+		callingCode     string
+		trunkPrefix     string
+	}
+)
+
+var (
+	_ validation.AddressValidator = new(DefaultAddressValidator)
+
+	e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+	phoneSeparators = strings.NewReplacer(" ", "", "-", "", "/", "", "(", "", ")", "", ".", "")
+
+	// defaultAddressCountryRules contains the built-in rules, they can be extended and overwritten by configuration
+	defaultAddressCountryRules = map[string]addressCountryRuleConfig{
+		"AT": {PostCodePattern: `^[0-9]{4}$`, CallingCode: "43", TrunkPrefix: "0"},
+		"BE": {PostCodePattern: `^[0-9]{4}$`, CallingCode: "32", TrunkPrefix: "0"},
+		"CH": {PostCodePattern: `^[0-9]{4}$`, CallingCode: "41", TrunkPrefix: "0"},
+		"DE": {PostCodePattern: `^[0-9]{5}$`, CallingCode: "49", TrunkPrefix: "0"},
+		"ES": {PostCodePattern: `^[0-9]{5}$`, CallingCode: "34"},
+		"FR": {PostCodePattern: `^[0-9]{5}$`, CallingCode: "33", TrunkPrefix: "0"},
+		"GB": {PostCodePattern: `^[A-Z]{1,2}[0-9][A-Z0-9]? [0-9][A-Z]{2}$`, CallingCode: "44", TrunkPrefix: "0"},
+		"IT": {PostCodePattern: `^[0-9]{5}$`, CallingCode: "39"},
+		"NL": {PostCodePattern: `^[0-9]{4} [A-Z]{2}$`, CallingCode: "31", TrunkPrefix: "0"},
+		"PL": {PostCodePattern: `^[0-9]{2}-[0-9]{3}$`, CallingCode: "48"},
+		"US": {PostCodePattern: `^[0-9]{5}(-[0-9]{4})?$`, RegionRequired: boolPtr(true), CallingCode: "1", TrunkPrefix: "1"},
+		"CA": {PostCodePattern: `^[A-Z][0-9][A-Z] [0-9][A-Z][0-9]$`, RegionRequired: boolPtr(true), CallingCode: "1", TrunkPrefix: "1"},
+	}
+
+	// countryCodeAliases maps common alpha-3 codes and english country names to ISO 3166-1 alpha-2 codes
+	countryCodeAliases = map[string]string{
+		"AUT": "AT", "AUSTRIA": "AT",
+		"BEL": "BE", "BELGIUM": "BE",
+		"CHE": "CH", "SWITZERLAND": "CH",
+		"DEU": "DE", "GERMANY": "DE",
+		"ESP": "ES", "SPAIN": "ES",
+		"FRA": "FR", "FRANCE": "FR",
+		"GBR": "GB", "UK": "GB", "UNITED KINGDOM": "GB",
+		"ITA": "IT", "ITALY": "IT",
+		"NLD": "NL", "NETHERLANDS": "NL",
+		"POL": "PL", "POLAND": "PL",
+		"USA": "US", "UNITED STATES": "US",
+		"CAN": "CA", "CANADA": "CA",
+	}
+)
+
+// Inject dependencies
+func (v *DefaultAddressValidator) Inject(
+	config *struct {
+		Countries config.Map `inject:"config:commerce.cart.addressValidation.countries,optional"`
+	},
+) *DefaultAddressValidator {
+	ruleConfigs := make(map[string]addressCountryRuleConfig, len(defaultAddressCountryRules))
+	for country, rule := range defaultAddressCountryRules {
+		ruleConfigs[country] = rule
+	}
+
+	if config != nil && config.Countries != nil {
+		configured := make(map[string]addressCountryRuleConfig)
+		config.Countries.MapInto(&configured)
+		for country, rule := range configured {
+			ruleConfigs[strings.ToUpper(country)] = mergeAddressCountryRule(ruleConfigs[strings.ToUpper(country)], rule)
+		}
+	}
+
+	v.rules = make(map[string]addressCountryRule, len(ruleConfigs))
+	for country, ruleConfig := range ruleConfigs {
+		rule := addressCountryRule{
+			regionCodes: ruleConfig.RegionCodes,
+			callingCode: strings.TrimPrefix(ruleConfig.CallingCode, "+"),
+			trunkPrefix: ruleConfig.TrunkPrefix,
+		}
+		if ruleConfig.RegionRequired != nil {
+			rule.regionRequired = *ruleConfig.RegionRequired
+		}
+		if ruleConfig.PostCodePattern != "" {
+			pattern, err := regexp.Compile(ruleConfig.PostCodePattern)
+			if err != nil {
+				panic(fmt.Errorf("invalid config commerce.cart.addressValidation.countries.%s.postCodePattern for country %q: %w", country, country, err))
+			}
+			rule.postCodePattern = pattern
+		}
+		v.rules[country] = rule
+	}
+
+	return v
+}
+
+// Validate normalizes the given address and checks it against the rule of the address country
+func (v *DefaultAddressValidator) Validate(_ context.Context, address cart.Address) validation.AddressValidationResult {
+	result := validation.AddressValidationResult{NormalizedAddress: address}
+	normalized := &result.NormalizedAddress
+
+	normalized.CountryCode = normalizeCountryCode(address.CountryCode, address.Country)
+	if normalized.CountryCode != address.CountryCode {
+		result.Suggestions = append(result.Suggestions, validation.AddressFieldSuggestion{
+			Field:      validation.AddressFieldCountryCode,
+			Value:      normalized.CountryCode,
+			MessageKey: "formsuggestion_countryCode_normalized",
+		})
+	}
+
+	rule := v.rules[normalized.CountryCode]
+	if normalized.CountryCode != "" && len(normalized.CountryCode) != 2 {
+		result.FieldErrors = append(result.FieldErrors, validation.AddressFieldError{
+			Field:        validation.AddressFieldCountryCode,
+			MessageKey:   "formerror_countryCode_invalid",
+			DefaultLabel: "Please enter a valid country",
+		})
+	}
+
+	v.validatePostCode(&result, rule)
+	v.validateRegion(&result, rule)
+	v.validateTelephone(&result, rule)
+
+	return result
+}
+
+func (v *DefaultAddressValidator) validatePostCode(result *validation.AddressValidationResult, rule addressCountryRule) {
+	original := result.NormalizedAddress.PostCode
+	if original == "" {
+		return
+	}
+
+	normalized := strings.Join(strings.Fields(strings.ToUpper(original)), " ")
+	if rule.postCodePattern != nil && !rule.postCodePattern.MatchString(normalized) {
+		// postcodes like "1234AB" or "SW1A1AA" are often entered without the separating space
+		matched := false
+		for _, variant := range postCodeSpaceVariants(normalized) {
+			if rule.postCodePattern.MatchString(variant) {
+				normalized = variant
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			result.FieldErrors = append(result.FieldErrors, validation.AddressFieldError{
+				Field:        validation.AddressFieldPostCode,
+				MessageKey:   "formerror_postCode_invalid",
+				DefaultLabel: "Please enter a valid postcode",
+			})
+			return
+		}
+	}
+
+	result.NormalizedAddress.PostCode = normalized
+	if normalized != original {
+		result.Suggestions = append(result.Suggestions, validation.AddressFieldSuggestion{
+			Field:      validation.AddressFieldPostCode,
+			Value:      normalized,
+			MessageKey: "formsuggestion_postCode_normalized",
+		})
+	}
+}
+
+func (v *DefaultAddressValidator) validateRegion(result *validation.AddressValidationResult, rule addressCountryRule) {
+	regionCode := strings.ToUpper(strings.TrimSpace(result.NormalizedAddress.RegionCode))
+	if regionCode == "" {
+		if rule.regionRequired {
+			result.FieldErrors = append(result.FieldErrors, validation.AddressFieldError{
+				Field:        validation.AddressFieldRegionCode,
+				MessageKey:   "formerror_regionCode_required",
+				DefaultLabel: "Please select a region",
+			})
+		}
+		return
+	}
+
+	if len(rule.regionCodes) > 0 && !containsString(rule.regionCodes, regionCode) {
+		result.FieldErrors = append(result.FieldErrors, validation.AddressFieldError{
+			Field:        validation.AddressFieldRegionCode,
+			MessageKey:   "formerror_regionCode_invalid",
+			DefaultLabel: "Please select a valid region",
+		})
+		return
+	}
+
+	if regionCode != result.NormalizedAddress.RegionCode {
+		result.NormalizedAddress.RegionCode = regionCode
+		result.Suggestions = append(result.Suggestions, validation.AddressFieldSuggestion{
+			Field:      validation.AddressFieldRegionCode,
+			Value:      regionCode,
+			MessageKey: "formsuggestion_regionCode_normalized",
+		})
+	}
+}
+
+func (v *DefaultAddressValidator) validateTelephone(result *validation.AddressValidationResult, rule addressCountryRule) {
+	original := result.NormalizedAddress.Telephone
+	if original == "" {
+		return
+	}
+
+	normalized := normalizePhoneNumber(original, rule.callingCode, rule.trunkPrefix)
+	if !e164Pattern.MatchString(normalized) {
+		result.FieldErrors = append(result.FieldErrors, validation.AddressFieldError{
+			Field:        validation.AddressFieldTelephone,
+			MessageKey:   "formerror_telephone_invalid",
+			DefaultLabel: "Please enter a valid phone number",
+		})
+		return
+	}
+
+	result.NormalizedAddress.Telephone = normalized
+	if normalized != original {
+		result.Suggestions = append(result.Suggestions, validation.AddressFieldSuggestion{
+			Field:      validation.AddressFieldTelephone,
+			Value:      normalized,
+			MessageKey: "formsuggestion_telephone_normalized",
+		})
+	}
+}
+
+// normalizeCountryCode returns the ISO 3166-1 alpha-2 code for the given code or country name
+func normalizeCountryCode(countryCode string, country string) string {
+	code := strings.ToUpper(strings.TrimSpace(countryCode))
+	if alias, found := countryCodeAliases[code]; found {
+		return alias
+	}
+	if len(code) == 2 {
+		return code
+	}
+	if alias, found := countryCodeAliases[strings.ToUpper(strings.TrimSpace(country))]; found {
+		return alias
+	}
+
+	return code
+}
+
+// normalizePhoneNumber converts a phone number to E.164 using the calling code for national numbers,
+// the trunk prefix of the country is removed from national numbers
+func normalizePhoneNumber(phone string, callingCode string, trunkPrefix string) string {
+	phone = phoneSeparators.Replace(strings.TrimSpace(phone))
+
+	switch {
+	case strings.HasPrefix(phone, "+"):
+		return phone
+	case strings.HasPrefix(phone, "00"):
+		return "+" + strings.TrimPrefix(phone, "00")
+	case callingCode == "":
+		return phone
+	case trunkPrefix != "" && strings.HasPrefix(phone, trunkPrefix):
+		return "+" + callingCode + strings.TrimPrefix(phone, trunkPrefix)
+	default:
+		return "+" + callingCode + phone
+	}
+}
+
+// postCodeSpaceVariants returns the postcode with a space between outward and inward code,
+// e.g. "SW1A1AA" becomes "SW1A 1AA" and "1234AB" becomes "1234 AB"
+func postCodeSpaceVariants(postCode string) []string {
+	if strings.Contains(postCode, " ") || len(postCode) < 5 {
+		return nil
+	}
+
+	return []string{
+		postCode[:len(postCode)-3] + " " + postCode[len(postCode)-3:],
+		postCode[:len(postCode)-2] + " " + postCode[len(postCode)-2:],
+	}
+}
+
+func mergeAddressCountryRule(base addressCountryRuleConfig, override addressCountryRuleConfig) addressCountryRuleConfig {
+	if override.PostCodePattern != "" {
+		base.PostCodePattern = override.PostCodePattern
+	}
+	if override.RegionRequired != nil {
+		base.RegionRequired = override.RegionRequired
+	}
+	if override.RegionCodes != nil {
+		base.RegionCodes = override.RegionCodes
+	}
+	if override.CallingCode != "" {
+		base.CallingCode = override.CallingCode
+	}
+	if override.TrunkPrefix != "" {
+		base.TrunkPrefix = override.TrunkPrefix
+	}
+
+	return base
+}
+
+func containsString(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if strings.EqualFold(s, needle) {
+			return true
+		}
+	}
+	return false
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,144 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo/v3/framework/config"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+)
+
+func TestDefaultAddressValidator_Validate(t *testing.T) {
+	tests := []struct {
+		name            string
+		address         cart.Address
+		wantAddress     cart.Address
+		wantErrorFields []string
+		wantSuggestions []validation.AddressFieldSuggestion
+	}{
+		{
+			name:        "valid german address stays untouched",
+			address:     cart.Address{CountryCode: "DE", PostCode: "80331", Telephone: "+49891234567"},
+			wantAddress: cart.Address{CountryCode: "DE", PostCode: "80331", Telephone: "+49891234567"},
+		},
+		{
+			name:        "country code and national phone number are normalized",
+			address:     cart.Address{CountryCode: "deu", PostCode: "80331", Telephone: "089 / 123 45-67"},
+			wantAddress: cart.Address{CountryCode: "DE", PostCode: "80331", Telephone: "+49891234567"},
+			wantSuggestions: []validation.AddressFieldSuggestion{
+				{Field: validation.AddressFieldCountryCode, Value: "DE", MessageKey: "formsuggestion_countryCode_normalized"},
+				{Field: validation.AddressFieldTelephone, Value: "+49891234567", MessageKey: "formsuggestion_telephone_normalized"},
+			},
+		},
+		{
+			name:        "italian phone number keeps the leading zero",
+			address:     cart.Address{CountryCode: "IT", PostCode: "00184", Telephone: "06 1234 5678"},
+			wantAddress: cart.Address{CountryCode: "IT", PostCode: "00184", Telephone: "+390612345678"},
+			wantSuggestions: []validation.AddressFieldSuggestion{
+				{Field: validation.AddressFieldTelephone, Value: "+390612345678", MessageKey: "formsuggestion_telephone_normalized"},
+			},
+		},
+		{
+			name:        "country name is used if no country code is given",
+			address:     cart.Address{Country: "Netherlands", PostCode: "1234ab", Telephone: "0031 20 1234567"},
+			wantAddress: cart.Address{Country: "Netherlands", CountryCode: "NL", PostCode: "1234 AB", Telephone: "+31201234567"},
+			wantSuggestions: []validation.AddressFieldSuggestion{
+				{Field: validation.AddressFieldCountryCode, Value: "NL", MessageKey: "formsuggestion_countryCode_normalized"},
+				{Field: validation.AddressFieldPostCode, Value: "1234 AB", MessageKey: "formsuggestion_postCode_normalized"},
+				{Field: validation.AddressFieldTelephone, Value: "+31201234567", MessageKey: "formsuggestion_telephone_normalized"},
+			},
+		},
+		{
+			name:        "british postcode gets separating space",
+			address:     cart.Address{CountryCode: "GB", PostCode: "sw1a1aa"},
+			wantAddress: cart.Address{CountryCode: "GB", PostCode: "SW1A 1AA"},
+			wantSuggestions: []validation.AddressFieldSuggestion{
+				{Field: validation.AddressFieldPostCode, Value: "SW1A 1AA", MessageKey: "formsuggestion_postCode_normalized"},
+			},
+		},
+		{
+			name:            "invalid postcode, missing region and invalid phone",
+			address:         cart.Address{CountryCode: "US", PostCode: "1234", Telephone: "abc"},
+			wantAddress:     cart.Address{CountryCode: "US", PostCode: "1234", Telephone: "abc"},
+			wantErrorFields: []string{validation.AddressFieldPostCode, validation.AddressFieldRegionCode, validation.AddressFieldTelephone},
+		},
+		{
+			name:            "invalid country code",
+			address:         cart.Address{CountryCode: "XYZ"},
+			wantAddress:     cart.Address{CountryCode: "XYZ"},
+			wantErrorFields: []string{validation.AddressFieldCountryCode},
+		},
+		{
+			name:        "unknown country without rules is only normalized",
+			address:     cart.Address{CountryCode: "jp", PostCode: "100-0001", Telephone: "+81 3 1234 5678"},
+			wantAddress: cart.Address{CountryCode: "JP", PostCode: "100-0001", Telephone: "+81312345678"},
+			wantSuggestions: []validation.AddressFieldSuggestion{
+				{Field: validation.AddressFieldCountryCode, Value: "JP", MessageKey: "formsuggestion_countryCode_normalized"},
+				{Field: validation.AddressFieldTelephone, Value: "+81312345678", MessageKey: "formsuggestion_telephone_normalized"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := new(DefaultAddressValidator).Inject(nil)
+			result := validator.Validate(context.Background(), tt.address)
+
+			assert.Equal(t, tt.wantAddress, result.NormalizedAddress)
+			assert.Equal(t, tt.wantSuggestions, result.Suggestions)
+			assert.Equal(t, len(tt.wantErrorFields) == 0, result.IsValid())
+			assert.Len(t, result.FieldErrors, len(tt.wantErrorFields))
+			for _, field := range tt.wantErrorFields {
+				assert.True(t, result.HasErrorForField(field), "expected error for field %q", field)
+			}
+		})
+	}
+}
+
+func TestDefaultAddressValidator_ConfiguredRules(t *testing.T) {
+	validator := new(DefaultAddressValidator).Inject(&struct {
+		Countries config.Map `inject:"config:commerce.cart.addressValidation.countries,optional"`
+	}{
+		Countries: config.Map{
+			"de": config.Map{
+				"regionRequired": true,
+				"regionCodes":    config.Slice{"BY", "BE"},
+			},
+			"JP": config.Map{
+				"callingCode": "+81",
+				"trunkPrefix": "0",
+			},
+		},
+	})
+
+	result := validator.Validate(context.Background(), cart.Address{CountryCode: "JP", Telephone: "03-1234-5678"})
+	assert.Equal(t, "+81312345678", result.NormalizedAddress.Telephone)
+
+	result = validator.Validate(context.Background(), cart.Address{CountryCode: "DE", PostCode: "80331"})
+	assert.True(t, result.HasErrorForField(validation.AddressFieldRegionCode))
+
+	result = validator.Validate(context.Background(), cart.Address{CountryCode: "DE", PostCode: "80331", RegionCode: "HH"})
+	assert.True(t, result.HasErrorForField(validation.AddressFieldRegionCode))
+
+	result = validator.Validate(context.Background(), cart.Address{CountryCode: "DE", PostCode: "8033", RegionCode: "by"})
+	assert.Equal(t, "BY", result.NormalizedAddress.RegionCode)
+	assert.True(t, result.HasErrorForField(validation.AddressFieldPostCode), "built-in postcode rule must be kept")
+}
+
+func TestDefaultAddressValidator_InvalidPostCodePattern(t *testing.T) {
+	assert.PanicsWithError(t, "invalid config commerce.cart.addressValidation.countries.AT.postCodePattern for country \"AT\": error parsing regexp: missing closing ]: `[0-9{4}$`", func() {
+		new(DefaultAddressValidator).Inject(&struct {
+			Countries config.Map `inject:"config:commerce.cart.addressValidation.countries,optional"`
+		}{
+			Countries: config.Map{
+				"at": config.Map{
+					"postCodePattern": "^[0-9{4}$",
+				},
+			},
+		})
+	})
+}
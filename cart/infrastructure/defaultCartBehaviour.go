@@ -3,11 +3,11 @@ package infrastructure
 import (
 	"context"
 	"fmt"
-	"math/big"
 	"math/rand"
 	"strconv"
 
 	domaincart "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/coupon"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/events"
 	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
 	"flamingo.me/flamingo-commerce/v3/product/domain"
@@ -26,7 +26,7 @@ type (
 		cartBuilderProvider     domaincart.BuilderProvider
 		giftCardHandler         GiftCardHandler
 		voucherHandler          VoucherHandler
-		defaultTaxRate          float64
+		calculationPipeline     *CartCalculationPipeline
 	}
 
 	// CartStorage Interface - might be implemented by other persistence types later as well
@@ -60,6 +60,8 @@ var (
 	_ domaincart.ModifyBehaviour             = (*DefaultCartBehaviour)(nil)
 	_ domaincart.GiftCardAndVoucherBehaviour = (*DefaultCartBehaviour)(nil)
 	_ domaincart.CompleteBehaviour           = (*DefaultCartBehaviour)(nil)
+	_ domaincart.GiftWrapBehaviour           = (*DefaultCartBehaviour)(nil)
+	_ domaincart.CurrencyBehaviour           = (*DefaultCartBehaviour)(nil)
 	_ GiftCardHandler                        = (*DefaultGiftCardHandler)(nil)
 	_ VoucherHandler                         = (*DefaultVoucherHandler)(nil)
 )
@@ -74,9 +76,7 @@ func (cob *DefaultCartBehaviour) Inject(
 	cartBuilderProvider domaincart.BuilderProvider,
 	voucherHandler VoucherHandler,
 	giftCardHandler GiftCardHandler,
-	config *struct {
-		DefaultTaxRate float64 `inject:"config:commerce.cart.defaultCartAdapter.defaultTaxRate,optional"`
-	},
+	calculationPipeline *CartCalculationPipeline,
 ) {
 	cob.cartStorage = CartStorage
 	cob.productService = ProductService
@@ -86,9 +86,7 @@ func (cob *DefaultCartBehaviour) Inject(
 	cob.cartBuilderProvider = cartBuilderProvider
 	cob.voucherHandler = voucherHandler
 	cob.giftCardHandler = giftCardHandler
-	if config != nil {
-		cob.defaultTaxRate = config.DefaultTaxRate
-	}
+	cob.calculationPipeline = calculationPipeline
 }
 
 // Complete a cart and remove from storage
@@ -136,7 +134,12 @@ func (cob *DefaultCartBehaviour) DeleteItem(ctx context.Context, cart *domaincar
 		}
 	}
 
-	err := cob.cartStorage.StoreCart(ctx, cart)
+	err := cob.recalculate(ctx, cart)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = cob.cartStorage.StoreCart(ctx, cart)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
 	}
@@ -154,6 +157,11 @@ func (cob *DefaultCartBehaviour) UpdateItem(ctx context.Context, cart *domaincar
 		return nil, nil, err
 	}
 
+	err = cob.recalculate(ctx, cart)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = cob.cartStorage.StoreCart(ctx, cart)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
@@ -175,7 +183,12 @@ func (cob *DefaultCartBehaviour) UpdateItems(ctx context.Context, cart *domainca
 		}
 	}
 
-	err := cob.cartStorage.StoreCart(ctx, cart)
+	err := cob.recalculate(ctx, cart)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = cob.cartStorage.StoreCart(ctx, cart)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
 	}
@@ -201,7 +214,7 @@ func (cob *DefaultCartBehaviour) updateItem(ctx context.Context, cart *domaincar
 			if itemUpdateCommand.SourceID != nil {
 				itemBuilder.SetSourceID(*itemUpdateCommand.SourceID)
 			}
-			itemBuilder.AddTaxInfo("default", big.NewFloat(cob.defaultTaxRate), nil).CalculatePricesAndTax()
+			itemBuilder.CalculatePricesAndTax()
 			newItem, err := itemBuilder.Build()
 			if err != nil {
 				return err
@@ -268,6 +281,11 @@ func (cob *DefaultCartBehaviour) AddToCart(ctx context.Context, cart *domaincart
 		}
 	}
 
+	err = cob.recalculate(ctx, cart)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = cob.cartStorage.StoreCart(ctx, cart)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
@@ -296,7 +314,6 @@ func (cob *DefaultCartBehaviour) buildItemForCart(ctx context.Context, addReques
 
 	itemBuilder.
 		SetQty(addRequest.Qty).
-		AddTaxInfo("default", big.NewFloat(cob.defaultTaxRate), nil).
 		SetByProduct(product).
 		SetID(strconv.Itoa(rand.Int())).
 		SetExternalReference(strconv.Itoa(rand.Int())).
@@ -313,7 +330,12 @@ func (cob *DefaultCartBehaviour) CleanCart(ctx context.Context, cart *domaincart
 
 	cart.Deliveries = []domaincart.Delivery{}
 
-	err := cob.cartStorage.StoreCart(ctx, cart)
+	err := cob.recalculate(ctx, cart)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = cob.cartStorage.StoreCart(ctx, cart)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
 	}
@@ -345,7 +367,12 @@ func (cob *DefaultCartBehaviour) CleanDelivery(ctx context.Context, cart *domain
 	cart.Deliveries[newLength] = domaincart.Delivery{}
 	cart.Deliveries = cart.Deliveries[:newLength]
 
-	err := cob.cartStorage.StoreCart(ctx, cart)
+	err := cob.recalculate(ctx, cart)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = cob.cartStorage.StoreCart(ctx, cart)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
 	}
@@ -353,6 +380,131 @@ func (cob *DefaultCartBehaviour) CleanDelivery(ctx context.Context, cart *domain
 	return cob.resetPaymentSelectionIfInvalid(ctx, cart)
 }
 
+// UpdateItemGiftWrap sets or removes the gift wrapping of an item and updates the gift wrap totalitems
+func (cob *DefaultCartBehaviour) UpdateItemGiftWrap(ctx context.Context, cart *domaincart.Cart, itemID string, giftWrap *domaincart.GiftWrap) (*domaincart.Cart, domaincart.DeferEvents, error) {
+	if !cob.cartStorage.HasCart(ctx, cart.ID) {
+		return nil, nil, fmt.Errorf("cart.infrastructure.DefaultCartBehaviour: Cannot update - Guestcart with id %v not existent", cart.ID)
+	}
+
+	itemDelivery, err := cart.GetDeliveryByItemID(itemID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for k, item := range itemDelivery.Cartitems {
+		if item.ID == itemID {
+			itemDelivery.Cartitems[k].GiftWrap = giftWrap
+		}
+	}
+
+	err = cob.recalculate(ctx, cart)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = cob.cartStorage.StoreCart(ctx, cart)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
+	}
+
+	return cob.resetPaymentSelectionIfInvalid(ctx, cart)
+}
+
+// recalculate runs the calculation pipeline after the items of the cart changed, without pipeline only the gift wrap totalitems are updated
+func (cob *DefaultCartBehaviour) recalculate(ctx context.Context, cart *domaincart.Cart) error {
+	if cob.calculationPipeline == nil {
+		replaceGiftWrapTotalitems(cart)
+		return nil
+	}
+
+	return cob.calculationPipeline.Calculate(ctx, cart)
+}
+
+// replaceGiftWrapTotalitems replaces the gift wrap totalitems of the cart with the fees of the currently wrapped items
+func replaceGiftWrapTotalitems(cart *domaincart.Cart) {
+	giftWrapTotalitems := cart.GiftWrapTotalitems()
+	if len(giftWrapTotalitems) == 0 && len(cart.GetTotalItemsByType(domaincart.TotalsTypeGiftWrap)) == 0 {
+		return
+	}
+
+	totalitems := make([]domaincart.Totalitem, 0, len(cart.Totalitems))
+	for _, totalitem := range cart.Totalitems {
+		if totalitem.Type != domaincart.TotalsTypeGiftWrap {
+			totalitems = append(totalitems, totalitem)
+		}
+	}
+
+	cart.Totalitems = append(totalitems, giftWrapTotalitems...)
+}
+
+// SwitchCurrency re-prices all items, gift wraps and shipping items with the prices of the command
+func (cob *DefaultCartBehaviour) SwitchCurrency(ctx context.Context, cart *domaincart.Cart, command domaincart.CurrencySwitchCommand) (*domaincart.Cart, domaincart.DeferEvents, error) {
+	if !cob.cartStorage.HasCart(ctx, cart.ID) {
+		return nil, nil, fmt.Errorf("cart.infrastructure.DefaultCartBehaviour: Cannot switch currency - Guestcart with id %v not existent", cart.ID)
+	}
+
+	err := command.Validate(*cart)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for d, delivery := range cart.Deliveries {
+		if shippingItem, found := command.ShippingItems[delivery.DeliveryInfo.Code]; found {
+			cart.Deliveries[d].ShippingItem = shippingItem
+		}
+
+		for k, item := range delivery.Cartitems {
+			newItem, err := cob.repriceItem(item, command)
+			if err != nil {
+				return nil, nil, err
+			}
+			cart.Deliveries[d].Cartitems[k] = *newItem
+		}
+	}
+
+	cart.DefaultCurrency = command.Currency
+
+	err = cob.recalculate(ctx, cart)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = cob.cartStorage.StoreCart(ctx, cart)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cart.infrastructure.DefaultCartBehaviour: error on saving cart")
+	}
+
+	return cob.resetPaymentSelectionIfInvalid(ctx, cart)
+}
+
+// repriceItem builds the item from scratch, since the item builder does not allow to mix currencies within an item
+// discounts of the item are not taken over, since they are in the old currency
+func (cob *DefaultCartBehaviour) repriceItem(item domaincart.Item, command domaincart.CurrencySwitchCommand) (*domaincart.Item, error) {
+	var giftWrap *domaincart.GiftWrap
+	if item.HasGiftWrap() {
+		giftWrap = &domaincart.GiftWrap{
+			Code:  item.GiftWrap.Code,
+			Title: item.GiftWrap.Title,
+			Price: command.GiftWrapPrices[item.ID],
+		}
+	}
+
+	price := command.SinglePrices[item.ID]
+
+	return cob.itemBuilderProvider().
+		SetProductData(item.MarketplaceCode, item.VariantMarketPlaceCode, item.ProductName).
+		SetExternalReference(item.ExternalReference).
+		SetID(item.ID).
+		SetQty(item.Qty).
+		SetSourceID(item.SourceID).
+		SetAdditionalData(item.AdditionalData).
+		SetGiftWrap(giftWrap).
+		SetSinglePriceGross(price).
+		SetSinglePriceNet(price).
+		CalculatePricesAndTax().
+		Build()
+}
+
 // UpdatePurchaser @todo implement when needed
 func (cob *DefaultCartBehaviour) UpdatePurchaser(ctx context.Context, cart *domaincart.Cart, purchaser *domaincart.Person, additionalData *domaincart.AdditionalData) (*domaincart.Cart, domaincart.DeferEvents, error) {
 	cart.Purchaser = purchaser
@@ -488,6 +640,11 @@ func (cob *DefaultCartBehaviour) ApplyAny(ctx context.Context, cart *domaincart.
 		return currentCart, deferFunc, nil
 	}
 
+	// known coupons that can not be applied keep their specific error
+	if couponErr, ok := err.(*coupon.Error); ok && couponErr.Code != coupon.ErrorCodeNotFound {
+		return nil, nil, err
+	}
+
 	// some error occurred, retry as giftcard
 	return cob.ApplyGiftCard(ctx, cart, anyCode)
 }
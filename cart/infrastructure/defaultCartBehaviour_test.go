@@ -534,3 +534,121 @@ func TestInMemoryBehaviour_Restore(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestInMemoryBehaviour_UpdateItemGiftWrap(t *testing.T) {
+	t.Run("gift wrap fee is added to and removed from the totalitems", func(t *testing.T) {
+		cob := &DefaultCartBehaviour{}
+		cob.Inject(
+			&InMemoryCartStorage{},
+			nil,
+			flamingo.NullLogger{},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		cart, err := cob.StoreNewCart(context.Background(), &domaincart.Cart{
+			ID: "gift",
+			Deliveries: []domaincart.Delivery{
+				{
+					DeliveryInfo: domaincart.DeliveryInfo{Code: "delivery"},
+					Cartitems:    []domaincart.Item{{ID: "item-1", Qty: 3}},
+				},
+			},
+			Totalitems: []domaincart.Totalitem{{Code: "fee", Type: domaincart.TotalsTypeShipping}},
+		})
+		assert.NoError(t, err)
+
+		giftWrap := &domaincart.GiftWrap{Code: "paper", Title: "Gift paper", Price: priceDomain.NewFromInt(200, 100, "€")}
+		got, _, err := cob.UpdateItemGiftWrap(context.Background(), cart, "item-1", giftWrap)
+		assert.NoError(t, err)
+		assert.Equal(t, giftWrap, got.Deliveries[0].Cartitems[0].GiftWrap)
+		assert.Len(t, got.Totalitems, 2)
+		assert.True(t, priceDomain.NewFromInt(600, 100, "€").Equal(got.SumGiftWrapFees()))
+
+		got, _, err = cob.UpdateItemGiftWrap(context.Background(), got, "item-1", nil)
+		assert.NoError(t, err)
+		assert.Nil(t, got.Deliveries[0].Cartitems[0].GiftWrap)
+		assert.Equal(t, []domaincart.Totalitem{{Code: "fee", Type: domaincart.TotalsTypeShipping}}, got.Totalitems)
+
+		_, _, err = cob.UpdateItemGiftWrap(context.Background(), got, "unknown", giftWrap)
+		assert.Error(t, err)
+	})
+}
+
+func TestInMemoryBehaviour_SwitchCurrency(t *testing.T) {
+	newBehaviour := func() *DefaultCartBehaviour {
+		cob := &DefaultCartBehaviour{}
+		cob.Inject(
+			&InMemoryCartStorage{},
+			nil,
+			flamingo.NullLogger{},
+			func() *domaincart.ItemBuilder {
+				return &domaincart.ItemBuilder{}
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		return cob
+	}
+
+	newCart := func() *domaincart.Cart {
+		return &domaincart.Cart{
+			ID:              "currency",
+			DefaultCurrency: "EUR",
+			Deliveries: []domaincart.Delivery{
+				{
+					DeliveryInfo: domaincart.DeliveryInfo{Code: "delivery"},
+					Cartitems: []domaincart.Item{
+						{
+							ID:               "item-1",
+							Qty:              2,
+							SinglePriceGross: priceDomain.NewFromInt(1000, 100, "EUR"),
+							GiftWrap:         &domaincart.GiftWrap{Code: "paper", Title: "Gift paper", Price: priceDomain.NewFromInt(100, 100, "EUR")},
+						},
+					},
+					ShippingItem: domaincart.ShippingItem{Title: "Standard", PriceNet: priceDomain.NewFromInt(500, 100, "EUR")},
+				},
+			},
+		}
+	}
+
+	t.Run("cart is re-priced in the new currency", func(t *testing.T) {
+		cob := newBehaviour()
+		cart, err := cob.StoreNewCart(context.Background(), newCart())
+		assert.NoError(t, err)
+
+		got, _, err := cob.SwitchCurrency(context.Background(), cart, domaincart.CurrencySwitchCommand{
+			Currency:       "USD",
+			SinglePrices:   map[string]priceDomain.Price{"item-1": priceDomain.NewFromInt(1200, 100, "USD")},
+			GiftWrapPrices: map[string]priceDomain.Price{"item-1": priceDomain.NewFromInt(120, 100, "USD")},
+			ShippingItems:  map[string]domaincart.ShippingItem{"delivery": {Title: "Standard", PriceNet: priceDomain.NewFromInt(600, 100, "USD")}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "USD", got.Currency())
+
+		item := got.Deliveries[0].Cartitems[0]
+		assert.True(t, priceDomain.NewFromInt(2400, 100, "USD").LikelyEqual(item.RowPriceGross))
+		assert.Equal(t, "paper", item.GiftWrap.Code)
+		assert.True(t, priceDomain.NewFromInt(240, 100, "USD").LikelyEqual(got.SumGiftWrapFees()))
+		assert.Equal(t, "USD", got.Deliveries[0].ShippingItem.PriceNet.Currency())
+	})
+
+	t.Run("missing prices are rejected", func(t *testing.T) {
+		cob := newBehaviour()
+		cart, err := cob.StoreNewCart(context.Background(), newCart())
+		assert.NoError(t, err)
+
+		_, _, err = cob.SwitchCurrency(context.Background(), cart, domaincart.CurrencySwitchCommand{
+			Currency:     "USD",
+			SinglePrices: map[string]priceDomain.Price{"item-1": priceDomain.NewFromInt(1200, 100, "USD")},
+		})
+		assert.Equal(t, domaincart.ErrMissingPriceForCurrencySwitch, err)
+		assert.Equal(t, "EUR", cart.Currency())
+	})
+}
@@ -2,6 +2,9 @@ package infrastructure
 
 import (
 	"context"
+	"math/rand"
+	"strconv"
+
 	"flamingo.me/flamingo/v3/core/auth"
 	"flamingo.me/flamingo/v3/framework/flamingo"
 
@@ -17,7 +20,8 @@ type (
 )
 
 var (
-	_ cart.CustomerCartService = (*DefaultCustomerCartService)(nil)
+	_ cart.CustomerCartService           = (*DefaultCustomerCartService)(nil)
+	_ cart.AdditionalCustomerCartService = (*DefaultCustomerCartService)(nil)
 )
 
 // Inject dependencies
@@ -29,9 +33,13 @@ func (cs *DefaultCustomerCartService) Inject(
 	cs.logger = logger
 }
 
-// GetCart gets a customer cart from the in memory customer cart service
-func (cs *DefaultCustomerCartService) GetCart(ctx context.Context, identity auth.Identity, _ string) (*cart.Cart, error) {
+// GetCart gets a customer cart from the in memory customer cart service, the main cart if the cart id is empty or "me"
+func (cs *DefaultCustomerCartService) GetCart(ctx context.Context, identity auth.Identity, cartID string) (*cart.Cart, error) {
 	id := identity.Subject()
+	if cartID != "" && cartID != "me" && cartID != id {
+		return cs.getAdditionalCart(ctx, identity, cartID)
+	}
+
 	foundCart, err := cs.defaultBehaviour.GetCart(ctx, id)
 	if err == nil {
 		return foundCart, err
@@ -45,6 +53,28 @@ func (cs *DefaultCustomerCartService) GetCart(ctx context.Context, identity auth
 	return nil, err
 }
 
+// GetNewCart creates a new cart of the customer next to the main cart
+func (cs *DefaultCustomerCartService) GetNewCart(ctx context.Context, identity auth.Identity) (*cart.Cart, error) {
+	newCart := &cart.Cart{ID: identity.Subject() + "-" + strconv.Itoa(rand.Int())}
+	newCart.BelongsToAuthenticatedUser = true
+	newCart.AuthenticatedUserID = identity.Subject()
+	return cs.defaultBehaviour.StoreNewCart(ctx, newCart)
+}
+
+// getAdditionalCart returns a cart created by GetNewCart, carts of other customers are not found
+func (cs *DefaultCustomerCartService) getAdditionalCart(ctx context.Context, identity auth.Identity, cartID string) (*cart.Cart, error) {
+	foundCart, err := cs.defaultBehaviour.GetCart(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !foundCart.BelongsToAuthenticatedUser || foundCart.AuthenticatedUserID != identity.Subject() {
+		return nil, cart.ErrCartNotFound
+	}
+
+	return foundCart, nil
+}
+
 // GetModifyBehaviour gets the cart order behaviour of the service
 func (cs *DefaultCustomerCartService) GetModifyBehaviour(context.Context, auth.Identity) (cart.ModifyBehaviour, error) {
 	return cs.defaultBehaviour, nil
@@ -95,8 +95,13 @@ func (e *PlaceOrderLoggerAdapter) checkPayment(cart *cartDomain.Cart, payment *p
 
 // logOrder
 func (e *PlaceOrderLoggerAdapter) logOrder(cart *cartDomain.Cart, payment *placeorder.Payment) error {
+	giftOptions := printGiftOptions(cart)
 	if e.useFlamingoLog {
-		e.logger.WithField("placeorder", cart.ID).WithField("cart", cart).Info("Order placed and logged")
+		orderLogger := e.logger.WithField("placeorder", cart.ID).WithField("cart", cart)
+		if len(giftOptions) > 0 {
+			orderLogger = orderLogger.WithField("giftOptions", giftOptions)
+		}
+		orderLogger.Info("Order placed and logged")
 	}
 	if e.logAsFile && e.logDirectory != "" {
 		if !modfile.IsDirectoryPath(e.logDirectory) {
@@ -111,12 +116,14 @@ func (e *PlaceOrderLoggerAdapter) logOrder(cart *cartDomain.Cart, payment *place
 			}
 		}
 		type order struct {
-			Cart    cartDomain.Cart
-			Payment placeorder.Payment
+			Cart        cartDomain.Cart
+			Payment     placeorder.Payment
+			GiftOptions []string `json:",omitempty"`
 		}
 		content, err := json.Marshal(order{
-			Cart:    *cart,
-			Payment: *payment,
+			Cart:        *cart,
+			Payment:     *payment,
+			GiftOptions: giftOptions,
 		})
 		if err != nil {
 			e.logger.Error(err)
@@ -132,6 +139,23 @@ func (e *PlaceOrderLoggerAdapter) logOrder(cart *cartDomain.Cart, payment *place
 	return nil
 }
 
+// printGiftOptions returns one readable line per gift wrapped item and per delivery with gift message
+func printGiftOptions(cart *cartDomain.Cart) []string {
+	var lines []string
+	for _, delivery := range cart.Deliveries {
+		for _, item := range delivery.Cartitems {
+			if item.HasGiftWrap() {
+				lines = append(lines, fmt.Sprintf("item %v (%v): gift wrap %q (%v x %.2f %v)", item.ID, item.ProductName, item.GiftWrap.Title, item.Qty, item.GiftWrap.Price.FloatAmount(), item.GiftWrap.Price.Currency()))
+			}
+		}
+		if giftMessage := delivery.DeliveryInfo.GiftMessage; giftMessage != nil {
+			lines = append(lines, fmt.Sprintf("delivery %v: gift message to %q from %q: %q", delivery.DeliveryInfo.Code, giftMessage.Recipient, giftMessage.Sender, giftMessage.Message))
+		}
+	}
+
+	return lines
+}
+
 // ReserveOrderID returns the reserved order id
 func (e *PlaceOrderLoggerAdapter) ReserveOrderID(ctx context.Context, cart *cartDomain.Cart) (string, error) {
 	return cart.ID, nil
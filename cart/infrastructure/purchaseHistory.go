@@ -0,0 +1,125 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+)
+
+type (
+	// InMemoryPurchaseHistory keeps the purchases in memory, the history is lost on restart
+	InMemoryPurchaseHistory struct {
+		mx        sync.RWMutex
+		purchases []validation.Purchase
+	}
+
+	// FilePurchaseHistory keeps the purchases in memory and persists them as json file
+	FilePurchaseHistory struct {
+		InMemoryPurchaseHistory
+		fileName string
+		loadOnce sync.Once
+		loadErr  error
+	}
+)
+
+var (
+	_ validation.PurchaseHistory = new(InMemoryPurchaseHistory)
+	_ validation.PurchaseHistory = new(FilePurchaseHistory)
+)
+
+// Record stores the purchases
+func (h *InMemoryPurchaseHistory) Record(_ context.Context, purchases []validation.Purchase) error {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	h.purchases = append(h.purchases, purchases...)
+
+	return nil
+}
+
+// PurchasedQty sums the qty of all purchases of the product by the customer since the given time
+func (h *InMemoryPurchaseHistory) PurchasedQty(_ context.Context, customer validation.PurchaseCustomer, marketplaceCode string, since time.Time) (int, error) {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	qty := 0
+	for _, purchase := range h.purchases {
+		if purchase.MarketplaceCode != marketplaceCode || purchase.PurchasedAt.Before(since) || !purchase.Matches(customer) {
+			continue
+		}
+		qty += purchase.Qty
+	}
+
+	return qty, nil
+}
+
+// Inject dependencies
+func (h *FilePurchaseHistory) Inject(
+	config *struct {
+		FileName string `inject:"config:commerce.cart.purchaseLimits.historyFile"`
+	},
+) *FilePurchaseHistory {
+	if config != nil {
+		h.fileName = config.FileName
+	}
+
+	return h
+}
+
+// Record stores the purchases and writes the whole history to the file
+func (h *FilePurchaseHistory) Record(_ context.Context, purchases []validation.Purchase) error {
+	if err := h.load(); err != nil {
+		return err
+	}
+
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	h.purchases = append(h.purchases, purchases...)
+	content, err := json.Marshal(h.purchases)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(h.fileName), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(h.fileName, content, 0644)
+}
+
+// PurchasedQty sums the qty of all purchases of the product by the customer since the given time
+func (h *FilePurchaseHistory) PurchasedQty(ctx context.Context, customer validation.PurchaseCustomer, marketplaceCode string, since time.Time) (int, error) {
+	if err := h.load(); err != nil {
+		return 0, err
+	}
+
+	return h.InMemoryPurchaseHistory.PurchasedQty(ctx, customer, marketplaceCode, since)
+}
+
+// load reads the existing history from the file once
+func (h *FilePurchaseHistory) load() error {
+	h.loadOnce.Do(func() {
+		content, err := ioutil.ReadFile(h.fileName)
+		if errors.Is(err, os.ErrNotExist) {
+			return
+		}
+		if err != nil {
+			h.loadErr = err
+			return
+		}
+
+		h.mx.Lock()
+		defer h.mx.Unlock()
+		h.loadErr = json.Unmarshal(content, &h.purchases)
+	})
+
+	return h.loadErr
+}
@@ -0,0 +1,200 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"runtime"
+	"strconv"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/gomodule/redigo/redis"
+
+	"flamingo.me/flamingo-commerce/v3/cart/application"
+)
+
+type (
+	// RedisCodeAttemptStore keeps the code attempts in redis, so the attempts are limited across all instances
+	RedisCodeAttemptStore struct {
+		pool   *redis.Pool
+		logger flamingo.Logger
+	}
+)
+
+const (
+	// codeAttemptLockPrefix prefixes the hashes with the lockout of a key
+	codeAttemptLockPrefix = "cart_codeattempts_lock_"
+	// codeAttemptCountPrefix prefixes the sorted sets with the attempts of a key, scored by the time of the attempt in milliseconds
+	codeAttemptCountPrefix = "cart_codeattempts_attempts_"
+)
+
+var (
+	_ application.CodeAttemptStore = new(RedisCodeAttemptStore)
+
+	// ErrNoRedisConnection is returned if the underlying connection is erroneous
+	ErrNoRedisConnection = errors.New("no redis connection")
+
+	// incrementAttemptsScript adds the attempt (ARGV[1] now, ARGV[2] window, ARGV[3] unique member), drops the attempts
+	// outside of the sliding window and returns the remaining attempts. The set expires with its latest attempt
+	incrementAttemptsScript = redis.NewScript(1, `
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now - window)
+redis.call("ZADD", KEYS[1], now, ARGV[3])
+redis.call("PEXPIRE", KEYS[1], window)
+return redis.call("ZCARD", KEYS[1])
+`)
+
+	// expireAttemptsScript lets all attempts drop out of the window at the latest after the given duration
+	// (ARGV[1] now, ARGV[2] duration, ARGV[3] window) by moving them back in time
+	expireAttemptsScript = redis.NewScript(1, `
+local latest = tonumber(ARGV[1]) + tonumber(ARGV[2]) - tonumber(ARGV[3])
+for _, member in ipairs(redis.call("ZRANGEBYSCORE", KEYS[1], "(" .. latest, "+inf")) do
+	redis.call("ZADD", KEYS[1], latest, member)
+end
+return 0
+`)
+)
+
+// Inject dependencies
+func (r *RedisCodeAttemptStore) Inject(
+	logger flamingo.Logger,
+	cfg *struct {
+		MaxIdle                 int    `inject:"config:commerce.cart.codeAttempts.redis.maxIdle"`
+		IdleTimeoutMilliseconds int    `inject:"config:commerce.cart.codeAttempts.redis.idleTimeoutMilliseconds"`
+		Network                 string `inject:"config:commerce.cart.codeAttempts.redis.network"`
+		Address                 string `inject:"config:commerce.cart.codeAttempts.redis.address"`
+		Database                int    `inject:"config:commerce.cart.codeAttempts.redis.database"`
+	},
+) *RedisCodeAttemptStore {
+	r.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "RedisCodeAttemptStore")
+	if cfg != nil {
+		r.pool = &redis.Pool{
+			MaxIdle:     cfg.MaxIdle,
+			IdleTimeout: time.Duration(cfg.IdleTimeoutMilliseconds) * time.Millisecond,
+			TestOnBorrow: func(c redis.Conn, t time.Time) error {
+				_, err := c.Do("PING")
+				return err
+			},
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial(cfg.Network, cfg.Address, redis.DialDatabase(cfg.Database))
+			},
+		}
+		runtime.SetFinalizer(r, func(r *RedisCodeAttemptStore) { r.pool.Close() }) // close all connections on destruction
+	}
+
+	return r
+}
+
+// Get returns the lockout of the key
+func (r *RedisCodeAttemptStore) Get(ctx context.Context, key string) (application.CodeAttempts, error) {
+	conn, err := r.conn(ctx)
+	if err != nil {
+		return application.CodeAttempts{}, err
+	}
+	defer conn.Close()
+
+	values, err := redis.Values(conn.Do("HMGET", codeAttemptLockPrefix+key, "lockouts", "lockedUntil"))
+	if err != nil {
+		return application.CodeAttempts{}, err
+	}
+
+	var attempts application.CodeAttempts
+	if len(values) != 2 || values[0] == nil {
+		return attempts, nil
+	}
+
+	attempts.Lockouts, err = redis.Int(values[0], nil)
+	if err != nil {
+		return application.CodeAttempts{}, err
+	}
+
+	lockedUntil, err := redis.Int64(values[1], nil)
+	if err != nil && err != redis.ErrNil {
+		return application.CodeAttempts{}, err
+	}
+
+	if lockedUntil > 0 {
+		attempts.LockedUntil = time.Unix(0, lockedUntil*int64(time.Millisecond))
+	}
+
+	return attempts, nil
+}
+
+// Set stores the lockout of the key, redis removes it after the ttl
+func (r *RedisCodeAttemptStore) Set(ctx context.Context, key string, attempts application.CodeAttempts, ttl time.Duration) error {
+	conn, err := r.conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var lockedUntil int64
+	if !attempts.LockedUntil.IsZero() {
+		lockedUntil = attempts.LockedUntil.UnixNano() / int64(time.Millisecond)
+	}
+
+	err = conn.Send("MULTI")
+	if err != nil {
+		return err
+	}
+	_ = conn.Send("HSET", codeAttemptLockPrefix+key, "lockouts", attempts.Lockouts, "lockedUntil", lockedUntil)
+	_ = conn.Send("PEXPIRE", codeAttemptLockPrefix+key, ttl.Milliseconds())
+	_, err = conn.Do("EXEC")
+
+	return err
+}
+
+// IncrementAttempts atomically adds an attempt of the key to its sorted set and counts the attempts within the sliding window
+func (r *RedisCodeAttemptStore) IncrementAttempts(ctx context.Context, key string, window time.Duration) (int, error) {
+	conn, err := r.conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	member := strconv.FormatInt(now, 10) + "-" + strconv.FormatInt(rand.Int63(), 36)
+
+	return redis.Int(incrementAttemptsScript.Do(conn, codeAttemptCountPrefix+key, now, window.Milliseconds(), member))
+}
+
+// DecrementAttempts atomically removes the latest counted attempt of the key
+func (r *RedisCodeAttemptStore) DecrementAttempts(ctx context.Context, key string) error {
+	conn, err := r.conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Do("ZREMRANGEBYRANK", codeAttemptCountPrefix+key, -1, -1)
+
+	return err
+}
+
+// ExpireAttempts lets all counted attempts of the key drop out of the window after the given duration
+func (r *RedisCodeAttemptStore) ExpireAttempts(ctx context.Context, key string, after time.Duration, window time.Duration) error {
+	conn, err := r.conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	_, err = expireAttemptsScript.Do(conn, codeAttemptCountPrefix+key, now, after.Milliseconds(), window.Milliseconds())
+
+	return err
+}
+
+// conn returns a connection of the pool, the connection must be closed by the caller
+func (r *RedisCodeAttemptStore) conn(ctx context.Context) (redis.Conn, error) {
+	conn := r.pool.Get()
+	if conn.Err() != nil {
+		r.logger.WithContext(ctx).Error("redis connection failed: ", conn.Err())
+		conn.Close()
+		return nil, ErrNoRedisConnection
+	}
+
+	return conn, nil
+}
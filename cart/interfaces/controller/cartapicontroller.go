@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"net/http"
 	"net/url"
 	"strconv"
 
@@ -28,6 +29,8 @@ type (
 		billingAddressFormController *forms.BillingAddressFormController
 		deliveryFormController       *forms.DeliveryFormController
 		simplePaymentFormController  *forms.SimplePaymentFormController
+		giftOptionsService           *application.GiftOptionsService
+		currencyService              *application.CurrencyService
 	}
 
 	// CartAPIResult view data
@@ -41,6 +44,11 @@ type (
 		CartValidationResult *validation.Result
 	}
 
+	giftOptionsResult struct {
+		GiftWraps            []cart.GiftWrap
+		GiftMessageMaxLength int
+	}
+
 	getCartResult struct {
 		Cart                 *cart.Cart
 		CartValidationResult *validation.Result
@@ -67,6 +75,8 @@ func (cc *CartAPIController) Inject(
 	billingAddressFormController *forms.BillingAddressFormController,
 	deliveryFormController *forms.DeliveryFormController,
 	simplePaymentFormController *forms.SimplePaymentFormController,
+	giftOptionsService *application.GiftOptionsService,
+	currencyService *application.CurrencyService,
 	Logger flamingo.Logger,
 ) {
 	cc.responder = responder
@@ -76,6 +86,8 @@ func (cc *CartAPIController) Inject(
 	cc.billingAddressFormController = billingAddressFormController
 	cc.deliveryFormController = deliveryFormController
 	cc.simplePaymentFormController = simplePaymentFormController
+	cc.giftOptionsService = giftOptionsService
+	cc.currencyService = currencyService
 }
 
 // GetAction Get JSON Format of API
@@ -143,6 +155,7 @@ func (cc *CartAPIController) AddAction(ctx context.Context, r *web.Request) web.
 // @Produce json
 // @Success 200 {object} CartAPIResult
 // @Failure 500 {object} CartAPIResult
+// @Failure 429 {object} CartAPIResult "too many invalid codes, see Retry-After header"
 // @Param couponCode query string true "the couponCode that should be applied"
 // @Router /api/v1/cart/applyvoucher [post]
 // @Router /api/v1/cart/applyvoucher [put]
@@ -189,6 +202,7 @@ func (cc *CartAPIController) DeleteCartAction(ctx context.Context, r *web.Reques
 // @Produce json
 // @Success 200 {object} CartAPIResult
 // @Failure 500 {object} CartAPIResult
+// @Failure 429 {object} CartAPIResult "too many invalid codes, see Retry-After header"
 // @Param couponCode query string true "the couponCode that should be applied as giftcart"
 // @Router /api/v1/cart/applygiftcard [post]
 // @Router /api/v1/cart/applygiftcard [put]
@@ -204,6 +218,7 @@ func (cc *CartAPIController) ApplyGiftCardAndGetAction(ctx context.Context, r *w
 // @Produce json
 // @Success 200 {object} CartAPIResult
 // @Failure 500 {object} CartAPIResult
+// @Failure 429 {object} CartAPIResult "too many invalid codes, see Retry-After header"
 // @Param couponCode query string true "the couponCode that should be applied as giftcart or voucher"
 // @Router /api/v1/cart/applycombinedvouchergift [post]
 func (cc *CartAPIController) ApplyCombinedVoucherGift(ctx context.Context, r *web.Request) web.Result {
@@ -234,6 +249,14 @@ func (cc *CartAPIController) handlePromotionAction(ctx context.Context, r *web.R
 		response := cc.responder.Data(result)
 		response.Status(500)
 
+		if blockedErr, ok := err.(*application.CodeAttemptsBlockedError); ok {
+			response.Status(http.StatusTooManyRequests)
+			if response.Header == nil {
+				response.Header = make(http.Header)
+			}
+			response.Header.Set("Retry-After", strconv.Itoa(blockedErr.RetryAfterSeconds()))
+		}
+
 		return response
 	}
 	cc.enrichResultWithCartInfos(ctx, &result)
@@ -393,6 +416,138 @@ func (cc *CartAPIController) UpdatePaymentSelectionAction(ctx context.Context, r
 	return cc.responder.Data(result)
 }
 
+// GetGiftOptionsAction returns the available gift wrappings and the gift message constraints
+// @Summary Get the available gift wrappings and the maximum length of gift messages
+// @Tags v1 Cart ajax API
+// @Produce json
+// @Success 200 {object} CartAPIResult{data=giftOptionsResult}
+// @Failure 500 {object} CartAPIResult
+// @Router /api/v1/cart/giftoptions [get]
+func (cc *CartAPIController) GetGiftOptionsAction(ctx context.Context, r *web.Request) web.Result {
+	result := newResult()
+	giftWraps, err := cc.giftOptionsService.GetGiftWrapOptions(ctx, r.Session())
+	if err != nil {
+		cc.logger.WithContext(ctx).Error("cart.cartapicontroller.giftoptions: %v", err.Error())
+		result.SetError(err, "giftoptions_error")
+		return cc.responder.Data(result).Status(500)
+	}
+
+	result.Data = giftOptionsResult{
+		GiftWraps:            giftWraps,
+		GiftMessageMaxLength: cc.giftOptionsService.GetGiftMessageMaxLength(),
+	}
+	return cc.responder.Data(result)
+}
+
+// UpdateItemGiftWrapAction sets the gift wrapping of a cart item
+// @Summary Sets the gift wrapping of a cart item, the fee is added to the cart totals
+// @Tags v1 Cart ajax API
+// @Produce json
+// @Success 200 {object} CartAPIResult
+// @Failure 500 {object} CartAPIResult
+// @Param itemID path string true "the id of the cart item"
+// @Param giftWrapCode query string true "the code of the gift wrapping"
+// @Router /api/v1/cart/item/{itemID}/giftwrap [put]
+func (cc *CartAPIController) UpdateItemGiftWrapAction(ctx context.Context, r *web.Request) web.Result {
+	return cc.handleItemGiftWrap(ctx, r, r.Params["giftWrapCode"])
+}
+
+// RemoveItemGiftWrapAction removes the gift wrapping of a cart item
+// @Summary Removes the gift wrapping of a cart item
+// @Tags v1 Cart ajax API
+// @Produce json
+// @Success 200 {object} CartAPIResult
+// @Failure 500 {object} CartAPIResult
+// @Param itemID path string true "the id of the cart item"
+// @Router /api/v1/cart/item/{itemID}/giftwrap [delete]
+func (cc *CartAPIController) RemoveItemGiftWrapAction(ctx context.Context, r *web.Request) web.Result {
+	return cc.handleItemGiftWrap(ctx, r, "")
+}
+
+func (cc *CartAPIController) handleItemGiftWrap(ctx context.Context, r *web.Request, giftWrapCode string) web.Result {
+	result := newResult()
+	err := cc.giftOptionsService.UpdateItemGiftWrap(ctx, r.Session(), r.Params["itemID"], giftWrapCode)
+	if err != nil {
+		cc.logger.WithContext(ctx).Error("cart.cartapicontroller.giftwrap: %v", err.Error())
+		result.SetError(err, "giftwrap_error")
+		return cc.responder.Data(result).Status(500)
+	}
+	cc.enrichResultWithCartInfos(ctx, &result)
+	return cc.responder.Data(result)
+}
+
+// SwitchCurrencyAction re-prices the cart in another currency
+// @Summary Switches the currency of the cart, all prices are re-calculated in the new currency
+// @Tags v1 Cart ajax API
+// @Produce json
+// @Success 200 {object} CartAPIResult
+// @Failure 500 {object} CartAPIResult
+// @Param currency query string true "the new currency, e.g. USD"
+// @Router /api/v1/cart/currency [put]
+func (cc *CartAPIController) SwitchCurrencyAction(ctx context.Context, r *web.Request) web.Result {
+	result := newResult()
+	err := cc.currencyService.SwitchCurrency(ctx, r.Session(), r.Params["currency"])
+	if err != nil {
+		cc.logger.WithContext(ctx).Error("cart.cartapicontroller.currency: %v", err.Error())
+		result.SetError(err, "currency_error")
+		return cc.responder.Data(result).Status(500)
+	}
+	cc.enrichResultWithCartInfos(ctx, &result)
+	return cc.responder.Data(result)
+}
+
+// UpdateGiftMessageAction sets the gift message of a delivery
+// @Summary Sets the gift message that is attached to the delivery as greeting card
+// @Tags v1 Cart ajax API
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Success 200 {object} CartAPIResult
+// @Failure 400 {object} CartAPIResult
+// @Failure 500 {object} CartAPIResult
+// @Param deliveryCode path string true "the idendifier for the delivery in the cart"
+// @Param recipient formData string false "recipient"
+// @Param sender formData string false "sender"
+// @Param message formData string true "message"
+// @Router /api/v1/cart/delivery/{deliveryCode}/giftmessage [put]
+func (cc *CartAPIController) UpdateGiftMessageAction(ctx context.Context, r *web.Request) web.Result {
+	recipient, _ := r.Form1("recipient")
+	sender, _ := r.Form1("sender")
+	message, _ := r.Form1("message")
+
+	return cc.handleGiftMessage(ctx, r, &cart.GiftMessage{
+		Recipient: recipient,
+		Sender:    sender,
+		Message:   message,
+	})
+}
+
+// RemoveGiftMessageAction removes the gift message of a delivery
+// @Summary Removes the gift message of a delivery
+// @Tags v1 Cart ajax API
+// @Produce json
+// @Success 200 {object} CartAPIResult
+// @Failure 500 {object} CartAPIResult
+// @Param deliveryCode path string true "the idendifier for the delivery in the cart"
+// @Router /api/v1/cart/delivery/{deliveryCode}/giftmessage [delete]
+func (cc *CartAPIController) RemoveGiftMessageAction(ctx context.Context, r *web.Request) web.Result {
+	return cc.handleGiftMessage(ctx, r, nil)
+}
+
+func (cc *CartAPIController) handleGiftMessage(ctx context.Context, r *web.Request, giftMessage *cart.GiftMessage) web.Result {
+	result := newResult()
+	err := cc.giftOptionsService.UpdateDeliveryGiftMessage(ctx, r.Session(), r.Params["deliveryCode"], giftMessage)
+	if err != nil {
+		result.SetError(err, "giftmessage_error")
+		if _, ok := err.(*validation.GiftMessageValidationError); ok {
+			return cc.responder.Data(result).Status(400)
+		}
+		cc.logger.WithContext(ctx).Error("cart.cartapicontroller.giftmessage: %v", err.Error())
+		return cc.responder.Data(result).Status(500)
+	}
+	cc.enrichResultWithCartInfos(ctx, &result)
+	return cc.responder.Data(result)
+}
+
 func (cc *CartAPIController) enrichResultWithCartInfos(ctx context.Context, result *CartAPIResult) {
 	session := web.SessionFromContext(ctx)
 	decoratedCart, err := cc.cartReceiverService.ViewDecoratedCart(ctx, session)
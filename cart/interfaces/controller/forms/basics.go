@@ -1,7 +1,15 @@
 package forms
 
 import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	formDomain "flamingo.me/form/domain"
+
+	cartApplication "flamingo.me/flamingo-commerce/v3/cart/application"
+
 	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
 	"flamingo.me/flamingo-commerce/v3/customer/domain"
 )
 
@@ -29,9 +37,20 @@ type (
 		PhoneCountryCode string `form:"phoneCountryCode" conform:"trim"`
 		PhoneNumber      string `form:"phoneNumber" conform:"trim"`
 		Email            string `form:"email" validate:"required,email" conform:"trim,lowercase"`
+		// AddressBookID references an address of the customer address book that should be used instead of the entered data
+		AddressBookID string `form:"addressBookId" conform:"trim"`
+		// SaveToAddressBook - the entered address should be stored in the customer address book
+		SaveToAddressBook bool `form:"saveToAddressBook"`
+		// Suggestions - the values of the AddressValidator that were taken over instead of the entered ones, set after a successful submit
+		Suggestions []validation.AddressFieldSuggestion `form:"-"`
 	}
 )
 
+// addressFormFieldNames maps address validation fields to AddressForm fields with a different name
+var addressFormFieldNames = map[string]string{
+	validation.AddressFieldTelephone: "phoneNumber",
+}
+
 //MapToDomainAddress - returns the cart Address Object
 func (a *AddressForm) MapToDomainAddress() cart.Address {
 	lines := make([]string, 2)
@@ -146,3 +165,49 @@ func (a *AddressForm) LoadFromCartAddress(address cart.Address) {
 		a.Company = address.Company
 	}
 }
+
+//AddressFormFieldName - returns the form field name for an address validation field, e.g. "deliveryAddress.postCode"
+func AddressFormFieldName(fieldPrefix string, addressField string) string {
+	fieldName := addressField
+	if mapped, ok := addressFormFieldNames[addressField]; ok {
+		fieldName = mapped
+	}
+	if fieldPrefix == "" {
+		return fieldName
+	}
+	return fieldPrefix + "." + fieldName
+}
+
+//AddAddressValidationErrors - adds the field errors of an address validation result to the form validation info
+func AddAddressValidationErrors(validationInfo *formDomain.ValidationInfo, fieldPrefix string, result validation.AddressValidationResult) {
+	for _, fieldError := range result.FieldErrors {
+		validationInfo.AddFieldError(AddressFormFieldName(fieldPrefix, fieldError.Field), fieldError.MessageKey, fieldError.DefaultLabel)
+	}
+}
+
+// normalizeAddress returns the normalized address of the AddressValidator and the suggestions for the entered address
+func normalizeAddress(ctx context.Context, addressValidator validation.AddressValidator, address cart.Address) (cart.Address, []validation.AddressFieldSuggestion) {
+	if addressValidator == nil {
+		return address, nil
+	}
+
+	result := addressValidator.Validate(ctx, address)
+
+	return result.NormalizedAddress, result.Suggestions
+}
+
+// validateAddressBookReference checks that the referenced address exists in the customer address book and passes the address validator
+func validateAddressBookReference(ctx context.Context, req *web.Request, addressBookService *cartApplication.AddressBookService, addressValidator validation.AddressValidator, fieldPrefix string, addressBookID string) *formDomain.ValidationInfo {
+	validationInfo := formDomain.ValidationInfo{}
+	address, err := addressBookService.GetAddressFromAddressBook(ctx, req, addressBookID)
+	if err != nil {
+		validationInfo.AddFieldError(AddressFormFieldName(fieldPrefix, "addressBookId"), "formerror_addressBookId_notFound", "The selected address could not be found")
+		return &validationInfo
+	}
+
+	if addressValidator != nil {
+		AddAddressValidationErrors(&validationInfo, fieldPrefix, addressValidator.Validate(ctx, *address))
+	}
+
+	return &validationInfo
+}
@@ -0,0 +1,146 @@
+package forms
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	authMock "flamingo.me/flamingo/v3/core/auth/mock"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/stretchr/testify/assert"
+
+	cartApplication "flamingo.me/flamingo-commerce/v3/cart/application"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+	customerApplication "flamingo.me/flamingo-commerce/v3/customer/application"
+	"flamingo.me/flamingo-commerce/v3/customer/domain"
+)
+
+type (
+	addressBookCustomer struct {
+		addresses []domain.Address
+	}
+
+	addressBookCustomerIdentityService struct {
+		customer domain.Customer
+	}
+
+	// countryCodeAddressValidator rejects all addresses without a country code and upper cases the country code
+	countryCodeAddressValidator struct{}
+)
+
+func (c *addressBookCustomer) GetID() string {
+	return "customer"
+}
+
+func (c *addressBookCustomer) GetPersonalData() domain.PersonData {
+	return domain.PersonData{}
+}
+
+func (c *addressBookCustomer) GetAddresses() []domain.Address {
+	return c.addresses
+}
+
+func (c *addressBookCustomer) GetDefaultShippingAddress() *domain.Address {
+	return nil
+}
+
+func (c *addressBookCustomer) GetDefaultBillingAddress() *domain.Address {
+	return nil
+}
+
+func (s *addressBookCustomerIdentityService) GetByIdentity(context.Context, auth.Identity) (domain.Customer, error) {
+	return s.customer, nil
+}
+
+func (countryCodeAddressValidator) Validate(_ context.Context, address cart.Address) validation.AddressValidationResult {
+	result := validation.AddressValidationResult{NormalizedAddress: address}
+	if address.CountryCode == "" {
+		result.FieldErrors = append(result.FieldErrors, validation.AddressFieldError{
+			Field:      validation.AddressFieldCountryCode,
+			MessageKey: "formerror_countryCode_required",
+		})
+	}
+
+	if normalized := strings.ToUpper(address.CountryCode); normalized != address.CountryCode {
+		result.NormalizedAddress.CountryCode = normalized
+		result.Suggestions = append(result.Suggestions, validation.AddressFieldSuggestion{
+			Field:      validation.AddressFieldCountryCode,
+			Value:      normalized,
+			MessageKey: "formsuggestion_countryCode_normalized",
+		})
+	}
+
+	return result
+}
+
+func TestNormalizeAddress(t *testing.T) {
+	address := cart.Address{Firstname: "Home", CountryCode: "de"}
+
+	t.Run("without validator", func(t *testing.T) {
+		normalized, suggestions := normalizeAddress(context.Background(), nil, address)
+		assert.Equal(t, address, normalized)
+		assert.Empty(t, suggestions)
+	})
+
+	t.Run("normalized address and suggestions of the validator", func(t *testing.T) {
+		normalized, suggestions := normalizeAddress(context.Background(), countryCodeAddressValidator{}, address)
+		assert.Equal(t, cart.Address{Firstname: "Home", CountryCode: "DE"}, normalized)
+		assert.Equal(t, []validation.AddressFieldSuggestion{
+			{Field: validation.AddressFieldCountryCode, Value: "DE", MessageKey: "formsuggestion_countryCode_normalized"},
+		}, suggestions)
+	})
+}
+
+func TestValidateAddressBookReference(t *testing.T) {
+	identifier := new(authMock.Identifier).SetIdentifyMethod(
+		func(identifier *authMock.Identifier, ctx context.Context, request *web.Request) (auth.Identity, error) {
+			return &authMock.Identity{Sub: "customer"}, nil
+		},
+	)
+	webIdentityService := new(auth.WebIdentityService).Inject([]auth.RequestIdentifier{identifier}, nil, nil, nil)
+	customerService := new(customerApplication.Service).Inject(
+		webIdentityService,
+		&addressBookCustomerIdentityService{customer: &addressBookCustomer{
+			addresses: []domain.Address{
+				{ID: "home", Firstname: "Home", CountryCode: "DE"},
+				{ID: "incomplete", Firstname: "Incomplete"},
+			},
+		}},
+		nil,
+	)
+	addressBookService := new(cartApplication.AddressBookService).Inject(nil, nil, customerService, flamingo.NullLogger{})
+
+	request := web.CreateRequest(nil, web.EmptySession())
+	ctx := web.ContextWithRequest(context.Background(), request)
+
+	t.Run("valid address of the address book", func(t *testing.T) {
+		validationInfo := validateAddressBookReference(ctx, request, addressBookService, countryCodeAddressValidator{}, "deliveryAddress", "home")
+		assert.Empty(t, validationInfo.GetErrorsForAllFields())
+	})
+
+	t.Run("unknown address", func(t *testing.T) {
+		validationInfo := validateAddressBookReference(ctx, request, addressBookService, countryCodeAddressValidator{}, "deliveryAddress", "unknown")
+		fieldErrors := validationInfo.GetErrorsForAllFields()
+		assert.Len(t, fieldErrors, 1)
+		if assert.Len(t, fieldErrors["deliveryAddress.addressBookId"], 1) {
+			assert.Equal(t, "formerror_addressBookId_notFound", fieldErrors["deliveryAddress.addressBookId"][0].MessageKey)
+		}
+	})
+
+	t.Run("address of the address book fails the address validation", func(t *testing.T) {
+		validationInfo := validateAddressBookReference(ctx, request, addressBookService, countryCodeAddressValidator{}, "", "incomplete")
+		fieldErrors := validationInfo.GetErrorsForAllFields()
+		fieldName := AddressFormFieldName("", validation.AddressFieldCountryCode)
+		if assert.Len(t, fieldErrors[fieldName], 1) {
+			assert.Equal(t, "formerror_countryCode_required", fieldErrors[fieldName][0].MessageKey)
+		}
+	})
+
+	t.Run("address book without address validator", func(t *testing.T) {
+		validationInfo := validateAddressBookReference(ctx, request, addressBookService, nil, "", "incomplete")
+		assert.Empty(t, validationInfo.GetErrorsForAllFields())
+	})
+}
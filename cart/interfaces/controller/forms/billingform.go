@@ -10,6 +10,7 @@ import (
 	"flamingo.me/form/domain"
 
 	cartApplication "flamingo.me/flamingo-commerce/v3/cart/application"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
 	customerApplication "flamingo.me/flamingo-commerce/v3/customer/application"
 )
 
@@ -21,6 +22,8 @@ type (
 	BillingAddressFormService struct {
 		customerApplicationService     *customerApplication.Service
 		applicationCartReceiverService *cartApplication.CartReceiverService
+		addressBookService             *cartApplication.AddressBookService
+		addressValidator               validation.AddressValidator
 	}
 
 	// BillingAddressFormController the (mini) MVC
@@ -30,15 +33,26 @@ type (
 		applicationCartReceiverService *cartApplication.CartReceiverService
 		logger                         flamingo.Logger
 		formHandlerFactory             application.FormHandlerFactory
+		addressBookService             *cartApplication.AddressBookService
+		addressValidator               validation.AddressValidator
 	}
 )
 
 // Inject dependencies
 func (p *BillingAddressFormService) Inject(
 	applicationCartReceiverService *cartApplication.CartReceiverService,
-	customerApplicationService *customerApplication.Service) {
+	customerApplicationService *customerApplication.Service,
+	addressBookService *cartApplication.AddressBookService,
+	optionals *struct {
+		AddressValidator validation.AddressValidator `inject:",optional"`
+	},
+) {
 	p.customerApplicationService = customerApplicationService
 	p.applicationCartReceiverService = applicationCartReceiverService
+	p.addressBookService = addressBookService
+	if optionals != nil {
+		p.addressValidator = optionals.AddressValidator
+	}
 }
 
 // GetFormData provides form data
@@ -62,6 +76,27 @@ func (p *BillingAddressFormService) GetFormData(ctx context.Context, req *web.Re
 	return BillingAddressForm(billingAddressForm), nil
 }
 
+// Validate form data, the address is additionally checked by the AddressValidator if one is bound
+func (p *BillingAddressFormService) Validate(ctx context.Context, req *web.Request, validatorProvider domain.ValidatorProvider, formData interface{}) (*domain.ValidationInfo, error) {
+	billingAddressForm, ok := formData.(BillingAddressForm)
+	if !ok {
+		return nil, errors.New("no BillingAddressForm given")
+	}
+	if billingAddressForm.AddressBookID != "" {
+		return validateAddressBookReference(ctx, req, p.addressBookService, p.addressValidator, "", billingAddressForm.AddressBookID), nil
+	}
+
+	validationInfo := validatorProvider.Validate(ctx, req, billingAddressForm)
+
+	if p.addressValidator != nil {
+		addressForm := AddressForm(billingAddressForm)
+		result := p.addressValidator.Validate(ctx, addressForm.MapToDomainAddress())
+		AddAddressValidationErrors(&validationInfo, "", result)
+	}
+
+	return &validationInfo, nil
+}
+
 // Inject dependencies
 func (c *BillingAddressFormController) Inject(
 	responder *web.Responder,
@@ -69,12 +104,20 @@ func (c *BillingAddressFormController) Inject(
 	applicationCartReceiverService *cartApplication.CartReceiverService,
 	logger flamingo.Logger,
 	formHandlerFactory application.FormHandlerFactory,
+	addressBookService *cartApplication.AddressBookService,
+	optionals *struct {
+		AddressValidator validation.AddressValidator `inject:",optional"`
+	},
 ) {
 	c.responder = responder
 	c.applicationCartReceiverService = applicationCartReceiverService
 	c.applicationCartService = applicationCartService
 	c.formHandlerFactory = formHandlerFactory
+	c.addressBookService = addressBookService
 	c.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "billingform")
+	if optionals != nil {
+		c.addressValidator = optionals.AddressValidator
+	}
 }
 
 func (c *BillingAddressFormController) getFormHandler() (domain.FormHandler, error) {
@@ -116,6 +159,15 @@ func (c *BillingAddressFormController) HandleFormAction(ctx context.Context, r *
 	}
 	addressForm := AddressForm(billingAddressForm)
 	billingAddress := addressForm.MapToDomainAddress()
+	if addressForm.AddressBookID != "" {
+		addressBookAddress, err := c.addressBookService.GetAddressFromAddressBook(ctx, r, addressForm.AddressBookID)
+		if err != nil {
+			return form, false, err
+		}
+		billingAddress = *addressBookAddress
+	}
+	billingAddress, billingAddressForm.Suggestions = normalizeAddress(ctx, c.addressValidator, billingAddress)
+	form.Data = billingAddressForm
 
 	// update Billing
 	err = c.applicationCartService.UpdateBillingAddress(ctx, session, &billingAddress)
@@ -123,5 +175,10 @@ func (c *BillingAddressFormController) HandleFormAction(ctx context.Context, r *
 		c.logger.WithContext(ctx).Error("BillingAddressFormController UpdateBillingAddress Error %v", err)
 		return form, false, err
 	}
+
+	if addressForm.SaveToAddressBook && addressForm.AddressBookID == "" {
+		// errors are logged by the service, the billing address is already stored in the cart
+		_, _ = c.addressBookService.SaveToAddressBook(ctx, r, billingAddress)
+	}
 	return form, true, nil
 }
@@ -12,6 +12,8 @@ import (
 
 	cartApplication "flamingo.me/flamingo-commerce/v3/cart/application"
 	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+	customerApplication "flamingo.me/flamingo-commerce/v3/customer/application"
 )
 
 type (
@@ -29,6 +31,9 @@ type (
 	// DeliveryFormService implements Form(Data)Provider interface of form package
 	DeliveryFormService struct {
 		applicationCartReceiverService *cartApplication.CartReceiverService
+		customerApplicationService     *customerApplication.Service
+		addressBookService             *cartApplication.AddressBookService
+		addressValidator               validation.AddressValidator
 	}
 
 	// DeliveryFormController the (mini) MVC
@@ -39,6 +44,8 @@ type (
 		logger                         flamingo.Logger
 		formHandlerFactory             application.FormHandlerFactory
 		billingAddressFormProvider     *BillingAddressFormService
+		addressBookService             *cartApplication.AddressBookService
+		addressValidator               validation.AddressValidator
 	}
 )
 
@@ -55,8 +62,20 @@ func (d *DeliveryForm) MapToDeliveryInfo(currentInfo cartDomain.DeliveryInfo) ca
 }
 
 //Inject - Inject
-func (p *DeliveryFormService) Inject(applicationCartReceiverService *cartApplication.CartReceiverService) {
+func (p *DeliveryFormService) Inject(
+	applicationCartReceiverService *cartApplication.CartReceiverService,
+	customerApplicationService *customerApplication.Service,
+	addressBookService *cartApplication.AddressBookService,
+	optionals *struct {
+		AddressValidator validation.AddressValidator `inject:",optional"`
+	},
+) {
 	p.applicationCartReceiverService = applicationCartReceiverService
+	p.customerApplicationService = customerApplicationService
+	p.addressBookService = addressBookService
+	if optionals != nil {
+		p.addressValidator = optionals.AddressValidator
+	}
 }
 
 // GetFormData from data provider
@@ -81,6 +100,15 @@ func (p *DeliveryFormService) GetFormData(ctx context.Context, req *web.Request)
 		}
 	}
 
+	if deliveryAddress == (AddressForm{}) && !useBilling {
+		customer, err := p.customerApplicationService.GetForIdentity(ctx, req)
+		if err == nil {
+			if defaultShippingAddress := customer.GetDefaultShippingAddress(); defaultShippingAddress != nil {
+				deliveryAddress.LoadFromCustomerAddress(*defaultShippingAddress)
+			}
+		}
+	}
+
 	return DeliveryForm{
 		DeliveryAddress:   deliveryAddress,
 		UseBillingAddress: useBilling,
@@ -96,10 +124,18 @@ func (p *DeliveryFormService) Validate(ctx context.Context, req *web.Request, va
 	if !ok {
 		return nil, errors.New("No BillingAddressForm given")
 	}
+	if !deliveryForm.UseBillingAddress && deliveryForm.DeliveryAddress.AddressBookID != "" {
+		return validateAddressBookReference(ctx, req, p.addressBookService, p.addressValidator, "deliveryAddress", deliveryForm.DeliveryAddress.AddressBookID), nil
+	}
+
 	validationInfo := domain.ValidationInfo{}
 	if !deliveryForm.UseBillingAddress {
 		//Validate address only if no billing should be used
 		validationInfo = validatorProvider.Validate(ctx, req, deliveryForm)
+		if p.addressValidator != nil {
+			result := p.addressValidator.Validate(ctx, deliveryForm.DeliveryAddress.MapToDomainAddress())
+			AddAddressValidationErrors(&validationInfo, "deliveryAddress", result)
+		}
 	}
 	return &validationInfo, nil
 }
@@ -110,13 +146,22 @@ func (c *DeliveryFormController) Inject(responder *web.Responder,
 	applicationCartReceiverService *cartApplication.CartReceiverService,
 	logger flamingo.Logger,
 	formHandlerFactory application.FormHandlerFactory,
-	billingAddressFormProvider *BillingAddressFormService) {
+	billingAddressFormProvider *BillingAddressFormService,
+	addressBookService *cartApplication.AddressBookService,
+	optionals *struct {
+		AddressValidator validation.AddressValidator `inject:",optional"`
+	},
+) {
 	c.responder = responder
 	c.applicationCartReceiverService = applicationCartReceiverService
 	c.applicationCartService = applicationCartService
 	c.formHandlerFactory = formHandlerFactory
 	c.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "deliveryform")
 	c.billingAddressFormProvider = billingAddressFormProvider
+	c.addressBookService = addressBookService
+	if optionals != nil {
+		c.addressValidator = optionals.AddressValidator
+	}
 }
 
 // GetUnsubmittedForm returns the form with deliveryform data - without validation
@@ -179,6 +224,18 @@ func (c *DeliveryFormController) HandleFormAction(ctx context.Context, r *web.Re
 	}
 
 	deliveryInfo = deliveryForm.MapToDeliveryInfo(deliveryInfo)
+	if !deliveryForm.UseBillingAddress && deliveryForm.DeliveryAddress.AddressBookID != "" {
+		deliveryInfo.DeliveryLocation.Address, err = c.addressBookService.GetAddressFromAddressBook(ctx, r, deliveryForm.DeliveryAddress.AddressBookID)
+		if err != nil {
+			return form, false, err
+		}
+	}
+	if !deliveryForm.UseBillingAddress && deliveryInfo.DeliveryLocation.Address != nil {
+		var normalizedAddress cartDomain.Address
+		normalizedAddress, deliveryForm.DeliveryAddress.Suggestions = normalizeAddress(ctx, c.addressValidator, *deliveryInfo.DeliveryLocation.Address)
+		deliveryInfo.DeliveryLocation.Address = &normalizedAddress
+		form.Data = deliveryForm
+	}
 
 	//update Cart
 	err = c.applicationCartService.UpdateDeliveryInfo(ctx, session, deliverycode, cartDomain.CreateDeliveryInfoUpdateCommand(deliveryInfo))
@@ -186,5 +243,10 @@ func (c *DeliveryFormController) HandleFormAction(ctx context.Context, r *web.Re
 		c.logger.WithContext(ctx).Error("UpdateDeliveryInfo  Error %v", err)
 		return form, false, err
 	}
+
+	if deliveryForm.DeliveryAddress.SaveToAddressBook && deliveryForm.DeliveryAddress.AddressBookID == "" && !deliveryForm.UseBillingAddress {
+		// errors are logged by the service, the delivery address is already stored in the cart
+		_, _ = c.addressBookService.SaveToAddressBook(ctx, r, *deliveryInfo.DeliveryLocation.Address)
+	}
 	return form, true, nil
 }
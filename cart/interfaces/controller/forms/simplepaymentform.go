@@ -40,6 +40,7 @@ type (
 
 		formHandlerFactory       application.FormHandlerFactory
 		simplePaymentFormService *SimplePaymentFormService
+		currencyService          *cartApplication.CurrencyService
 	}
 )
 
@@ -83,6 +84,7 @@ func (c *SimplePaymentFormController) Inject(responder *web.Responder,
 	logger flamingo.Logger,
 	formHandlerFactory application.FormHandlerFactory,
 	simplePaymentFormService *SimplePaymentFormService,
+	currencyService *cartApplication.CurrencyService,
 ) {
 	c.responder = responder
 	c.applicationCartReceiverService = applicationCartReceiverService
@@ -91,6 +93,7 @@ func (c *SimplePaymentFormController) Inject(responder *web.Responder,
 	c.formHandlerFactory = formHandlerFactory
 	c.logger = logger.WithField(flamingo.LogKeyModule, "cart").WithField(flamingo.LogKeyCategory, "simplepaymentform")
 	c.simplePaymentFormService = simplePaymentFormService
+	c.currencyService = currencyService
 }
 
 func (c *SimplePaymentFormController) getFormHandler() (domain.FormHandler, error) {
@@ -137,6 +140,13 @@ func (c *SimplePaymentFormController) HandleFormAction(ctx context.Context, r *w
 
 	paymentSelection := c.simplePaymentFormService.MapFormToPaymentSelection(simplePaymentForm, currentCart)
 
+	// charges in another currency than the cart need a value in the cart currency
+	paymentSelection, err = c.currencyService.ValuePaymentSelection(ctx, currentCart, paymentSelection)
+	if err != nil {
+		c.logger.WithContext(ctx).Error("SimplePaymentFormController ValuePaymentSelection Error %v", err)
+		return form, false, err
+	}
+
 	//update cart
 	err = c.applicationCartService.UpdatePaymentSelection(ctx, session, paymentSelection)
 	if err != nil {
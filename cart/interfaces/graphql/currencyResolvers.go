@@ -0,0 +1,37 @@
+package graphql
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+
+	"flamingo.me/flamingo-commerce/v3/cart/application"
+	"flamingo.me/flamingo-commerce/v3/cart/interfaces/graphql/dto"
+)
+
+// CommerceCartCurrencyResolver resolves the currency switch of the cart
+type CommerceCartCurrencyResolver struct {
+	q               *CommerceCartQueryResolver
+	currencyService *application.CurrencyService
+}
+
+// Inject dependencies
+func (r *CommerceCartCurrencyResolver) Inject(
+	q *CommerceCartQueryResolver,
+	currencyService *application.CurrencyService,
+) *CommerceCartCurrencyResolver {
+	r.q = q
+	r.currencyService = currencyService
+
+	return r
+}
+
+// CommerceCartSwitchCurrency re-prices the current cart in the given currency
+func (r *CommerceCartCurrencyResolver) CommerceCartSwitchCurrency(ctx context.Context, currency string) (*dto.DecoratedCart, error) {
+	err := r.currencyService.SwitchCurrency(ctx, web.SessionFromContext(ctx), currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.q.CommerceCart(ctx)
+}
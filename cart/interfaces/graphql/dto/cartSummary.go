@@ -2,13 +2,15 @@ package dto
 
 import (
 	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
 	"flamingo.me/flamingo-commerce/v3/price/domain"
 )
 
 type (
 	// CartSummary – provides custom graphql interface methods
 	CartSummary struct {
-		cart *cart.Cart
+		cart          *cart.Cart
+		decoratedCart *decorator.DecoratedCart
 	}
 )
 
@@ -24,6 +26,15 @@ func (cs *CartSummary) Discounts() *CartAppliedDiscounts {
 	return &CartAppliedDiscounts{discounts: result}
 }
 
+// LoyaltyEarnings – returns the loyalty points the cart earns per item and per loyalty type
+func (cs *CartSummary) LoyaltyEarnings() decorator.CartLoyaltyEarnings {
+	if cs.decoratedCart == nil {
+		return decorator.CartLoyaltyEarnings{}
+	}
+
+	return cs.decoratedCart.LoyaltyEarnings()
+}
+
 // HasAppliedDiscounts check whether there are any discounts currently applied to the cart
 func (cs *CartSummary) HasAppliedDiscounts() bool {
 	result, _ := cs.cart.HasAppliedDiscounts()
@@ -35,7 +35,7 @@ func (dc *DecoratedCart) GetAllPaymentRequiredItems() PricedItems {
 // CartSummary – returns cart summary
 func (dc *DecoratedCart) CartSummary() CartSummary {
 	dcCart := dc.Cart()
-	return CartSummary{cart: &dcCart}
+	return CartSummary{cart: &dcCart, decoratedCart: dc.decoratedCart}
 }
 
 // NewDecoratedCart – factory method
@@ -14,6 +14,7 @@ type (
 		FormData       forms.AddressForm
 		Processed      bool
 		ValidationInfo ValidationInfo
+		Suggestions    []FieldSuggestion
 	}
 
 	// DeliveryAddressForm is the GraphQL representation of the delivery form
@@ -21,6 +22,7 @@ type (
 		FormData          forms.AddressForm
 		Processed         bool
 		ValidationInfo    ValidationInfo
+		Suggestions       []FieldSuggestion
 		UseBillingAddress bool
 		DeliveryCode      string
 		Method            string
@@ -51,6 +53,16 @@ type (
 		FieldName string
 	}
 
+	// FieldSuggestion contains a proposed value for a form field, e.g. a normalized phone number
+	FieldSuggestion struct {
+		// MessageKey - a key of the suggestion message. Often used to pass to translation func in the template
+		MessageKey string
+		// FieldName
+		FieldName string
+		// Value - the suggested value
+		Value string
+	}
+
 	// SelectedPaymentResult represents the selected payment
 	SelectedPaymentResult struct {
 		//Processed
@@ -0,0 +1,13 @@
+package dto
+
+import (
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+)
+
+type (
+	// GiftOptions contains the available gift wrappings and the gift message constraints
+	GiftOptions struct {
+		GiftWraps            []cart.GiftWrap
+		GiftMessageMaxLength int
+	}
+)
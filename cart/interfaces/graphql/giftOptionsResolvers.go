@@ -0,0 +1,66 @@
+package graphql
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+
+	"flamingo.me/flamingo-commerce/v3/cart/application"
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/interfaces/graphql/dto"
+)
+
+// CommerceCartGiftOptionsResolver resolves the gift wrapping and gift message queries and mutations
+type CommerceCartGiftOptionsResolver struct {
+	q                  *CommerceCartQueryResolver
+	giftOptionsService *application.GiftOptionsService
+}
+
+// Inject dependencies
+func (r *CommerceCartGiftOptionsResolver) Inject(
+	q *CommerceCartQueryResolver,
+	giftOptionsService *application.GiftOptionsService,
+) *CommerceCartGiftOptionsResolver {
+	r.q = q
+	r.giftOptionsService = giftOptionsService
+
+	return r
+}
+
+// CommerceCartGiftOptions returns the available gift options for the current cart
+func (r *CommerceCartGiftOptionsResolver) CommerceCartGiftOptions(ctx context.Context) (*dto.GiftOptions, error) {
+	giftWraps, err := r.giftOptionsService.GetGiftWrapOptions(ctx, web.SessionFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GiftOptions{
+		GiftWraps:            giftWraps,
+		GiftMessageMaxLength: r.giftOptionsService.GetGiftMessageMaxLength(),
+	}, nil
+}
+
+// CommerceCartUpdateItemGiftWrap sets or removes the gift wrapping of an item
+func (r *CommerceCartGiftOptionsResolver) CommerceCartUpdateItemGiftWrap(ctx context.Context, itemID string, giftWrapCode *string) (*dto.DecoratedCart, error) {
+	code := ""
+	if giftWrapCode != nil {
+		code = *giftWrapCode
+	}
+
+	err := r.giftOptionsService.UpdateItemGiftWrap(ctx, web.SessionFromContext(ctx), itemID, code)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.q.CommerceCart(ctx)
+}
+
+// CommerceCartUpdateDeliveryGiftMessage sets or removes the gift message of a delivery
+func (r *CommerceCartGiftOptionsResolver) CommerceCartUpdateDeliveryGiftMessage(ctx context.Context, deliveryCode string, giftMessage *cartDomain.GiftMessage) (*dto.DecoratedCart, error) {
+	err := r.giftOptionsService.UpdateDeliveryGiftMessage(ctx, web.SessionFromContext(ctx), deliveryCode, giftMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.q.CommerceCart(ctx)
+}
@@ -6,6 +6,8 @@ import (
 	"net/url"
 
 	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/coupon"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
 	"flamingo.me/flamingo-commerce/v3/cart/interfaces/controller/forms"
 	cartForms "flamingo.me/flamingo-commerce/v3/cart/interfaces/controller/forms"
 	"flamingo.me/flamingo-commerce/v3/cart/interfaces/graphql/dto"
@@ -14,6 +16,7 @@ import (
 
 	"flamingo.me/flamingo-commerce/v3/cart/application"
 	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 // CommerceCartMutationResolver resolves cart mutations
@@ -25,6 +28,7 @@ type CommerceCartMutationResolver struct {
 	deliveryFormController       *cartForms.DeliveryFormController
 	simplePaymentFormController  *cartForms.SimplePaymentFormController
 	formDataEncoderFactory       formApplication.FormDataEncoderFactory
+	addressValidator             validation.AddressValidator
 }
 
 // Inject dependencies
@@ -34,7 +38,11 @@ func (r *CommerceCartMutationResolver) Inject(q *CommerceCartQueryResolver,
 	formDataEncoderFactory formApplication.FormDataEncoderFactory,
 	simplePaymentFormController *cartForms.SimplePaymentFormController,
 	cartService *application.CartService,
-	cartReceiverService *application.CartReceiverService) *CommerceCartMutationResolver {
+	cartReceiverService *application.CartReceiverService,
+	optionals *struct {
+		AddressValidator validation.AddressValidator `inject:",optional"`
+	},
+) *CommerceCartMutationResolver {
 	r.q = q
 	r.billingAddressFormController = billingAddressFormController
 	r.deliveryFormController = deliveryFormController
@@ -42,6 +50,9 @@ func (r *CommerceCartMutationResolver) Inject(q *CommerceCartQueryResolver,
 	r.simplePaymentFormController = simplePaymentFormController
 	r.cartService = cartService
 	r.cartReceiverService = cartReceiverService
+	if optionals != nil {
+		r.addressValidator = optionals.AddressValidator
+	}
 	return r
 }
 
@@ -105,8 +116,13 @@ func (r *CommerceCartMutationResolver) CommerceCartUpdateBillingAddress(ctx cont
 	if err != nil {
 		return nil, err
 	}
-	return mapCommerceBillingAddressForm(form, success)
+	billingAddressForm, err := mapCommerceBillingAddressForm(form, success)
+	if err != nil {
+		return nil, err
+	}
+	billingAddressForm.Suggestions = r.addressSuggestions(ctx, "", billingAddressForm.FormData)
 
+	return billingAddressForm, nil
 }
 
 //CommerceCartUpdateSelectedPayment resolver method
@@ -138,6 +154,23 @@ func (r *CommerceCartMutationResolver) CommerceCartApplyCouponCodeOrGiftCard(ctx
 
 	_, err := r.cartService.ApplyAny(ctx, req.Session(), code)
 
+	if blockedErr, ok := err.(*application.CodeAttemptsBlockedError); ok {
+		return nil, &gqlerror.Error{
+			Message: blockedErr.Error(),
+			Extensions: map[string]interface{}{
+				"code":       blockedErr.MessageCode(),
+				"retryAfter": blockedErr.RetryAfterSeconds(),
+			},
+		}
+	}
+
+	if couponErr, ok := err.(*coupon.Error); ok {
+		return nil, &gqlerror.Error{
+			Message:    couponErr.Error(),
+			Extensions: map[string]interface{}{"code": couponErr.MessageCode()},
+		}
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -191,6 +224,9 @@ func (r *CommerceCartMutationResolver) CommerceCartUpdateDeliveryAddresses(ctx c
 		if err != nil {
 			return nil, err
 		}
+		if !deliveryAddressForm.UseBillingAddress {
+			deliveryAddressForm.Suggestions = r.addressSuggestions(ctx, "deliveryAddress", deliveryAddressForm.FormData)
+		}
 
 		result = append(result, &deliveryAddressForm)
 	}
@@ -290,6 +326,23 @@ func mapCommerceBillingAddressForm(form *domain.Form, success bool) (*dto.Billin
 	}, nil
 }
 
+// addressSuggestions returns the normalized values proposed by the address validator for the given address form
+func (r *CommerceCartMutationResolver) addressSuggestions(ctx context.Context, fieldPrefix string, addressForm forms.AddressForm) []dto.FieldSuggestion {
+	if r.addressValidator == nil {
+		return nil
+	}
+
+	var suggestions []dto.FieldSuggestion
+	for _, suggestion := range r.addressValidator.Validate(ctx, addressForm.MapToDomainAddress()).Suggestions {
+		suggestions = append(suggestions, dto.FieldSuggestion{
+			MessageKey: suggestion.MessageKey,
+			FieldName:  cartForms.AddressFormFieldName(fieldPrefix, suggestion.Field),
+			Value:      suggestion.Value,
+		})
+	}
+	return suggestions
+}
+
 func mapFieldErrors(validationInfo domain.ValidationInfo) []dto.FieldError {
 	var fieldErrors []dto.FieldError
 	for fieldName, currentFieldErrors := range validationInfo.GetErrorsForAllFields() {
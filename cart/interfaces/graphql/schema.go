@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x4d\x6f\xdc\x38\xd2\xbe\xfb\x57\xd0\x9e\x4b\x4f\x90\x77\x06\xef\x1e\x7d\x6b\x77\x27\x41\x63\xc6\x4e\x62\x3b\xd9\x43\x10\x18\xb4\x54\xdd\xcd\x0d\x45\xca\x24\x65\x5b\x58\xcc\x7f\x5f\xf0\x4b\x22\x29\xea\xc3\x09\x76\x80\xd9\xdd\x39\x4c\x5b\x62\xb1\x58\x64\x3d\x2c\x3e\x55\x54\x54\x5b\x03\xda\xf0\xaa\x02\x51\xc0\xdd\x16\x0a\x2e\xb0\x82\x72\x83\x85\x42\xff\x3c\x41\x08\xa1\x02\x0b\x75\xde\x8b\xe8\x96\x53\xd3\x50\x7a\xe1\x2d\x50\xf2\x08\x82\x80\x3c\x47\x5f\x22\xc1\x6d\x22\xd2\x9e\x7e\x35\x5d\x0f\x30\x6c\xba\x68\x37\xbc\x84\x55\xe9\x1e\xf5\xc3\x39\xba\x51\x82\xb0\xc3\xe9\xcf\x89\x01\x83\xce\x5e\xeb\x9a\xd2\x0f\xb8\xad\x80\xa9\x6b\x78\x68\x88\x80\x72\xa7\xa0\x92\x49\xf7\xbb\x0f\x82\x14\xae\xe9\xb4\x9b\xe4\x4d\x53\x55\x58\xb4\xa9\xac\x7b\x7d\x7a\xf2\xc7\xc9\x49\xbc\x5a\x61\xb3\x5b\xac\x92\xc8\x82\x37\x4c\xa5\x23\xae\xeb\x9a\x12\x28\xb7\xbe\xd9\x8e\x2a\x9b\x2a\x6d\x08\xfa\x19\x23\x13\xb9\x77\x64\xaf\x36\x58\x94\xa3\x72\xef\x04\x66\xe5\x2d\x57\x98\xfe\x9d\xa8\xe3\xac\xb8\x91\xf4\x83\x47\x3d\xd6\x95\x7e\x95\xed\x77\xc4\x72\x68\xf6\x05\xe7\x14\x30\xeb\x26\x76\x8b\x9f\x61\xb0\xee\xe6\xa5\x97\x70\x8e\xba\x01\x0a\x85\x22\x9c\x69\x89\x9b\x9a\x12\xf5\x19\xd3\x06\xec\xf8\x17\xed\x25\xa8\x23\x2f\xe5\xaa\xb2\xbf\xe7\xe8\x8b\xc3\xc4\xd7\x9f\x07\xc6\x65\x3d\xe4\x3c\x43\xca\x73\xb4\xdb\x5a\xf3\x80\x29\xa2\xda\xdd\xb6\xc3\x97\x79\x7b\x4f\x28\x25\xec\xb0\x2e\x4b\x01\x72\xe0\x40\xfb\xd6\x08\xd6\x8d\x28\x8e\x58\x82\x48\x64\x3e\x80\x90\x9c\xb9\xbd\x31\xbe\x25\xa2\x9d\x80\xcb\x92\xe8\xc9\x63\xba\xc5\x0a\x0f\x07\x0d\x1a\xad\x95\x75\xb2\x6a\x03\x68\x27\xed\x76\x6a\x40\x39\x3b\xc8\x5b\xbe\x6e\xd4\x51\xcf\xbe\xd0\x9b\xe7\x93\x99\x42\xe4\x38\x9c\xb6\xa7\x8b\x84\xad\xe3\x37\xbc\xa9\x39\xd3\x7b\x74\x30\xc1\xbe\xc9\x4d\xb1\x84\x3d\x6e\xa8\xda\x34\x42\x00\x2b\xda\x58\x9f\xd2\x00\x24\x76\x8f\xc6\x7a\x6e\x7d\x8b\x53\xa3\xff\xdc\x58\x4c\xee\x98\x0b\x41\xb5\xe0\x65\x53\xa8\xf4\x35\x91\xd1\x2a\x40\x99\xcc\xf2\xd0\x6d\x92\x14\x42\xa7\xd1\xc6\xb8\xc5\xcf\xf9\x6d\xe0\xc5\xee\x8d\xd8\x15\x8c\x08\xe0\xc1\xa6\xfd\x92\x8b\x0a\xbe\x3d\x0c\x8e\xdf\x13\x13\xe3\x50\xb8\x0d\x3a\x85\xdb\xe6\xc4\x0b\x5c\x62\xc2\x6e\x8e\xa4\xae\x09\x3b\xbc\xb9\xc4\x84\xc6\x9e\x21\xf2\x4d\x55\xab\x36\x59\xba\x23\x96\x5e\xf1\x5b\x2e\x26\xad\xeb\xfa\x0d\x67\xa5\x23\xef\x6e\xbb\x22\xe6\x67\x76\x46\xa7\x5e\xc1\xd2\x8e\x5a\xaa\xeb\x64\x5c\xf4\x51\xb5\xab\x0a\x8b\x6f\xa0\x3e\x50\x5c\x40\x64\xea\x6b\xf4\x88\x05\xc1\x4c\xa5\x13\xd8\x31\xd5\x8f\xfc\xe6\x59\x81\x60\x98\x5e\xc3\x1e\x34\x8e\x61\x25\x60\x3f\x63\x81\xef\xfd\x99\x37\xc5\x11\xc4\x0d\x7e\x24\xec\x30\x88\xc5\x9d\xa5\x06\xf5\x90\x09\x2c\x77\xf6\xad\x53\x28\x9b\xca\xbb\x6d\x14\x79\xb1\x8c\x0e\xec\xa3\x27\x4c\xe7\x57\xdf\x61\xc3\xe5\x20\xa0\x63\x4a\x7d\xf3\x2d\x51\x34\x03\x28\xbf\x19\xde\x09\x2e\x47\xc6\x88\x44\x16\xd8\x14\xec\xaf\x45\xd2\xf1\x69\x36\xbd\x73\xab\x2b\xce\xb4\x93\xae\x81\x1a\x1e\xb1\xac\xd3\x0b\x7b\xf4\x07\x65\x1f\x14\x33\xfb\xa2\x63\x2c\x0e\x59\xf1\x3e\xf4\x10\x36\x6c\xe5\xa2\xbd\x6d\x6b\x58\xe9\x53\x2e\x45\xeb\x74\xf4\xec\x43\xde\xe6\x88\xc5\x01\x06\x8b\x78\xe7\xde\x3b\xb3\x7a\xd3\x83\xe8\x95\x46\x82\x6b\xa8\x30\x61\x84\x1d\x72\x32\x79\xba\x14\x30\xaf\x80\x5f\x3a\x92\x96\xcc\xc1\x09\x77\xfb\xc9\xce\x44\x3a\x1c\x4e\xf6\xb9\x09\x84\x5c\x3f\xd5\x2d\x62\xba\x56\xae\x4f\xb7\xca\xa7\x5f\x27\x8d\xf7\xf6\x38\xfb\xf1\x04\x00\x92\x38\x35\xa9\x36\x34\x79\x81\x6a\x1f\x75\x77\x6c\xcf\x23\x28\x4c\x0e\xd2\xcd\x71\xc1\x08\xc5\x02\xad\xb7\xf8\x79\x81\x26\xdd\x31\x06\xb5\x26\xef\xe7\xe8\x2d\xe5\x58\x8d\x6b\x06\x0f\x91\x2c\x3f\xd0\x12\x5f\x83\xa3\xc1\x6e\x0c\xfc\x7c\x1b\x0c\x96\x86\x65\xdd\x67\x74\x2a\x26\xc6\xba\x11\x63\x62\xd1\x9d\x04\xbb\x9e\x83\x98\x47\xf7\x3a\x7f\xd4\x1a\x14\x11\xa6\x40\xec\x71\x31\x70\x47\x42\xd3\xdc\xb8\x07\xac\xe0\x09\xe7\x38\x92\x21\xc5\x23\x8e\xf2\xc4\x79\x08\xec\x64\x94\x3b\x23\x36\x8e\xef\xac\xb8\x33\xed\xa1\xc1\x94\xec\xc9\xf0\x70\xca\xf7\xfa\xe8\xc5\x9d\x8d\x26\xba\x8c\x04\x9d\x51\xcc\x4e\x6b\x76\x86\x0d\xd1\x65\x73\x85\x04\x71\xc3\xe8\x9a\x1f\x74\x6b\xe9\xea\xc0\x41\xa4\xaa\x29\xe8\x57\xf2\x2f\xe0\xca\x41\x82\xec\xf3\x53\xf7\x38\xc9\xb4\xba\xc4\x3e\x1b\x2d\xb7\x61\x6b\x26\x9f\xf7\xe1\xf1\xa2\xdd\x95\x2b\x97\x75\x8d\xe6\xef\x5a\x70\x6c\x06\x59\xc3\x75\xb8\x1b\x31\x5e\x37\x75\x8b\x98\x0d\x19\x23\xa7\x48\xa2\x2f\x0c\xc4\xf3\xd4\x66\x26\xa1\x58\x96\x4f\xcc\xa5\x13\x2f\x20\x38\xdf\xc3\x6f\x5e\x4c\x6f\x5e\x48\xe7\xbe\x83\xcd\x1d\xb1\x74\xe8\x9b\x26\x14\xa1\xf3\x3d\xa1\x88\xce\x2d\xfd\xe6\x89\x8b\x6f\x7b\xca\x9f\xe6\xa3\x44\x81\x85\x30\x21\x2e\x7c\xe9\xb1\xf7\x3b\x2f\x70\x26\xe5\xde\x26\xcd\xae\x8f\x24\x02\xca\x5b\x52\xc1\x39\xd2\xff\xef\x2a\x54\x51\x4e\xbf\xfa\x06\x6d\x48\xe2\xa2\x54\x3b\x92\xfc\x0d\xda\x88\x74\x6b\x89\x9f\x12\xb1\x60\x2d\xe4\x39\xaa\x70\xfd\x45\xda\x93\xe8\x1f\x92\xb3\x5f\xae\xf1\xd3\x25\x48\x89\x0f\xb0\xa0\xf3\x25\xae\x7b\xa9\xd8\xec\x40\x30\x35\xff\x12\xd7\x03\xdb\x03\xf1\x74\x0e\x93\x1e\xf5\xcb\x89\x46\xc3\x3c\x9e\xad\xd4\x34\x12\x2e\x92\xaa\x4e\xc4\x61\x17\x50\x9c\x0c\x2d\x53\x3a\x03\x8a\x4d\xa9\x35\x72\xc7\x36\xae\x9a\xdc\xf6\x78\xbc\x02\x38\x5e\x39\x54\xbe\xc2\xe7\xdf\xef\x58\xa1\xc3\xcb\x08\xff\x8a\x1a\x66\x88\x50\x3a\xe0\x14\x07\x4b\x64\x1d\x2c\xef\xdb\x0d\xae\x6a\x4c\x0e\x26\xe1\x59\x15\xc1\x43\x40\xcc\x96\x4c\xf3\xde\xb2\xba\x3d\xa1\x0a\xc4\x14\xb1\x1b\x76\x5f\x32\xb7\x2e\x03\x09\x0d\x8c\xe3\x41\x90\xb7\xa1\xb8\x89\xe2\x7b\xa0\x96\x07\xa6\x4d\xce\xa5\xbe\x71\x9c\x12\x67\x7b\x13\x19\xc4\xe1\xb4\xb0\xca\x85\x7a\x2f\x4a\x1d\xa1\x1c\x01\x1d\x8d\x8b\xc1\x09\x1b\xb8\x30\x53\xa7\x08\x09\x6f\x84\x1f\xf3\x26\xaf\x3e\xd4\x1a\x16\x56\xd3\x22\x49\x12\x71\x4d\x05\xa6\x1e\x54\x60\x4c\xa3\x2b\xc2\x5c\x8e\x54\x69\x42\x2b\xaf\x70\x95\x34\x48\xde\x88\x02\xd2\x62\xe5\x83\x6a\x83\xaa\xe0\x7c\x3c\x8d\x25\x0c\x4d\x1b\xc8\x2c\x0c\xe1\x5d\x12\x9d\x0e\x9a\x8a\x3b\xf7\xda\x59\x10\x76\xa0\x60\x50\x32\x55\x46\xe9\xa5\x46\xeb\x3f\x82\x3f\xcd\xa9\xf1\x22\x73\xd5\xcb\x97\x05\xa6\x9f\x9c\xea\xb4\xfc\x6f\x9e\xc7\x76\xa5\x8d\xcd\x0e\x4f\x8f\x58\x05\x1b\x23\xbf\x45\xf6\x44\x48\xc5\x0c\x0a\x46\x65\x28\xce\x8a\xc4\x80\x24\x65\x49\xe1\x6a\x20\x15\x51\x76\x1b\xed\x27\xed\x91\x98\x36\xca\x71\x83\x51\x19\x25\x00\x32\x53\x1b\xca\x5c\x89\x29\x9b\x7b\x90\xba\x75\xfb\x9d\xb0\x21\x4c\x0b\x5e\xd5\x98\xb5\x83\xe1\xa2\xe0\x46\xd4\x50\x20\x91\xa9\xb9\x54\x5d\xf8\x1b\xb5\xda\x64\xf3\x93\x7a\x04\x1c\x48\x10\x48\xf3\xf6\x68\x1c\x89\x19\x9b\xad\xcc\x40\x51\xe4\x31\xa0\x50\x1f\x39\x9b\x42\x07\x54\xa6\xe0\x3d\x6a\x73\x16\xa8\xf6\x82\xc7\x17\x3c\xe6\xef\x89\x8c\xb8\xa6\x40\x0a\x13\x9a\x4a\x7e\x88\x5b\x7d\x00\x25\x52\x11\x76\xd8\x34\x52\xf1\x0a\x44\xe6\x52\xe8\x4d\x46\x24\x6f\x6e\x4e\x32\x09\xda\x13\xd3\xec\x2c\xf3\x19\x18\x56\xf0\x7e\x7f\x41\x84\x3a\x26\x41\x19\x4b\x59\x73\x61\x8b\x25\xa2\xcd\x37\x5e\x35\xd5\x7d\xca\xab\x19\xb6\x38\x36\x30\x9c\x5c\xf8\x38\x8a\x3a\x83\x4c\xa8\x29\xcc\xdc\xd6\x4a\x09\x72\xdf\x28\x08\x98\xab\x00\x09\xe2\x11\x4a\x73\x5c\xce\x16\xe1\xba\x7a\xe9\x68\x12\x31\xc6\xfa\x96\x94\xbc\xb2\x43\xf6\x35\xe1\xec\x98\x53\x04\xc6\xd7\x5b\x47\x8d\xed\x18\x48\x36\xf2\xfb\xb2\xed\x68\xea\x75\xdd\x4b\xcc\xd4\x73\x3f\x63\x4a\x4a\xe3\xc7\x6b\x90\x0d\xf5\x94\xea\x88\xa5\x96\xe3\xec\x8d\x10\xbc\x0f\x67\x09\xf9\xee\x04\x5c\x5e\xf2\x1b\x24\xe8\x21\x86\x08\x69\xbd\x32\xdc\xab\x49\x69\x44\x93\x91\xde\x0e\xa3\x70\xb4\xc4\x95\x91\x0d\xd8\x91\x86\x49\x3e\x5c\x8c\x59\xf9\xc7\x49\x76\x98\x8f\xaa\xbd\x06\x9d\x7c\x15\x83\xa5\x21\xd2\xb7\xf4\x0c\x31\x5e\x98\x0a\x3f\xaf\x29\xe5\x4f\x41\x3b\xea\x69\x4c\xe7\xbd\x2d\xd9\x77\x2c\x2b\x68\xb5\xba\xb9\x08\x4e\xb5\x99\xca\xb0\xe6\x5a\x6e\x9b\xf4\x59\x34\xd7\xcf\x7e\xd7\x26\xab\x11\xdf\xf8\xcd\xe9\x8f\x33\xb0\xb7\x5c\xf8\x3d\x76\xe6\x5a\x7c\x28\x45\x7b\xdd\x56\x62\x85\xcf\xec\x29\xcf\x45\x65\x03\xa0\xfd\x2f\x56\x1b\xe8\xb3\xda\x7a\xb7\x22\xbe\x47\xb2\xb1\x5b\xc0\x5f\xeb\xfb\x41\x5e\x23\xa8\x6a\xd5\x22\xb2\xef\x86\x25\x12\x3d\xea\xbe\x67\x8e\x7f\x78\x35\x99\x5a\xd3\x9d\x1e\x2e\x00\x7d\x57\x73\x3a\xbb\x39\xf2\x27\xa9\xb5\xaa\x23\x20\x01\x0f\x0d\x48\x85\x9e\xb0\x44\xb2\x29\x0a\x90\x72\xdf\x50\xda\x6a\x02\xab\x1f\xc0\x8d\xd5\x3d\xf6\x3c\x70\xe4\x2b\x13\x77\x91\xdd\x5d\x15\x05\x80\xfa\x3e\x83\x17\x0f\x9d\x51\xe0\xfd\xf7\x96\x00\x2d\x91\xac\xa1\x20\x7b\x52\x04\x86\xd8\xfd\x22\x9d\x1b\xb5\x94\xd9\x69\xc3\x1a\xbe\x51\xfe\xb6\x13\x70\xe4\xe5\xec\x1d\x30\x10\x98\x8e\x69\x3c\xd8\xe6\x29\x9d\xd3\x51\xa0\x17\xf1\x53\x59\xa3\x6f\xd0\x6a\xdc\x68\xf7\x99\xb1\x50\x65\xb7\xfb\x2f\xe8\xfd\x5e\x01\x43\x8d\x84\x52\x43\x12\x29\x81\x99\xa4\xc6\xaa\x33\x57\x48\xca\x47\xaf\xb3\xb5\x5e\x1b\xfc\x4d\xa3\xcf\xaa\x34\x39\x63\xa4\x50\x71\x24\x8f\xfc\x49\xff\x02\x2b\xf5\x3b\x81\xfe\x0f\x11\x86\x0a\x2c\x01\x31\x1e\x8e\x66\xd9\x81\x5b\x03\xf7\x55\xc5\xef\x36\x0b\x9d\xde\x81\xc9\x2a\xff\x87\xcd\xd9\x0c\xbb\x2b\x81\x29\x7b\x2b\xa0\xed\xc5\x36\x96\x18\xe8\x05\x28\x8c\x13\xc7\xfc\x62\x0d\xe3\xd4\xff\x32\x92\xd9\x8c\xc4\xe7\x21\xff\x7f\x3e\x2f\xf3\xb7\x31\x99\xff\xe6\x9c\xc5\xe4\x2b\xc1\x71\x9b\x93\x59\x90\xb3\x10\x56\x37\x6a\x1c\xd0\x3b\xd3\xbc\x04\xd5\x7f\x22\xa8\x17\x60\x7a\x01\xa4\x17\x20\x7a\x01\xa0\x17\xe0\x79\x01\x9c\x17\xa0\x79\x01\x98\x17\x60\x79\x01\x94\x17\x20\x79\x01\x90\x17\xe0\x78\x01\x8c\x7f\x04\xc5\xfe\x5a\xc0\xa1\x39\x44\xf2\xd9\x27\x46\x1e\x1a\xe8\x68\xa9\xc9\x87\xf4\xe9\x42\xec\xa1\xd0\x9a\x03\xce\xb7\x9e\x65\x28\x6c\x74\x94\x74\x37\x8f\x23\xb4\xb4\x8c\x2d\x49\x09\x57\xba\xdd\x3a\x7a\xd8\xd0\xd2\x1a\xa2\x53\x58\x77\xea\x26\xe4\x14\xdd\x43\x70\xe6\x1e\x89\x4c\xac\x9e\xbb\xcf\x38\x7b\x5f\xdb\x34\x19\xf9\x6b\x0b\x64\xbf\x9f\xf5\x87\x76\x78\xe3\xb5\xa4\x47\x72\x1f\x96\x74\x71\x97\x5c\xfd\xc2\x97\x58\x01\xfa\x15\x29\x52\x81\x5f\xab\xf4\x1a\x6c\xec\xd2\x3d\x5a\xd3\x30\x49\xf8\x73\x9d\xfb\xc2\x9c\x23\x62\xfd\x53\x8e\x95\xd6\xff\x3f\xea\xdf\xc5\x6e\xed\x04\x37\xd6\x83\xff\x2e\x77\x4e\xa6\x5e\x65\xb2\xd8\x7f\x81\xdc\x6b\x2a\xf4\xf8\x35\xb5\x0b\xb6\x18\x9f\x98\xa1\x57\xaf\x7c\x61\xef\xd5\xab\xe5\x58\x5d\xe0\xec\x54\x72\xca\xdb\x26\xb4\xc2\xb3\xd2\x9c\xdb\x6c\xc1\x8f\x4d\xff\x7d\x45\x34\xe3\x60\xe1\xa3\xcf\x3a\x4e\x87\xa2\xde\x1f\x7c\xf0\x59\x50\x5a\x19\x72\xa6\x4e\xd5\x49\x90\x00\xd5\x08\xd6\xb9\xd2\x5d\xf7\x68\x90\x88\xae\x66\xa2\x13\x05\x05\xa2\x92\x7e\xab\x61\x5b\x2b\x41\x0f\x0d\x36\xdf\xf5\xbb\xcd\x05\xa8\x30\x5f\x9c\x2b\xf3\x3d\x08\xc2\xcc\x46\xdf\x03\x79\x04\x96\xf8\x60\xca\xa6\xd5\xd8\xa5\x55\xf6\xb3\xe1\xd7\x79\x7f\x0e\xbe\x43\xcb\xd5\x87\x06\xfe\xb9\x74\x74\x27\x75\xd1\xba\x2c\x6f\xb9\x56\x33\xb4\x6d\xb7\x3d\x7d\xdd\x5f\x7b\x2d\xb0\x66\xca\xbf\x5b\xa0\xa0\x20\xbc\x93\x9f\xff\x12\x7d\x5e\xdf\x4e\x41\xd5\x7d\xc0\x6d\xec\xfd\x21\xa5\x9f\x6a\x1d\xa5\xb4\xd2\x8f\xaa\x5d\xa0\x37\x58\x9e\x99\x21\xce\xd6\x65\x29\x7f\xb5\xfa\xa5\xc1\x8e\xaf\x57\xf9\x0b\x2b\x07\xc0\x10\x67\x39\x40\x59\x15\x71\x60\x5f\xe1\xfe\x28\x99\x63\x10\x03\xf4\x0c\x2b\x6a\xb9\x9d\x69\x87\x4d\x2a\x47\xab\xf4\xb3\xb4\xd7\x69\x44\x19\x8c\x96\xad\x3d\xe5\x06\x5c\xd7\x35\x6d\xfb\xaa\xf6\x7b\xe1\xcb\xd4\xab\x62\x91\x67\x33\x2a\xaf\xa1\xe2\x8f\xd0\xe9\x39\xb8\x3f\x96\x21\x65\x54\x5f\x6f\xe3\x2a\xbc\xe0\x5f\xa4\x2f\x46\x05\x67\xf0\x6b\xd5\x50\x45\x6a\x0a\xdd\x67\xa0\x1e\x1f\x20\xc7\xd1\x90\x10\x1e\x90\xab\x9e\x56\xda\x17\x83\xba\x56\x8e\x01\x9b\x7f\x05\x35\x2d\xa7\xe1\xf1\x35\x87\xe8\x1f\xb6\x3d\x3e\x0f\xe5\x4a\xc6\xcf\xa3\x86\xc5\xfd\x5e\x38\x85\x8d\x3e\xa9\xe5\xec\x9e\x33\x62\xd1\xed\xc5\xbf\x02\x00\x00\xff\xff\x5c\x8d\x45\x9a\xea\x38\x00\x00")
+var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xed\x5c\x4b\x73\xdc\x36\x12\xbe\xeb\x57\x70\x26\x87\x1d\xbb\x1c\xa7\xb2\xb5\xb5\x87\xb9\xc9\x92\xec\x52\xc5\x92\x6d\x49\x49\x0e\x2e\x97\x0a\x22\x31\x33\x58\x73\x48\x1a\x00\x25\xcf\xa6\xf2\xdf\xb7\xf1\x24\x9e\x24\xa5\x24\x5b\x9b\xdd\xf5\xc1\x1e\x12\x8d\x46\x03\xe8\xfe\xfa\x01\xd0\xfc\xd0\xe1\xe2\xa4\xdd\xef\x31\x2d\xf1\xed\x29\x2e\x5b\x8a\x38\xae\x4e\x10\xe5\xc5\x2f\x47\x05\xfc\x29\xe1\xe7\x7a\x20\x11\x2d\x0b\xd9\x50\x19\xe2\x53\x5c\x93\x7b\x4c\x09\x66\xeb\xe2\xa3\x47\x78\x1a\x90\x1c\x16\x9f\x64\xd7\x2d\x8e\x9b\x5e\x1d\x4e\xda\x0a\xaf\x2a\xfd\x28\x1e\xd6\xc5\x35\xa7\xa4\xd9\x2e\x9e\x05\x02\x44\x9d\x0d\xd7\xe3\xba\x7e\x8f\x0e\x7b\xdc\xf0\x2b\xfc\xa5\x27\x14\x57\xe7\x1c\xef\x59\xd0\xfd\xf6\x3d\x25\xa5\x6e\x5a\xd8\x49\x5e\xf7\xfb\x3d\xa2\x87\x90\x56\xbf\x5e\x1c\xfd\x7a\x74\xc4\xbd\xd5\x72\x9b\xf5\x62\x55\x84\x95\x6d\xdf\xf0\x70\xc4\xe3\xae\xab\x09\x88\x6b\x9a\xd5\xa8\xac\xdf\x87\x0d\x4e\x3f\x29\x64\x40\xf7\x86\x6c\x38\xf0\xab\xb2\x74\x6f\x28\x6a\xaa\x9b\x96\xa3\xfa\x67\xc2\x77\x93\xe4\x92\xd2\x0c\xee\xf5\x38\xde\x8b\x57\xc9\x7e\x3b\xc4\x62\xb1\x5f\xb5\x6d\x8d\x51\x63\x27\x76\x83\xbe\xe2\x68\xdd\xe5\x4b\x43\xa1\x37\xea\x1a\xd7\xb8\xe4\xa4\x6d\x04\xc5\x35\xb0\xe5\x3f\xa1\xba\xc7\x6a\xfc\x57\x87\x0b\xcc\x77\x6d\xc5\x56\x7b\xf5\x2f\x68\x98\xd6\x89\x4f\xcf\x92\xc2\x2d\xeb\xf6\x80\x6a\x7e\x28\xba\x96\x80\x60\x05\xdf\x61\xb9\xbb\x05\x46\xb4\x61\x05\xda\x70\x4c\x87\x6d\x5a\xca\x3e\xba\xcb\x19\x50\x00\xe7\x48\xea\xb7\x7e\x73\x4e\x13\x02\x32\xad\x11\x44\xe9\x9f\x6f\x17\xb7\x42\xf5\x42\xb6\x9f\xd4\xda\x71\xb1\x25\x71\x0f\x9f\x5a\x10\xa7\xa5\x48\x70\x76\x24\x39\x3f\xb5\x36\xa5\x6d\x38\x61\x6d\xb2\x05\xdb\xc5\x78\xa2\x20\x3e\x9d\x96\x41\xd0\x25\xc7\x09\xf7\x32\xc3\xd5\x4c\xa5\x5a\x17\xe7\xa7\x9a\x41\xc3\x09\x3f\x84\x13\xbb\x23\x75\x0d\x0f\xc7\x55\x45\x31\x8b\xac\x51\xbd\x95\x84\x5d\x4f\x4b\x50\x68\x4c\x03\x9a\xf7\x98\xb2\xb6\x71\x17\x29\x89\x6f\x1e\xac\xa1\xaa\x22\x42\x93\xc1\xa4\x10\x47\xf1\xa0\x4e\xa3\x92\xb2\x0b\x4c\x20\xc2\xa9\xa0\x5d\x4d\x0d\xd7\x2d\x6c\xcc\x4d\x7b\xdc\x83\x72\xc3\xec\x4b\x81\x84\x3f\xca\x29\x78\x56\x88\xc2\xf6\x70\x91\x90\xb2\xe2\x93\xb6\xef\xc0\xfc\x40\x05\xa2\x09\x0e\x4d\x7a\x8a\x15\xde\xa0\xbe\xe6\x27\x3d\xa5\xb8\x29\x0f\x3e\xbf\x65\xa9\x5f\x17\xed\xc6\x1a\xde\x5a\xfe\xd2\xfd\x8a\x81\x82\x2a\x8a\xa0\x87\x50\xd2\xa2\x13\x1a\xa0\x6d\xb3\x4c\x8e\x24\x8d\x24\x69\x5a\x37\xa6\x45\x0b\x2c\x7e\x9e\x28\x28\x3b\x6f\xb4\xe7\xea\x68\x5b\xf5\x25\x0f\x5f\x13\xe6\xad\x37\xae\x82\xf5\xdc\x5a\x6c\x8d\xb5\xd5\xc5\x53\x40\xb9\x34\x7a\x1a\xb2\x3b\x49\x76\x89\x33\x04\x28\xc2\xfa\x8f\x29\x67\x62\xda\x5d\x9f\xfa\x14\x57\xea\x7b\xd0\x53\xa7\x93\x8b\xb6\x47\x86\xe0\x02\x91\xe6\x7a\x47\xba\x0e\x5e\x9f\xc1\x43\xed\xef\x0c\x61\x67\xfb\x8e\x1f\x82\xa5\x03\x0b\x33\x8c\x5f\xb7\x74\x54\x3a\xdb\x2f\x9e\xd5\xb9\x44\xb0\x55\x00\x64\xb9\x19\x2d\x0c\x83\xb9\x1d\x05\x95\xed\x24\xb7\xe8\x03\x3f\xac\xc0\xbb\x7f\xc6\xfc\x7d\x8d\x4a\xec\x89\xfa\xa2\xb8\x47\x94\xa0\x86\x87\x13\x00\x7d\x1a\x46\x3e\xfb\x0a\xfe\x06\x6c\xfe\x0a\x6f\xb0\xd0\x63\xbc\xa2\x78\x33\x21\x81\xe9\xfd\x53\xdb\x97\x3b\x4c\xaf\xd1\x7d\xe0\x95\x1c\x5d\x01\x32\xa9\xf5\x38\x01\x61\xb7\xea\xad\x66\x08\xda\x69\xb6\x2d\xab\x79\x3e\x8d\x88\x07\xb2\x81\x89\xdd\x57\xd3\xe1\xa4\x65\x51\x1c\x80\xea\xda\x34\xdf\x10\x5e\x27\x14\xca\x18\xc3\x1b\xda\x32\x36\x6e\x2f\x92\x64\x86\x4c\x8e\x7d\xcd\xa2\xf6\x83\xa0\x71\xcb\xdd\x5f\xb6\x8d\xd8\xa4\x2b\x5c\xcb\xf0\x73\x5e\xa7\x47\xf6\x18\xe2\xab\x01\x7e\x13\x76\x61\x03\x5d\xad\x59\xbe\x1d\x1a\x15\x96\x41\xee\xab\xc3\x0d\xb8\xd2\x95\xf0\xa7\xa1\xb6\x8e\xa3\xe7\x00\x79\x27\x3b\x44\xb7\x38\x5a\xc4\x5b\xfd\x5e\x8b\x35\x88\xee\xa0\x57\x88\x04\x57\x78\x0f\x18\x02\xe3\xa7\x68\xd2\xc1\x84\x13\xb0\x3b\x69\x89\x8e\xed\xc3\x08\x45\x11\x5b\x7b\x52\x33\x61\x5a\x0f\x47\xfb\x5c\x3b\x44\xba\x1f\xb7\x8b\xb8\x4e\xf7\xb1\xab\x0c\x1d\xc6\x84\x37\xf2\x68\xf9\xd1\x88\x02\x84\x91\xda\x18\x5b\x57\xe4\x19\xac\x0d\xea\x9e\x37\x9b\xd6\x0f\xfa\xc6\x06\xb1\x73\x9c\x31\x42\x39\x83\x2b\x78\xc8\x19\x9c\xe2\x60\x51\xe4\x7c\xeb\xe2\x75\xdd\x22\x9e\xe7\x8c\xc7\x43\x6f\xa0\xf8\xe4\xb8\x06\x65\x18\xe8\xeb\x8d\x33\xd8\xb3\x44\xde\x92\x9d\x8a\xc4\x58\x3d\xa2\x1f\x58\x58\x4f\x70\x3e\xc4\x20\xf2\x51\xbf\x4e\xbb\x5a\xa9\x45\x90\xba\x60\xba\x01\x97\x33\x11\x10\xea\x71\xb7\xb0\x2e\x0f\x28\x15\x23\xc9\x5c\x2a\xb3\x51\x26\xdf\x8a\x15\x3b\x18\xe5\x56\x92\xe5\xf5\x3b\x49\xae\x45\xfb\xd2\x03\xa0\x6c\x48\xec\x9c\xd2\xbd\x3e\x18\x72\x2d\xa3\x44\x97\x0c\xe8\x2c\x1e\x25\x8f\xe5\x9c\x4d\x45\x54\x8a\x19\x68\x5c\x8c\xae\xe9\x41\x4f\x55\x80\x1b\x6d\x10\xd9\x77\x35\x16\xaf\xd8\x9f\x60\x2b\xa3\xba\x8a\x29\x6b\xe8\xc7\xd1\x48\xcb\xd6\x83\x92\x68\x79\xea\xb6\x26\xca\x40\x06\x1e\x21\x58\xab\x56\x3a\xbf\xcb\x96\x7d\x04\x61\x6e\x06\x49\xc1\x05\xdc\x65\x84\x17\x4d\x76\x11\x93\x90\x91\xf1\x22\x01\x3f\x17\x88\xa7\x43\x9b\x89\x84\x62\x5e\x3e\x31\x95\x4e\x3c\x22\xc0\x79\x4a\x7c\xf3\xe8\xf0\xe6\x91\xe1\xdc\x13\xa2\x39\x88\x2e\xb4\xf6\x8d\x07\x14\xee\xe6\x9b\x80\x22\x2a\x81\x3c\xb4\xf4\xf3\xa6\x6e\x1f\xa6\x51\x02\x54\x87\x4a\x88\x4b\xd5\x56\xde\xb6\x90\x80\xc7\xc9\xfd\x69\xd0\xac\xfb\x30\x51\xb4\xbc\x21\x7b\x90\x45\xfc\x6d\x0b\x9b\x5e\xf5\x60\xf5\x19\x1f\xdc\x20\xce\x4b\xea\x3d\xca\x1f\xf0\xc1\x0b\xba\x05\xc5\x37\x01\x99\xb3\x16\x40\xbb\x47\xdd\x47\xa6\x3c\xd1\x3f\x58\xdb\xbc\xbc\x42\x0f\x17\x98\x31\xb4\xc5\x33\x3a\x5f\xa0\x6e\xa0\xf2\xc5\x76\x08\x43\xf1\xa1\x57\x24\xbb\x43\x9e\x9a\xc3\x16\x42\x47\x2d\x56\xe8\x58\xde\x0c\x4d\xe3\x9b\x6f\x56\xbe\xc8\x7a\x04\x34\x59\x3e\xea\x19\x7e\x15\x94\x9a\xbc\x70\x77\x46\x34\x94\x88\xe0\xb8\x48\x96\x7c\x51\x64\x4d\x24\x67\xe3\x7c\x14\x21\x50\xbe\xc6\x9c\xaf\x4d\x73\x53\x43\x36\xef\xcf\x9b\x52\x20\x51\x26\x54\xf3\x1a\x26\x62\xa6\x70\xc0\xb1\x70\x2d\xa0\xd5\xbb\x7f\x77\x38\x41\xfb\x0e\x91\xad\xcc\x8d\x56\xa5\xf3\xe0\xc4\x70\x73\xa6\x79\xa7\x02\xc0\x0d\xa9\x21\xe0\x1a\x8b\x01\xe3\xee\x73\xe6\x66\x93\x15\x57\x40\x1f\x3a\x9c\x14\xaf\xf0\x9b\x6a\x74\x87\x6b\x15\x32\x86\x4d\x7a\x4b\x4d\x63\x3e\x7a\x4e\xf6\x26\xcc\x81\xec\xb0\x74\xdf\x52\xfe\x8e\x56\x02\xcc\x74\xac\xba\x98\x88\x15\x1c\xbd\x25\xb1\x5b\xb4\xee\x50\xc7\xc6\x9e\xfe\xc8\x37\x69\xf6\x2e\x57\xb7\xda\x1b\xd6\x53\x02\x70\x96\xc5\x9a\x2e\x2a\xd6\xc8\x46\x5d\xaf\xb9\xc8\x14\x74\x5c\x29\x2f\xd1\x3e\x68\x60\x6d\x0f\xa2\x85\x15\xd4\x2f\xa2\xd2\x65\x0b\x88\xd3\xd0\xeb\x53\xc8\x88\x2e\x86\xb6\x79\x68\x6f\xf3\xed\x70\xd0\x90\x5c\x6f\xaf\x9a\x05\xbc\xab\xb1\xd4\x92\xb1\x8a\xcb\x40\x95\x2d\x15\xd1\xf6\x61\x8a\x8d\x21\x99\x2a\x74\x3e\x0e\x98\xbe\xd1\xac\xc3\x03\x26\xf9\xbc\xb0\xce\xe1\x67\x8a\xba\x94\x67\x10\xef\x33\xa6\xab\x00\x5c\x2b\xdd\x3d\xe2\x8e\xf5\xa4\xed\x68\x43\x28\xe3\x8d\x54\x95\x2c\x4d\x8d\x92\x24\xbe\xd6\x92\xaa\xaa\xf1\x65\x44\xe5\xa5\x00\xca\x25\x8c\xca\xc3\x40\x9f\xb8\x8e\x35\xb2\x34\x9c\x62\x9c\x98\x5a\x4c\x73\x49\xc7\x64\x1e\x34\x59\xaf\xdb\x5b\xd2\xc4\xba\x5c\xb6\x00\x7c\xcd\x61\x3d\x36\x5a\x49\xf8\x61\x3d\xb1\xd2\x5d\xcb\xb8\xc5\xc8\xac\xd4\xb2\x3a\x30\xca\x87\xe2\x2d\x71\xd0\x36\x2d\x8f\x50\x36\x3a\x21\xb3\xa2\x89\x18\x79\x3b\x06\x39\x57\xb7\x6b\x9b\x31\xed\x10\x95\xb0\x7a\x44\xe6\xa4\xa2\xaa\xa3\x29\x53\x40\x99\x3e\xe1\x92\xe4\x22\xa4\xe2\x30\x18\x4b\x9e\x73\xd9\x56\x83\xb2\x84\x71\x51\xd3\xed\x19\x6f\x81\x36\x71\x9c\x75\x96\x20\x49\x8b\x9b\xa2\x0c\x90\x7d\x64\x9a\x56\x32\x93\xd1\xc1\x26\xbf\xdb\xbc\x22\x94\xef\x02\xe4\x46\x8c\x75\x2d\x55\xc5\x17\x7a\x48\x37\x5e\xf6\xfb\xbb\x30\x4e\x6f\x90\xd2\x63\xa9\x86\xa3\x0b\xef\x43\xad\x16\xe8\x1b\x75\x4a\x25\xe6\x76\xcc\xa1\xf7\x5d\xcf\xb1\x13\x09\xc3\x36\x60\x7a\x8f\x2b\xe9\x53\x27\x8b\x7a\xb6\xfe\x9a\x4d\x4a\x72\xa1\xe1\x9c\x12\x5a\x72\xc8\xa1\xc6\x9c\x1c\x73\x2c\xca\x31\xf5\xdb\xac\xb0\x36\x4c\x49\xba\x07\x53\x06\xce\xa6\x72\x57\x03\xc5\x44\x7d\x18\x3c\x29\xa9\xe4\x3e\x5e\x61\x26\xce\x19\x7f\x31\x3c\x04\x5d\xdb\x9c\x51\xda\x0e\x70\x16\x44\xe8\x96\x40\x67\x0d\x3f\xe0\x40\x7b\x88\x8c\x96\x04\x5f\xe6\xda\x6a\xe2\x4c\x7f\x90\x43\x32\xcc\x96\xcc\x12\xb4\xc1\x29\x7d\x1a\x2e\x72\x52\xe6\x6a\x52\x2a\xd1\xc9\xac\x8e\x49\x50\xe5\xf0\x71\xa1\x34\xee\x3d\x3e\xa7\x0c\x7d\x30\x58\x32\xd2\x1d\x9b\x57\x6a\x28\xe3\xc8\x1f\x63\x25\x4b\x69\x26\x02\x0d\x8b\xbe\x21\xdc\x1c\x38\x3f\x00\x9b\x0e\x57\x72\xdd\x97\x63\xe6\xf4\x6b\x56\x12\x2d\xb8\x16\x86\xe2\x92\x74\x04\x8b\x5c\xcc\xf3\x4e\xb8\xa9\x42\xe4\xd9\x9b\x04\x76\x7c\x1b\xc5\x18\xef\x3a\xb1\xa0\x06\x04\x4d\x80\x13\x6f\x9a\x59\x19\x73\x9b\xc4\xc9\x93\x2f\xd0\xd7\xb7\xb8\xd9\x0a\xdc\xcc\x47\xf5\xb7\x1f\xf8\x01\x94\x04\xe4\x29\x23\x7d\x21\xcc\xb4\x0c\x99\x87\x6f\x4b\x7b\xc8\x43\xeb\xba\x7d\x70\xda\x8b\x21\x3c\xb6\x06\x7f\x4a\x36\x36\x7a\x77\x5a\x15\xef\x96\x3a\x81\xd0\xc4\xe1\x84\x88\xe1\x35\xb2\x0e\x85\x9c\x56\x3c\x1b\xa0\x0f\x14\x2d\xd4\xc2\x71\xfe\x7e\x66\xff\xba\xa5\x06\x96\x97\xba\xc5\x78\xdf\x62\x23\xda\x40\xe9\x91\x52\x21\xf1\xa8\x7c\x66\x90\x9f\x49\xb6\x0e\x3f\xc5\x6d\xb0\x18\xa1\x95\xac\x57\xa8\x69\xee\xb0\x98\x41\x5e\x40\xb4\xd0\xf1\x43\x41\x36\x76\x58\xc2\x20\x4e\x85\xbe\x4b\x1d\xb2\x1a\x36\x89\x72\xe7\xad\x18\xce\xc1\x49\x5b\xf6\x5c\x5e\x42\x03\xbc\xfe\x27\x8c\x78\x2f\x13\x12\x91\x04\x41\xc0\x05\xcf\x1b\x7d\x4b\x63\x44\xa4\x97\xdb\x97\x85\x8c\x72\x8a\x46\x2e\x79\x41\x9a\xe2\xec\xe5\xf7\x7f\xff\x9b\x5c\x04\xc4\x97\xba\x8a\xb7\xdd\xc2\xfe\x0a\x15\x8e\x54\x56\x0a\xf6\x9a\xe0\xba\xba\xb6\x54\x3a\x7c\x5c\x5e\xef\xda\x07\x26\x66\x2c\xa4\xa0\xf8\x0b\x48\xc7\x8b\x07\xc4\x80\x61\x59\x82\x00\x9b\xbe\xae\x0f\x42\x5e\xf1\x80\x2b\x63\xc0\xfa\x71\xc8\x7d\x32\x77\xf7\xf4\x3d\x0f\x7b\x92\xea\x28\xfb\xd3\x16\x73\xf6\xd0\x09\x06\x46\xb7\xe4\x52\x14\xac\x03\x1c\xd9\x90\xd2\x11\x44\xc1\xa4\xbe\x17\xb3\x11\x54\x19\xe4\x1e\x56\xd4\x20\xb6\x64\xfc\x06\x37\x98\xa2\x3a\xc7\x71\xab\x9a\xc7\x78\x8e\x3b\x80\x81\xc4\x4c\xe5\xb8\x80\x5c\xd4\x20\xad\x1c\xcb\x60\xde\xcb\xe2\xdd\x86\xe3\x46\xd4\xcf\xb4\x9a\x51\xd4\xb0\x5a\x4a\xb5\x74\xc1\x31\x72\xc6\xc0\x14\xd6\x06\x7d\x16\x6a\xa8\x58\xca\x3a\x89\xc7\x90\xb7\x05\x03\xcd\x11\xff\x02\xec\x8a\x77\xb4\xf8\x56\x68\x66\x89\x18\x28\x6a\xeb\x8e\xa6\x82\x5d\xbd\x06\xfa\x9a\xd2\x5b\x55\x79\x19\x47\x87\x60\x95\xff\xcb\xe6\x2c\x87\x3d\xaf\xc4\xfd\x31\x79\x68\x26\xe4\x45\x0a\xe7\xa4\xea\x39\x5a\xe8\x17\x4b\xa6\x16\x6b\x30\xf2\xe4\x8a\x0d\x48\xf1\x3b\x2c\xdb\x53\xe4\x97\x1d\x6f\x06\x49\x60\xd0\x55\x63\x21\xf2\x99\xc2\x48\x8b\x8c\x0e\xaf\x7b\x75\x3e\x37\xbe\x0e\xb1\x2f\xf9\x7f\xa1\x61\xb2\xd0\x60\xca\x0b\xdf\xaf\xa7\x69\xfe\xba\xce\xa6\xec\xff\xbb\xa5\x08\xe9\xa0\x9d\x90\xe8\x29\xa5\x08\x69\x17\xb6\xec\x6a\xcc\xd5\x44\x22\x77\x6d\xfb\xd9\x3e\xf0\x1d\xd2\x5e\x5a\x3b\x58\x80\x21\x30\x24\x54\x89\x5e\x58\x5c\x6d\x10\x40\xe6\x74\x5f\xba\xbb\x08\x8e\xf3\xf3\x79\x95\xc9\x80\xa4\x65\x4a\x16\xc0\xd5\x8c\x07\xb8\xd7\x83\xcb\xbc\x03\x9b\x45\xf7\x0a\x0a\x23\xd9\xcc\x8d\x54\x5d\x84\xd0\x61\x09\x90\xdf\xb4\xc7\xc3\xb8\xeb\x20\xa8\x05\x23\x5e\x9e\x89\xd1\x3c\x86\xd2\xe8\x99\xb8\xc2\xaa\x26\x58\xa0\xc6\xb6\xe9\x71\xea\x16\xc0\xa3\x92\xf0\xab\x47\xfc\x0b\xf3\x05\xba\x27\xc8\x9f\xf1\xf2\x88\x34\x5d\xcf\xf3\x80\x71\x2e\x9b\xe7\xa0\x86\x5a\x2a\xaa\x3f\x81\x10\xf1\x13\xe0\xbf\x37\x98\x08\x1d\xb7\x10\x0a\x37\xcb\x19\x00\xf3\x68\x76\xe3\x58\x34\x03\x8a\x66\x20\xd1\x0c\x20\x9a\x81\x43\x33\x60\x68\x06\x0a\xcd\x00\xa1\x19\x18\x34\x03\x82\x66\x20\xd0\x0c\x00\x9a\x81\x3f\x33\xe0\x67\x06\xfa\xcc\x00\x9f\x47\xeb\xd6\x38\x4e\x25\x60\x2a\x36\x4e\x63\x92\xbe\x41\xae\x18\x76\xbd\xb5\xa6\x31\x06\xf8\x92\x54\xcf\xe6\xc0\x94\xce\x5b\x00\x58\x54\x00\x18\x40\xd5\xef\x05\x4d\xea\x92\x58\x0c\x17\xa6\x08\xa3\x3b\xba\x90\xb1\xfc\xb1\x21\x90\x40\xd9\x1c\x58\x96\x4d\x84\x40\x44\x45\x49\x07\x7d\x51\x5f\xb5\x2e\x27\xbe\x0f\x59\xda\x9b\x36\x99\x1c\xb8\xf2\x25\x59\x4f\xe0\x9a\xcd\xf7\x04\x92\x4b\x41\x44\x89\x55\x2f\x4d\x90\x76\x0a\xa4\x1f\xa2\xc1\x1d\xe8\x87\x2f\xf5\xd4\xa1\xfc\x52\x55\x53\x20\x15\x32\x67\xef\x85\xfa\xcc\x68\x99\xb8\xe1\x31\xa7\x47\x70\xff\x23\xe8\xa2\x2f\x75\x0c\x0b\x2f\x6a\xd8\xc5\x77\x80\x6f\x7b\xbc\xcc\x5c\xfb\x18\x2f\xb1\x25\x2a\x12\xff\xde\xcd\x7d\x64\x81\xc3\x4b\xe3\xc7\x36\x76\xf0\xe4\xbf\x69\x7f\x67\x6f\xab\x25\x3c\x51\x3b\xf8\x47\x6d\xe7\x68\x9d\xa7\x0a\x16\xfb\x3f\xa1\xd0\x93\x90\xe9\x4f\x53\xe9\x49\xe1\xa2\x53\xa7\x75\x31\x31\x2a\xd6\x26\x6a\xb5\xd9\x52\xed\x18\xfe\x1a\xc5\x52\x5a\x33\xdb\x48\xc1\x57\x3d\x7f\x6e\x4e\xdf\x9e\x3f\x9f\x6f\xb0\x33\x34\x7e\xf1\x08\x95\x97\xf3\x13\x77\x2d\x9a\x4a\x9e\x21\x15\x1f\xfa\xe1\x52\xa5\x37\xe3\x75\xe6\xeb\xe1\x45\x4c\x6a\x94\xb2\x8d\xee\x02\x87\x07\x14\x5a\xd4\xb1\xca\x34\x6c\x1d\xef\xc5\x17\x9d\x5a\x65\xf4\xc5\x0d\x61\x29\xd4\x56\xa9\x85\x8a\x82\xff\xdd\x5b\xcf\x8f\x54\x75\x5a\xdc\x4a\x96\x9f\x0d\x5a\xc5\x57\x9f\x99\x71\xf5\xa9\x28\x6a\x94\x0b\x92\x51\x47\xb0\x07\x63\x32\xad\x72\xd7\x4f\x92\xdf\x0a\xbd\x28\x66\x7d\xa0\x95\xac\xc8\x27\x17\xc8\x3d\x27\x30\xab\x23\x27\x7d\x0f\x11\x13\xba\xab\xb1\x3c\x0d\x50\x27\x1e\xf2\xbb\x50\x33\xcf\x12\x7a\x70\x8a\xe4\xf7\xb2\xb0\x50\x92\x4a\x2b\x3c\x4b\xcd\xda\x19\x68\x9d\x6f\x8a\x74\xe8\x42\x87\xea\xa1\x1a\x01\x88\xdf\xb4\xa2\x77\xbc\x7e\xe7\xa7\xb0\x76\xf6\x92\xcd\x8c\x15\x1b\xd3\x41\xb0\x4d\xcc\xb1\x7b\x03\x70\xf5\x3b\xf0\x13\x47\x79\xf6\xcb\x32\x29\xef\x6f\x62\xfa\x63\x27\xdc\x89\x60\x2a\x3e\x3e\x9b\xe6\xeb\x2c\xcf\xc4\x10\x4b\x58\x67\xf6\x9d\xe2\xaf\x14\xc3\x9c\x62\x1c\x87\xe1\xf1\x60\x0b\xa9\xed\x57\x2c\x7c\x0f\xbc\x42\x83\xcf\x9f\x0a\xf5\x22\x0d\x8f\xcf\x59\x16\xd9\x61\x83\x9a\xfd\x2a\xbc\x2f\xff\x22\x44\xbd\x68\xb4\x64\xd5\x3f\x35\xa0\x38\xe5\x3e\x0c\xc7\xe3\xef\xa8\x39\xef\x5e\x95\xb3\x76\x36\xc1\xf2\x0a\xef\xdb\x7b\x6c\xf9\x6c\xf5\x8f\x93\xdf\xc6\x6f\x90\x71\xe5\x5e\x27\x9c\xc5\xcf\xd7\x0a\xf0\xee\xdf\xed\x61\x39\x48\x07\x68\x61\x83\x40\xbd\x31\x69\x30\x50\x5d\x83\xc8\x14\xb3\xd5\x10\xff\xab\x17\xd9\xf3\x65\x37\x55\x91\x5f\xf5\x7f\x9c\x0c\x7a\x3f\x2d\xfe\x08\xd9\x7d\x9f\xcd\x56\xcc\x7f\xce\x0a\xe6\xf7\x7b\xe4\x14\x4e\x44\xd4\xc2\x26\x6d\x4e\x92\x45\x61\x2e\xd6\xff\xc1\x81\x87\xeb\x3a\xe7\x15\xd0\xf1\x42\xfc\x50\xc1\xa4\x39\x32\x96\x17\x3d\xa8\x54\x9b\x44\xdf\xfc\x22\x09\x44\x32\x87\xcb\x3e\x2c\xb9\x9c\x53\xb7\x67\x53\x50\xe4\x4b\xae\x7d\x8d\x14\xdc\xa2\x5c\x20\xbb\x39\x64\x8f\x44\xd7\x9d\xa7\xb7\xd7\x09\x01\x57\x19\x28\x9d\x77\xab\x3c\x02\xb1\xe4\x0c\x1f\x08\x2f\x77\x5a\xd0\xd4\x77\xf1\x2f\x44\x30\xa2\x3f\x75\x2f\x10\x15\x33\xfb\xb6\x44\x75\xd9\xcb\x5b\xb9\x32\x72\x01\xca\x06\x3f\xd8\xde\xa9\x29\xaa\x61\xcc\xe7\xf8\xab\xe8\x6b\xf9\x11\x31\x7f\x3d\xfa\x17\x0d\x45\xe2\x7b\xfd\x45\x00\x00")
 
 func schemaGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -31,6 +31,9 @@ func (*Service) Types(types *graphql.Types) {
 	types.Map("Commerce_Cart", cart.Cart{})
 	types.Resolve("Commerce_Cart", "getDeliveryByCode", Resolver{}, "GetDeliveryByCodeWithoutBool")
 	types.Map("Commerce_Cart_Summary", dto.CartSummary{})
+	types.Map("Commerce_Cart_LoyaltyEarnings", decorator.CartLoyaltyEarnings{})
+	types.Map("Commerce_Cart_ItemLoyaltyEarnings", decorator.ItemLoyaltyEarnings{})
+	types.Map("Commerce_Cart_LoyaltyEarning", decorator.LoyaltyEarning{})
 	types.Map("Commerce_CartDecoratedDelivery", decorator.DecoratedDelivery{})
 	types.Map("Commerce_CartDelivery", cart.Delivery{})
 	types.Map("Commerce_CartDeliveryInfo", cart.DeliveryInfo{})
@@ -63,8 +66,11 @@ func (*Service) Types(types *graphql.Types) {
 	types.Map("Commerce_Cart_Form_ValidationInfo", dto.ValidationInfo{})
 	types.Map("Commerce_Cart_Form_Error", formDomain.Error{})
 	types.Map("Commerce_Cart_Form_FieldError", dto.FieldError{})
+	types.Map("Commerce_Cart_Form_FieldSuggestion", dto.FieldSuggestion{})
 	types.Map("Commerce_Cart_ValidationResult", validation.Result{})
 	types.Map("Commerce_Cart_ItemValidationError", validation.ItemValidationError{})
+	types.Map("Commerce_Cart_DeliveryValidationResult", validation.DeliveryValidationResult{})
+	types.Map("Commerce_Cart_DeliveryValidationError", validation.DeliveryValidationError{})
 	types.Map("Commerce_Cart_PlacedOrderInfo", placeorder.PlacedOrderInfo{})
 	types.Map("Commerce_Cart_SelectedPaymentResult", dto.SelectedPaymentResult{})
 	types.Map("Commerce_Cart_PaymentSelection", new(cart.PaymentSelection))
@@ -81,10 +87,15 @@ func (*Service) Types(types *graphql.Types) {
 	types.Map("Commerce_Cart_PaymentSelection_SplitQualifier", cart.SplitQualifier{})
 	types.GoField("Commerce_Cart_PaymentSelection_SplitQualifier", "type", "ChargeType")
 	types.GoField("Commerce_Cart_PaymentSelection_SplitQualifier", "reference", "ChargeReference")
+	types.Map("Commerce_Cart_GiftWrap", cart.GiftWrap{})
+	types.Map("Commerce_Cart_GiftMessage", cart.GiftMessage{})
+	types.Map("Commerce_Cart_GiftMessageInput", cart.GiftMessage{})
+	types.Map("Commerce_Cart_GiftOptions", dto.GiftOptions{})
 
 	types.Resolve("Query", "Commerce_Cart", CommerceCartQueryResolver{}, "CommerceCart")
 	types.Resolve("Query", "Commerce_Cart_Validator", CommerceCartQueryResolver{}, "CommerceCartValidator")
 	types.Resolve("Query", "Commerce_Cart_QtyRestriction", CommerceCartQueryResolver{}, "CommerceCartQtyRestriction")
+	types.Resolve("Query", "Commerce_Cart_GiftOptions", CommerceCartGiftOptionsResolver{}, "CommerceCartGiftOptions")
 
 	types.Resolve("Mutation", "Commerce_AddToCart", CommerceCartMutationResolver{}, "CommerceAddToCart")
 	types.Resolve("Mutation", "Commerce_DeleteCartDelivery", CommerceCartMutationResolver{}, "CommerceDeleteCartDelivery")
@@ -99,6 +110,9 @@ func (*Service) Types(types *graphql.Types) {
 	types.Resolve("Mutation", "Commerce_Cart_UpdateDeliveryAddresses", CommerceCartMutationResolver{}, "CommerceCartUpdateDeliveryAddresses")
 	types.Resolve("Mutation", "Commerce_Cart_UpdateDeliveryShippingOptions", CommerceCartMutationResolver{}, "CommerceCartUpdateDeliveryShippingOptions")
 	types.Resolve("Mutation", "Commerce_Cart_Clean", CommerceCartMutationResolver{}, "CartClean")
+	types.Resolve("Mutation", "Commerce_Cart_UpdateItemGiftWrap", CommerceCartGiftOptionsResolver{}, "CommerceCartUpdateItemGiftWrap")
+	types.Resolve("Mutation", "Commerce_Cart_UpdateDeliveryGiftMessage", CommerceCartGiftOptionsResolver{}, "CommerceCartUpdateDeliveryGiftMessage")
+	types.Resolve("Mutation", "Commerce_Cart_SwitchCurrency", CommerceCartCurrencyResolver{}, "CommerceCartSwitchCurrency")
 }
 
 // Resolver helper
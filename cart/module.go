@@ -10,8 +10,10 @@ import (
 
 	"flamingo.me/flamingo-commerce/v3/cart/application"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/coupon"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/events"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/placeorder"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
 	"flamingo.me/flamingo-commerce/v3/cart/infrastructure"
 	placeorderAdapter "flamingo.me/flamingo-commerce/v3/cart/infrastructure/placeorder"
 	"flamingo.me/flamingo-commerce/v3/cart/interfaces/controller"
@@ -29,6 +31,12 @@ type (
 		enableDefaultCartAdapter      bool
 		enablePlaceOrderLoggerAdapter bool
 		enableCartCache               bool
+		enableAddressValidation       bool
+		enablePurchaseLimits          bool
+		purchaseHistory               string
+		enableCodeAttempts            bool
+		codeAttemptStore              string
+		enableCoupons                 bool
 	}
 )
 
@@ -36,9 +44,15 @@ type (
 func (m *Module) Inject(
 	routerRegistry *web.RouterRegistry,
 	config *struct {
-		EnableDefaultCartAdapter      bool `inject:"config:commerce.cart.defaultCartAdapter.enabled,optional"`
-		EnableCartCache               bool `inject:"config:commerce.cart.enableCartCache,optional"`
-		EnablePlaceOrderLoggerAdapter bool `inject:"config:commerce.cart.placeOrderLogger.enabled,optional"`
+		EnableDefaultCartAdapter      bool   `inject:"config:commerce.cart.defaultCartAdapter.enabled,optional"`
+		EnableCartCache               bool   `inject:"config:commerce.cart.enableCartCache,optional"`
+		EnablePlaceOrderLoggerAdapter bool   `inject:"config:commerce.cart.placeOrderLogger.enabled,optional"`
+		EnableAddressValidation       bool   `inject:"config:commerce.cart.addressValidation.enabled,optional"`
+		EnablePurchaseLimits          bool   `inject:"config:commerce.cart.purchaseLimits.enabled,optional"`
+		PurchaseHistory               string `inject:"config:commerce.cart.purchaseLimits.history,optional"`
+		EnableCodeAttempts            bool   `inject:"config:commerce.cart.codeAttempts.enabled,optional"`
+		CodeAttemptStore              string `inject:"config:commerce.cart.codeAttempts.store,optional"`
+		EnableCoupons                 bool   `inject:"config:commerce.cart.coupons.enabled,optional"`
 	},
 ) {
 	m.routerRegistry = routerRegistry
@@ -46,6 +60,12 @@ func (m *Module) Inject(
 		m.enableDefaultCartAdapter = config.EnableDefaultCartAdapter
 		m.enableCartCache = config.EnableCartCache
 		m.enablePlaceOrderLoggerAdapter = config.EnablePlaceOrderLoggerAdapter
+		m.enableAddressValidation = config.EnableAddressValidation
+		m.enablePurchaseLimits = config.EnablePurchaseLimits
+		m.purchaseHistory = config.PurchaseHistory
+		m.enableCodeAttempts = config.EnableCodeAttempts
+		m.codeAttemptStore = config.CodeAttemptStore
+		m.enableCoupons = config.EnableCoupons
 	}
 }
 
@@ -54,13 +74,49 @@ func (m *Module) Configure(injector *dingo.Injector) {
 	if m.enableDefaultCartAdapter {
 		injector.Bind((*infrastructure.CartStorage)(nil)).To(infrastructure.InMemoryCartStorage{}).AsEagerSingleton()
 		injector.Bind((*infrastructure.GiftCardHandler)(nil)).To(infrastructure.DefaultGiftCardHandler{})
-		injector.Bind((*infrastructure.VoucherHandler)(nil)).To(infrastructure.DefaultVoucherHandler{})
+		if m.enableCoupons {
+			injector.Bind((*infrastructure.VoucherHandler)(nil)).To(infrastructure.CouponVoucherHandler{})
+		} else {
+			injector.Bind((*infrastructure.VoucherHandler)(nil)).To(infrastructure.DefaultVoucherHandler{})
+		}
 		injector.Bind((*cart.GuestCartService)(nil)).To(infrastructure.DefaultGuestCartService{})
 		injector.Bind((*cart.CustomerCartService)(nil)).To(infrastructure.DefaultCustomerCartService{})
+		injector.BindMulti((*infrastructure.CartCalculator)(nil)).To(infrastructure.DefaultPricingCalculator{})
+		injector.BindMulti((*infrastructure.CartCalculator)(nil)).To(infrastructure.DefaultDiscountCalculator{})
+		injector.BindMulti((*infrastructure.CartCalculator)(nil)).To(infrastructure.DefaultShippingCalculator{})
+		injector.BindMulti((*infrastructure.CartCalculator)(nil)).To(infrastructure.DefaultTaxCalculator{})
+		injector.BindMulti((*infrastructure.CartCalculator)(nil)).To(infrastructure.DefaultTotalsCalculator{})
+		injector.BindMulti((*infrastructure.CartCalculator)(nil)).To(infrastructure.DefaultGiftCardCalculator{})
 	}
 	if m.enablePlaceOrderLoggerAdapter {
 		injector.Bind((*placeorder.Service)(nil)).To(placeorderAdapter.PlaceOrderLoggerAdapter{})
 	}
+	if m.enableAddressValidation {
+		injector.Bind((*validation.AddressValidator)(nil)).To(infrastructure.DefaultAddressValidator{})
+	}
+	if m.enablePurchaseLimits {
+		if m.purchaseHistory == "file" {
+			injector.Bind((*validation.PurchaseHistory)(nil)).To(infrastructure.FilePurchaseHistory{}).In(dingo.Singleton)
+		} else {
+			injector.Bind((*validation.PurchaseHistory)(nil)).To(infrastructure.InMemoryPurchaseHistory{}).In(dingo.Singleton)
+		}
+		injector.BindMulti((*validation.MaxQuantityRestrictor)(nil)).To(validation.PurchaseLimitRestrictor{})
+		flamingo.BindEventSubscriber(injector).To(application.PurchaseHistoryRecorder{})
+	}
+	if m.enableCoupons {
+		injector.Bind((*coupon.Repository)(nil)).To(infrastructure.InMemoryCouponRepository{}).In(dingo.Singleton)
+		injector.Bind((*coupon.UsageStore)(nil)).To(infrastructure.InMemoryCouponUsageStore{}).In(dingo.Singleton)
+		injector.Bind((*coupon.UsageReserver)(nil)).To(coupon.Service{})
+		flamingo.BindEventSubscriber(injector).To(application.CouponUsageRecorder{})
+	}
+	if m.enableCodeAttempts {
+		switch m.codeAttemptStore {
+		case "memory":
+			injector.Bind((*application.CodeAttemptStore)(nil)).To(application.InMemoryCodeAttemptStore{}).In(dingo.Singleton)
+		case "redis":
+			injector.Bind((*application.CodeAttemptStore)(nil)).To(infrastructure.RedisCodeAttemptStore{}).In(dingo.Singleton)
+		}
+	}
 	// Register Default EventPublisher
 	injector.Bind((*events.EventPublisher)(nil)).To(events.DefaultEventPublisher{})
 
@@ -100,6 +156,9 @@ commerce: {
 			enabled: bool | *true
 			storage: "inmemory"
 			defaultTaxRate?: number
+			calculation: {
+				stages: [...string] | *["pricing", "discounts", "shipping", "taxes", "totals", "giftcards"]
+			}
 		}
 		placeOrderLogger: {
 			enabled: bool | *true
@@ -124,6 +183,80 @@ commerce: {
 		simplePaymentForm: {
 			giftCardPaymentMethod: string | *"voucher"
 		}
+		addressValidation: {
+			enabled: bool | *false
+			countries: {
+				[string]: {
+					postCodePattern?: string
+					regionRequired?: bool
+					regionCodes?: [...string]
+					callingCode?: string
+					trunkPrefix?: string
+				}
+			}
+		}
+		giftOptions: {
+			wrappings: {
+				[string]: {
+					title: string
+					price: number
+				}
+			}
+			message: {
+				maxLength: number | *250
+				nameMaxLength: number | *50
+				allowedCharacters?: string
+			}
+		}
+		purchaseLimits: {
+			enabled: bool | *false
+			history: *"memory" | "file"
+			historyFile: string | *"./purchasehistory/purchases.json"
+			products: {
+				[string]: {
+					maxQty: number
+					window?: string
+				}
+			}
+		}
+		coupons: {
+			enabled: bool | *false
+			codes: {
+				[string]: {
+					promotionCode?: string
+					validFrom?: string
+					validUntil?: string
+					maxUses?: number
+					maxUsesPerCustomer?: number
+					notCombinable?: bool
+				}
+			}
+		}
+		codeAttempts: {
+			enabled: bool | *false
+			store: *"memory" | "redis" | "custom"
+			if store == "redis" {
+				redis: {
+					maxIdle:                 number | *25
+					idleTimeoutMilliseconds: number | *240000
+					network:                 string | *"tcp"
+					address:                 string | *"localhost:6379"
+					database:                number | *0
+				}
+			}
+			maxAttempts: number | *5
+			window: number | *600
+			lockout: number | *60
+			maxLockout: number | *86400
+			lockoutMemory: number | *86400
+			clientIPHeader: string | *"X-Forwarded-For"
+			trustedProxies: [...string] | *[]
+		}
+		loyaltyEarnings: {
+			roundingMode: *"floor" | "ceil" | "halfup" | "halfdown"
+			roundingPrecision: number | *1
+			loyaltyPaidItems: *"proportional" | "exclude" | "include"
+		}
 	}
 }`
 }
@@ -236,6 +369,21 @@ func (r *routes) apiRoutes(registry *web.RouterRegistry) {
 	registry.Route("/api/v1/cart/updatepaymentselection", `cart.api.updatepaymentselection`)
 	registry.HandlePut("cart.api.updatepaymentselection", r.apiController.UpdatePaymentSelectionAction)
 
+	registry.Route("/api/v1/cart/giftoptions", `cart.api.giftoptions`)
+	registry.HandleGet("cart.api.giftoptions", r.apiController.GetGiftOptionsAction)
+
+	registry.Route("/api/v1/cart/item/:itemID/giftwrap", `cart.api.item.giftwrap(giftWrapCode?="")`)
+	registry.HandlePut("cart.api.item.giftwrap", r.apiController.UpdateItemGiftWrapAction)
+	registry.HandleDelete("cart.api.item.giftwrap", r.apiController.RemoveItemGiftWrapAction)
+
+	registry.Route("/api/v1/cart/delivery/:deliveryCode/giftmessage", `cart.api.delivery.giftmessage`)
+	registry.HandlePut("cart.api.delivery.giftmessage", r.apiController.UpdateGiftMessageAction)
+	registry.HandlePost("cart.api.delivery.giftmessage", r.apiController.UpdateGiftMessageAction)
+	registry.HandleDelete("cart.api.delivery.giftmessage", r.apiController.RemoveGiftMessageAction)
+
+	registry.Route("/api/v1/cart/currency", `cart.api.currency(currency)`)
+	registry.HandlePut("cart.api.currency", r.apiController.SwitchCurrencyAction)
+
 	// registry.Route("/api/cart/delivery/:shipping", `cart.api.shipping(deliveryCode?="")`)
 	// TODO registry.HandleDelete("cart.api.delivery", r.apiController.DeleteDelivery)
 }
@@ -41,6 +41,9 @@ type (
 		PlacedOrders placeorder.PlacedOrderInfos
 		ContactEmail string
 		Cart         cart.Cart
+		// ReviewRequired is set if the risk assessment requires a manual review of the placed orders
+		ReviewRequired bool
+		RiskScore      float64
 	}
 
 	// PlaceOrderPaymentInfo holding payment infos
@@ -52,6 +55,11 @@ type (
 		Amount          priceDomain.Price
 		Title           string
 	}
+
+	// placeOrderError hides the details of a failed place order from the customer, the cause is kept for errors.As, e.g. to detect transient errors
+	placeOrderError struct {
+		cause error
+	}
 )
 
 const (
@@ -256,6 +264,15 @@ func (os *OrderService) CurrentCartPlaceOrder(ctx context.Context, session *web.
 	return info, err
 }
 
+func (e *placeOrderError) Error() string {
+	return "error while placing the order. please contact customer support"
+}
+
+// Unwrap returns the cause of the failed place order
+func (e *placeOrderError) Unwrap() error {
+	return e.cause
+}
+
 func (os *OrderService) placeOrder(ctx context.Context, session *web.Session, decoratedCart *decorator.DecoratedCart, payment placeorder.Payment) (*PlaceOrderInfo, error) {
 	validationResult := os.cartService.ValidateCart(ctx, session, decoratedCart)
 	if !validationResult.IsValid() {
@@ -272,7 +289,7 @@ func (os *OrderService) placeOrder(ctx context.Context, session *web.Session, de
 		stats.Record(ctx, placeOrderFailCount.M(1))
 		os.logger.WithContext(ctx).Error("Error during place Order:" + err.Error())
 
-		return nil, errors.New("error while placing the order. please contact customer support")
+		return nil, &placeOrderError{cause: err}
 	}
 
 	placeOrderInfo := os.preparePlaceOrderInfo(ctx, decoratedCart.Cart, placedOrderInfos, payment)
@@ -1,6 +1,7 @@
 package placeorder
 
 import (
+	"net/http"
 	"net/url"
 
 	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
@@ -11,6 +12,8 @@ type (
 	StartPlaceOrderCommand struct {
 		Cart      cartDomain.Cart
 		ReturnURL *url.URL
+		// IdempotencyKey optionally provided by the client, a replay returns the original process
+		IdempotencyKey string
 	}
 
 	// RefreshPlaceOrderCommand proceeds in place order process
@@ -20,4 +23,26 @@ type (
 	// CancelPlaceOrderCommand cancels current running process
 	CancelPlaceOrderCommand struct {
 	}
+
+	// BuyNowCommand starts an express checkout of a single product, see ExpressCheckout
+	BuyNowCommand struct {
+		MarketplaceCode        string
+		VariantMarketplaceCode string
+		Qty                    int
+		// DeliveryInfo of the only delivery, the default delivery code is used if the code is empty
+		DeliveryInfo   cartDomain.DeliveryInfo
+		BillingAddress *cartDomain.Address
+		// PaymentGateway and PaymentMethod of the main charge, the payment selection is skipped if the gateway is empty
+		PaymentGateway string
+		PaymentMethod  string
+		ReturnURL      *url.URL
+		// IdempotencyKey optionally provided by the client, a replay returns the original process
+		IdempotencyKey string
+	}
+
+	// PaymentNotificationCommand advances the process of a server-to-server payment notification
+	PaymentNotificationCommand struct {
+		Gateway string
+		Request *http.Request
+	}
 )
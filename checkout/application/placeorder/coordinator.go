@@ -13,6 +13,7 @@ import (
 	"go.opencensus.io/tag"
 	"go.opencensus.io/trace"
 
+	"flamingo.me/flamingo/v3/framework/config"
 	"flamingo.me/flamingo/v3/framework/flamingo"
 	"flamingo.me/flamingo/v3/framework/opencensus"
 	"flamingo.me/flamingo/v3/framework/web"
@@ -44,9 +45,18 @@ type (
 		cartService    *application.CartService
 		processFactory *process.Factory
 		contextStore   process.ContextStore
+		// referenceStore is optional, without it processes can't be advanced by payment notifications
+		referenceStore process.ReferenceStore
 		sessionStore   *web.SessionStore
 		sessionName    string
 		area           string
+		// idempotencyKeyExpiration after which an idempotency key can be used for a new process again
+		idempotencyKeyExpiration time.Duration
+		stateTimeouts            process.StateTimeouts
+		// referenceExpiration of the references to advance processes by payment notifications
+		referenceExpiration time.Duration
+		// trustedProxies may set the X-Forwarded-For header of the requests
+		trustedProxies application.TrustedProxies
 	}
 )
 
@@ -56,6 +66,9 @@ const maxRunCount = 100
 // waitForLockThrottle specifies the time to wait between attempts to get the lock for all blocking operations (cancel / runBlocking)
 const waitForLockThrottle = 50 * time.Millisecond
 
+// maxIdempotencyKeyLength limits the length of idempotency keys provided by clients
+const maxIdempotencyKeyLength = 255
+
 var (
 	// ErrLockTaken to indicate the lock is taken (by another running process)
 	ErrLockTaken = errors.New("lock already taken")
@@ -63,11 +76,18 @@ var (
 	ErrNoPlaceOrderProcess = errors.New("ErrNoPlaceOrderProcess")
 	// ErrAnotherPlaceOrderProcessRunning if a process runs
 	ErrAnotherPlaceOrderProcessRunning = errors.New("ErrAnotherPlaceOrderProcessRunning")
+	// ErrInvalidIdempotencyKey if the provided idempotency key exceeds the maximum length
+	ErrInvalidIdempotencyKey = errors.New("ErrInvalidIdempotencyKey")
 
 	maxLockDuration = 2 * time.Minute
 
 	// startCount counts starts of new place order processes
 	startCount = stats.Int64("flamingo-commerce/checkout/placeorder/starts", "Counts how often a new place order process was started", stats.UnitDimensionless)
+	// lockTakenCount counts the attempts to get the lock of a process that is locked by another request
+	lockTakenCount = stats.Int64("flamingo-commerce/checkout/placeorder/lock_taken_count", "Counts how often the lock of a process was already taken", stats.UnitDimensionless)
+	// lockWaitDuration measures the time blocking operations wait for the lock of a process
+	lockWaitDuration = stats.Int64("flamingo-commerce/checkout/placeorder/lock_wait_duration", "Time blocking operations wait for the lock of a process", stats.UnitMilliseconds)
+	keyOperation, _  = tag.NewKey("operation")
 )
 
 func init() {
@@ -76,6 +96,14 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	err = opencensus.View("flamingo-commerce/checkout/placeorder/lock_taken_count", lockTakenCount, view.Count(), keyOperation)
+	if err != nil {
+		panic(err)
+	}
+	err = opencensus.View("flamingo-commerce/checkout/placeorder/lock_wait_duration", lockWaitDuration, view.Distribution(10, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000, 60000, 120000), keyOperation)
+	if err != nil {
+		panic(err)
+	}
 }
 
 //Inject dependencies
@@ -86,9 +114,16 @@ func (c *Coordinator) Inject(
 	contextStore process.ContextStore,
 	sessionStore *web.SessionStore,
 	cartService *application.CartService,
+	optionals *struct {
+		ReferenceStore process.ReferenceStore `inject:",optional"`
+	},
 	cfg *struct {
-		SessionName string `inject:"config:flamingo.session.name,optional"`
-		Area        string `inject:"config:area"`
+		SessionName                     string       `inject:"config:flamingo.session.name,optional"`
+		Area                            string       `inject:"config:area"`
+		IdempotencyKeyExpirationSeconds float64      `inject:"config:commerce.checkout.placeorder.idempotencyKeyExpirationSeconds,optional"`
+		StateTimeouts                   config.Map   `inject:"config:commerce.checkout.placeorder.timeouts.states,optional"`
+		ReferenceExpirationSeconds      float64      `inject:"config:commerce.checkout.placeorder.notifications.referenceExpiration,optional"`
+		TrustedProxies                  config.Slice `inject:"config:commerce.checkout.placeorder.risk.trustedProxies,optional"`
 	},
 ) {
 	c.locker = locker
@@ -98,19 +133,88 @@ func (c *Coordinator) Inject(
 	c.sessionStore = sessionStore
 	c.cartService = cartService
 
+	// the references are kept in the context store if the project doesn't bind its own reference store
+	if optionals != nil && optionals.ReferenceStore != nil {
+		c.referenceStore = optionals.ReferenceStore
+	} else if store, ok := contextStore.(process.ReferenceStore); ok {
+		c.referenceStore = store
+	}
+
 	if cfg != nil {
 		c.area = cfg.Area
 		c.sessionName = cfg.SessionName
+		c.idempotencyKeyExpiration = time.Duration(cfg.IdempotencyKeyExpirationSeconds * float64(time.Second))
+		c.referenceExpiration = time.Duration(cfg.ReferenceExpirationSeconds * float64(time.Second))
+
+		var seconds map[string]float64
+		if err := cfg.StateTimeouts.MapInto(&seconds); err != nil {
+			panic(fmt.Errorf("invalid config commerce.checkout.placeorder.timeouts.states: %w", err))
+		}
+
+		c.stateTimeouts = make(process.StateTimeouts, len(seconds))
+		for state, timeout := range seconds {
+			c.stateTimeouts[state] = time.Duration(timeout * float64(time.Second))
+		}
+
+		var trustedProxies []string
+		if cfg.TrustedProxies != nil {
+			if err := cfg.TrustedProxies.MapInto(&trustedProxies); err != nil {
+				panic(fmt.Errorf("invalid config commerce.checkout.placeorder.risk.trustedProxies: %w", err))
+			}
+		}
+
+		var err error
+		c.trustedProxies, err = application.ParseTrustedProxies(trustedProxies)
+		if err != nil {
+			panic(fmt.Errorf("invalid config commerce.checkout.placeorder.risk.trustedProxies: %w", err))
+		}
+	}
+
+	if c.idempotencyKeyExpiration <= 0 {
+		c.idempotencyKeyExpiration = 24 * time.Hour
+	}
+
+	if c.referenceExpiration <= 0 {
+		c.referenceExpiration = 7 * 24 * time.Hour
 	}
 }
 
 // New acquires lock if possible and creates new process with first run call blocking
 // returns error if already locked or error during run
 func (c *Coordinator) New(ctx context.Context, cart cartDomain.Cart, returnURL *url.URL) (*process.Context, error) {
+	return c.NewWithIdempotencyKey(ctx, cart, returnURL, "")
+}
+
+// NewWithIdempotencyKey works like New but a replay with an already used idempotency key returns
+// the context of the original process instead of starting a new one, see Replay
+func (c *Coordinator) NewWithIdempotencyKey(ctx context.Context, cart cartDomain.Cart, returnURL *url.URL, idempotencyKey string) (*process.Context, error) {
+	return c.start(ctx, cart, returnURL, idempotencyKey, "")
+}
+
+// NewExpress works like NewWithIdempotencyKey for the express cart of an express checkout,
+// the states of the process work on the express cart instead of the cart of the session
+func (c *Coordinator) NewExpress(ctx context.Context, expressCart cartDomain.Cart, returnURL *url.URL, idempotencyKey string) (*process.Context, error) {
+	return c.start(ctx, expressCart, returnURL, idempotencyKey, expressCart.ID)
+}
+
+func (c *Coordinator) start(ctx context.Context, cart cartDomain.Cart, returnURL *url.URL, idempotencyKey string, expressCartID string) (*process.Context, error) {
 	ctx, span := trace.StartSpan(ctx, "placeorder/coordinator/New")
 	defer span.End()
 
-	unlock, err := c.locker.TryLock(ctx, determineLockKeyForCart(cart), maxLockDuration)
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		return nil, ErrInvalidIdempotencyKey
+	}
+
+	if pctx, replayed := c.Replay(ctx, idempotencyKey); replayed {
+		return pctx, nil
+	}
+
+	// a timed out process must not block the new one
+	if err := c.ExpireTimedOutProcess(ctx); err != nil && err != ErrNoPlaceOrderProcess {
+		c.logger.WithContext(ctx).Error(err)
+	}
+
+	unlock, err := c.tryLock(ctx, determineLockKeyForCart(cart), "new")
 	if err != nil {
 		if err == ErrLockTaken {
 			return nil, ErrAnotherPlaceOrderProcessRunning
@@ -121,9 +225,17 @@ func (c *Coordinator) New(ctx context.Context, cart cartDomain.Cart, returnURL *
 		_ = unlock()
 	}()
 
+	clientIP, clientUserAgent := c.determineClient(web.RequestFromContext(ctx))
+
 	var runErr error
 	var runPCtx *process.Context
 	web.RunWithDetachedContext(ctx, func(ctx context.Context) {
+		// the original request may have finished while waiting for the lock
+		if pctx, replayed := c.Replay(ctx, idempotencyKey); replayed {
+			runPCtx = pctx
+			return
+		}
+
 		has, err := c.HasUnfinishedProcess(ctx)
 		if err != nil {
 			runErr = err
@@ -144,6 +256,10 @@ func (c *Coordinator) New(ctx context.Context, cart cartDomain.Cart, returnURL *
 			c.logger.Error(err)
 			return
 		}
+		newProcess.UpdateClient(clientIP, clientUserAgent)
+		if expressCartID != "" {
+			newProcess.UseExpressCart(expressCartID)
+		}
 		pctx := newProcess.Context()
 		runPCtx = &pctx
 		err = c.storeProcessContext(ctx, pctx)
@@ -153,12 +269,140 @@ func (c *Coordinator) New(ctx context.Context, cart cartDomain.Cart, returnURL *
 			return
 		}
 
+		if idempotencyKey != "" {
+			err = c.storeIdempotencyKey(ctx, idempotencyKey, pctx)
+			if err != nil {
+				c.logger.Error(err)
+			}
+		}
+
+		// payment notifications advance the process without the session of the customer
+		if c.referenceStore != nil {
+			err = c.referenceStore.StoreReference(ctx, determineProcessReference(pctx.UUID), web.SessionFromContext(ctx).ID(), c.referenceExpiration)
+			if err != nil {
+				c.logger.Error(err)
+			}
+		}
+
 		c.Run(ctx)
 	})
 
 	return runPCtx, runErr
 }
 
+// Replay returns the context of the process started with the idempotency key in the current session,
+// the latest context is returned as long as it is the last process of the session, otherwise the context at start
+func (c *Coordinator) Replay(ctx context.Context, idempotencyKey string) (*process.Context, bool) {
+	if idempotencyKey == "" {
+		return nil, false
+	}
+
+	session := web.SessionFromContext(ctx)
+	if session == nil {
+		return nil, false
+	}
+
+	key := determineIdempotencyKey(session.ID(), idempotencyKey)
+	original, found := c.contextStore.Get(ctx, key)
+	if !found {
+		return nil, false
+	}
+
+	if time.Since(original.StartedAt) > c.idempotencyKeyExpiration {
+		_ = c.contextStore.Delete(ctx, key)
+		return nil, false
+	}
+
+	if last, found := c.contextStore.Get(ctx, session.ID()); found && last.UUID == original.UUID {
+		return &last, true
+	}
+
+	return &original, true
+}
+
+func (c *Coordinator) storeIdempotencyKey(ctx context.Context, idempotencyKey string, pctx process.Context) error {
+	session := web.SessionFromContext(ctx)
+	if session == nil {
+		return errors.New("session not available to store the idempotency key")
+	}
+
+	key := determineIdempotencyKey(session.ID(), idempotencyKey)
+	if store, ok := c.contextStore.(process.ExpiringContextStore); ok {
+		return store.StoreWithExpiration(ctx, key, pctx, c.idempotencyKeyExpiration)
+	}
+
+	return c.contextStore.Store(ctx, key, pctx)
+}
+
+// RegisterCorrelationID maps the correlation id of a payment to the place order process, needed by payment gateways
+// that don't use the process UUID as correlation id for the notifications of their payment provider
+func (c *Coordinator) RegisterCorrelationID(ctx context.Context, correlationID string, processUUID string) error {
+	if c.referenceStore == nil {
+		return errors.New("no place order reference store available to register the correlation id")
+	}
+
+	return c.referenceStore.StoreReference(ctx, determineCorrelationReference(correlationID), processUUID, c.referenceExpiration)
+}
+
+// ProcessUUIDByCorrelationID returns the UUID of the place order process of the payment,
+// the correlation id is the UUID itself if no other mapping has been registered
+func (c *Coordinator) ProcessUUIDByCorrelationID(ctx context.Context, correlationID string) (string, error) {
+	if c.referenceStore == nil {
+		return correlationID, nil
+	}
+
+	processUUID, found, err := c.referenceStore.GetReference(ctx, determineCorrelationReference(correlationID))
+	if err != nil {
+		return "", err
+	}
+
+	if found {
+		return processUUID, nil
+	}
+
+	return correlationID, nil
+}
+
+// RunByUUID loads the process by its UUID without the session of the customer, e.g. for payment notifications,
+// and proceeds in the state machine like RunBlocking. The next refresh of the customer sees the updated state.
+// Without reference store no process can be found and ErrNoPlaceOrderProcess is returned.
+func (c *Coordinator) RunByUUID(ctx context.Context, processUUID string) (*process.Context, error) {
+	ctx, span := trace.StartSpan(ctx, "placeorder/coordinator/RunByUUID")
+	defer span.End()
+
+	if c.referenceStore == nil {
+		return nil, ErrNoPlaceOrderProcess
+	}
+
+	sessionID, found, err := c.referenceStore.GetReference(ctx, determineProcessReference(processUUID))
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, ErrNoPlaceOrderProcess
+	}
+
+	session, err := c.sessionStore.LoadByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = web.ContextWithSession(ctx, session)
+
+	last, err := c.LastProcess(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the customer may have started another process in the meantime
+	if last.Context().UUID != processUUID {
+		return nil, ErrNoPlaceOrderProcess
+	}
+
+	return c.RunBlocking(ctx)
+}
+
 // HasUnfinishedProcess checks for processes not in final state
 func (c *Coordinator) HasUnfinishedProcess(ctx context.Context) (bool, error) {
 	last, err := c.LastProcess(ctx)
@@ -183,6 +427,13 @@ func (c *Coordinator) storeProcessContext(ctx context.Context, pctx process.Cont
 		return errors.New("session not available to check for last place order context")
 	}
 
+	// index processes in states with timeout so that the janitor only checks the due ones
+	if store, ok := c.contextStore.(process.DeadlineContextStore); ok {
+		if deadline, found := c.stateTimeouts.Deadline(pctx); found {
+			return store.StoreWithDeadline(ctx, session.ID(), pctx, deadline)
+		}
+	}
+
 	return c.contextStore.Store(ctx, session.ID(), pctx)
 }
 
@@ -229,14 +480,7 @@ func (c *Coordinator) Cancel(ctx context.Context) error {
 				returnErr = err
 				return
 			}
-			var unlock Unlock
-			err = ErrLockTaken
-			for err == ErrLockTaken {
-				unlock, err = c.locker.TryLock(ctx, determineLockKeyForProcess(p), maxLockDuration)
-				// todo: add proper throttling
-
-				time.Sleep(waitForLockThrottle)
-			}
+			unlock, err := c.waitForLock(ctx, determineLockKeyForProcess(p), "cancel")
 			if err != nil {
 				returnErr = err
 				return
@@ -274,6 +518,113 @@ func (c *Coordinator) Cancel(ctx context.Context) error {
 	return returnErr
 }
 
+// ExpireTimedOutProcess fails the process of the current session with the TimeoutReason if it stayed longer than configured
+// in its current state, all rollbacks are executed. It is a nop if the process is locked by a running request.
+func (c *Coordinator) ExpireTimedOutProcess(ctx context.Context) error {
+	if len(c.stateTimeouts) == 0 {
+		return nil
+	}
+
+	ctx, span := trace.StartSpan(ctx, "placeorder/coordinator/ExpireTimedOutProcess")
+	defer span.End()
+
+	var returnErr error
+	web.RunWithDetachedContext(ctx, func(ctx context.Context) {
+		p, err := c.LastProcess(ctx)
+		if err != nil {
+			returnErr = err
+			return
+		}
+
+		if _, timedOut := c.stateTimeouts.TimedOut(p.Context(), time.Now()); !timedOut {
+			return
+		}
+
+		unlock, err := c.tryLock(ctx, determineLockKeyForProcess(p), "expire")
+		if err != nil {
+			if err != ErrLockTaken {
+				returnErr = err
+			}
+			return
+		}
+		defer func() {
+			_ = unlock()
+		}()
+
+		// lock acquired get fresh process state
+		p, err = c.LastProcess(ctx)
+		if err != nil {
+			returnErr = err
+			return
+		}
+
+		c.failIfTimedOut(ctx, p)
+	})
+
+	return returnErr
+}
+
+// ExpireTimedOutProcesses checks the processes of all sessions with a passed deadline for timeouts, see ExpireTimedOutProcess,
+// the context store has to implement process.DeadlineContextStore
+func (c *Coordinator) ExpireTimedOutProcesses(ctx context.Context) error {
+	if len(c.stateTimeouts) == 0 {
+		return nil
+	}
+
+	store, ok := c.contextStore.(process.DeadlineContextStore)
+	if !ok {
+		return fmt.Errorf("context store %T can't index its contexts by deadline", c.contextStore)
+	}
+
+	now := time.Now()
+	keys, err := store.KeysDueBefore(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		pctx, found := c.contextStore.Get(ctx, key)
+		if !found {
+			continue
+		}
+
+		if _, timedOut := c.stateTimeouts.TimedOut(pctx, now); !timedOut {
+			continue
+		}
+
+		// the context store key is the session id, rollbacks like restoring the cart need the session
+		session, err := c.sessionStore.LoadByID(ctx, key)
+		if err != nil {
+			c.logger.WithContext(ctx).Warn(fmt.Sprintf("session of timed out place order process %q not loaded: %s", pctx.UUID, err))
+			continue
+		}
+
+		err = c.ExpireTimedOutProcess(web.ContextWithSession(ctx, session))
+		if err != nil {
+			c.logger.WithContext(ctx).Error(err)
+		}
+	}
+
+	return nil
+}
+
+// failIfTimedOut fails and stores the process if it timed out, the caller has to hold the lock of the process
+func (c *Coordinator) failIfTimedOut(ctx context.Context, p *process.Process) bool {
+	reason, timedOut := c.stateTimeouts.TimedOut(p.Context(), time.Now())
+	if !timedOut {
+		return false
+	}
+
+	c.logger.WithContext(ctx).Info(fmt.Sprintf("place order process %q: %s", p.Context().UUID, reason.Reason()))
+	p.Failed(ctx, reason)
+	err := c.storeProcessContext(ctx, p.Context())
+	if err != nil {
+		c.logger.WithContext(ctx).Error(err)
+	}
+
+	return true
+}
+
 // ClearLastProcess removes last stored process if in final state
 func (c *Coordinator) ClearLastProcess(ctx context.Context) error {
 	ctx, span := trace.StartSpan(ctx, "placeorder/coordinator/Clear")
@@ -327,7 +678,7 @@ func (c *Coordinator) Run(ctx context.Context) {
 				return
 			}
 
-			unlock, err := c.locker.TryLock(ctx, determineLockKeyForProcess(p), maxLockDuration)
+			unlock, err := c.tryLock(ctx, determineLockKeyForProcess(p), "run")
 			if err != nil {
 				return
 			}
@@ -341,7 +692,11 @@ func (c *Coordinator) Run(ctx context.Context) {
 				return
 			}
 
-			err = c.proceedInStateMachineUntilNoStateChange(ctx, p)
+			if c.failIfTimedOut(ctx, p) {
+				return
+			}
+
+			err = c.proceedInStateMachineUntilNoStateChange(ctx, p, nil)
 			if err != nil {
 				c.logger.Error("proceeding in state machine failed: ", err)
 				return
@@ -350,7 +705,10 @@ func (c *Coordinator) Run(ctx context.Context) {
 	}(ctx)
 }
 
-func (c *Coordinator) proceedInStateMachineUntilNoStateChange(ctx context.Context, p *process.Process) error {
+// proceedInStateMachineUntilNoStateChange runs the process until the state doesn't change anymore. A pending retry after a
+// transient failure is rerun if awaitRetry waited for its RetryAt, otherwise (or without awaitRetry) it is returned with the
+// RetryAt of the process context and the next Run / RunBlocking retries it. Retries count against the maxRunCount.
+func (c *Coordinator) proceedInStateMachineUntilNoStateChange(ctx context.Context, p *process.Process, awaitRetry func(retryAt time.Time) bool) error {
 	stateBeforeRun := p.Context().CurrentStateName
 	for i := 0; i < maxRunCount; i++ {
 
@@ -362,6 +720,9 @@ func (c *Coordinator) proceedInStateMachineUntilNoStateChange(ctx context.Contex
 		c.forceSessionUpdate(ctx)
 		stateAfterRun := p.Context().CurrentStateName
 		if stateBeforeRun == stateAfterRun {
+			if p.RetryPending() && awaitRetry != nil && awaitRetry(p.Context().RetryAt) {
+				continue
+			}
 			return nil
 		}
 		stateBeforeRun = stateAfterRun
@@ -374,11 +735,13 @@ func (c *Coordinator) proceedInStateMachineUntilNoStateChange(ctx context.Contex
 }
 
 // RunBlocking waits for the lock and starts the next processing
-// RunBlocking waits until the process is finished and returns its result
+// RunBlocking waits until the process is finished and returns its result, pending retries are awaited while the request waits
 func (c *Coordinator) RunBlocking(ctx context.Context) (*process.Context, error) {
 	ctx, span := trace.StartSpan(ctx, "placeorder/coordinator/RunBlocking")
 	defer span.End()
 
+	requestCtx := ctx
+	var lockExpiresAt time.Time
 	var pctx *process.Context
 	var returnErr error
 	web.RunWithDetachedContext(ctx, func(ctx context.Context) {
@@ -390,17 +753,12 @@ func (c *Coordinator) RunBlocking(ctx context.Context) (*process.Context, error)
 				return
 			}
 
-			var unlock Unlock
-			err = ErrLockTaken
-			for err == ErrLockTaken {
-				unlock, err = c.locker.TryLock(ctx, determineLockKeyForProcess(p), maxLockDuration)
-				// todo: add proper throttling
-				time.Sleep(waitForLockThrottle)
-			}
+			unlock, err := c.waitForLock(ctx, determineLockKeyForProcess(p), "runblocking")
 			if err != nil {
 				returnErr = err
 				return
 			}
+			lockExpiresAt = time.Now().Add(maxLockDuration)
 
 			defer func() {
 				_ = unlock()
@@ -435,7 +793,15 @@ func (c *Coordinator) RunBlocking(ctx context.Context) (*process.Context, error)
 
 		ctx = web.ContextWithSession(ctx, session)
 
-		err = c.proceedInStateMachineUntilNoStateChange(ctx, p)
+		if c.failIfTimedOut(ctx, p) {
+			timedOutPctx := p.Context()
+			pctx = &timedOutPctx
+			return
+		}
+
+		err = c.proceedInStateMachineUntilNoStateChange(ctx, p, func(retryAt time.Time) bool {
+			return awaitRetry(requestCtx, retryAt, lockExpiresAt)
+		})
 		if err != nil {
 			returnErr = err
 			return
@@ -447,6 +813,55 @@ func (c *Coordinator) RunBlocking(ctx context.Context) (*process.Context, error)
 	return pctx, returnErr
 }
 
+// awaitRetry sleeps until the retry is due, it returns false without waiting if the retry is not due before the deadline
+// (e.g. the expiration of the lock) and returns false as soon as the context is done
+func awaitRetry(ctx context.Context, retryAt time.Time, deadline time.Time) bool {
+	if retryAt.After(deadline) {
+		return false
+	}
+
+	timer := time.NewTimer(time.Until(retryAt))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// tryLock tries to get the lock and counts taken locks per operation
+func (c *Coordinator) tryLock(ctx context.Context, key string, operation string) (Unlock, error) {
+	unlock, err := c.locker.TryLock(ctx, key, maxLockDuration)
+	if err == ErrLockTaken {
+		censusCtx, _ := tag.New(ctx, tag.Upsert(opencensus.KeyArea, c.area), tag.Upsert(keyOperation, operation))
+		stats.Record(censusCtx, lockTakenCount.M(1))
+	}
+
+	return unlock, err
+}
+
+// waitForLock blocks until the lock is acquired, a taken lock is counted once and the wait is measured per operation
+func (c *Coordinator) waitForLock(ctx context.Context, key string, operation string) (Unlock, error) {
+	start := time.Now()
+	unlock, err := c.tryLock(ctx, key, operation)
+	if err != ErrLockTaken {
+		return unlock, err
+	}
+
+	for err == ErrLockTaken {
+		// todo: add proper throttling
+		time.Sleep(waitForLockThrottle)
+		unlock, err = c.locker.TryLock(ctx, key, maxLockDuration)
+	}
+
+	censusCtx, _ := tag.New(ctx, tag.Upsert(opencensus.KeyArea, c.area), tag.Upsert(keyOperation, operation))
+	stats.Record(censusCtx, lockWaitDuration.M(time.Since(start).Milliseconds()))
+
+	return unlock, err
+}
+
 func (c *Coordinator) forceSessionUpdate(ctx context.Context) {
 	session := web.SessionFromContext(ctx)
 	_, err := c.sessionStore.Save(ctx, session)
@@ -462,3 +877,25 @@ func determineLockKeyForCart(cart cartDomain.Cart) string {
 func determineLockKeyForProcess(p *process.Process) string {
 	return "checkout_placeorder_lock_" + p.Context().Cart.ID
 }
+
+func determineProcessReference(processUUID string) string {
+	return "process_" + processUUID
+}
+
+func determineCorrelationReference(correlationID string) string {
+	return "correlation_" + correlationID
+}
+
+// determineClient returns the IP and user agent of the request, the X-Forwarded-For header is only taken into account
+// behind the trusted proxies
+func (c *Coordinator) determineClient(r *web.Request) (string, string) {
+	if r == nil || r.Request() == nil {
+		return "", ""
+	}
+
+	return c.trustedProxies.ClientIP(r.Request(), "X-Forwarded-For"), r.Request().UserAgent()
+}
+
+func determineIdempotencyKey(sessionID string, idempotencyKey string) string {
+	return "checkout_placeorder_idempotency_" + sessionID + "_" + idempotencyKey
+}
@@ -0,0 +1,37 @@
+package placeorder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAwaitRetry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retry due before the deadline is awaited", func(t *testing.T) {
+		t.Parallel()
+
+		retryAt := time.Now().Add(10 * time.Millisecond)
+		assert.True(t, awaitRetry(context.Background(), retryAt, time.Now().Add(time.Minute)))
+		assert.False(t, time.Now().Before(retryAt))
+	})
+
+	t.Run("retry after the deadline is not awaited", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Now()
+		assert.False(t, awaitRetry(context.Background(), start.Add(time.Hour), start.Add(time.Minute)))
+		assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	})
+
+	t.Run("canceled context stops waiting", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.False(t, awaitRetry(ctx, time.Now().Add(30*time.Second), time.Now().Add(time.Minute)))
+	})
+}
@@ -0,0 +1,134 @@
+package placeorder
+
+import (
+	"context"
+	"errors"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"go.opencensus.io/trace"
+
+	"flamingo.me/flamingo-commerce/v3/cart/application"
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+type (
+	// ExpressCheckout places the order of a single product ("buy now") without touching the cart of the session:
+	// the product is put into a new express cart that only the place order process works on,
+	// all other requests of the session keep working on its main cart
+	ExpressCheckout struct {
+		cartService *application.CartService
+		coordinator *Coordinator
+		logger      flamingo.Logger
+	}
+)
+
+// ErrInvalidBuyNowCommand is returned if the product or the qty of the BuyNowCommand is missing
+var ErrInvalidBuyNowCommand = errors.New("marketplace code and a positive qty are required")
+
+// Inject dependencies
+func (e *ExpressCheckout) Inject(
+	cartService *application.CartService,
+	coordinator *Coordinator,
+	logger flamingo.Logger,
+) *ExpressCheckout {
+	e.cartService = cartService
+	e.coordinator = coordinator
+	e.logger = logger.WithField(flamingo.LogKeyModule, "checkout").WithField(flamingo.LogKeyCategory, "expresscheckout")
+
+	return e
+}
+
+// BuyNow creates the express cart with the product, the delivery info, the billing address and the payment selection
+// of the command and starts the place order process for it. If the process can't be started the express cart is abandoned.
+func (e *ExpressCheckout) BuyNow(ctx context.Context, command BuyNowCommand) (*process.Context, error) {
+	ctx, span := trace.StartSpan(ctx, "placeorder/expresscheckout/BuyNow")
+	defer span.End()
+
+	if pctx, replayed := e.coordinator.Replay(ctx, command.IdempotencyKey); replayed {
+		return pctx, nil
+	}
+
+	if command.MarketplaceCode == "" || command.Qty <= 0 {
+		return nil, ErrInvalidBuyNowCommand
+	}
+
+	session := web.SessionFromContext(ctx)
+	if session == nil {
+		return nil, errors.New("session not available to start an express checkout")
+	}
+
+	has, err := e.coordinator.HasUnfinishedProcess(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, ErrAnotherPlaceOrderProcessRunning
+	}
+
+	expressCart, err := e.cartService.GetCartReceiverService().NewExpressCart(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pctx, err := e.start(application.ContextWithExpressCart(ctx, expressCart.ID), session, command)
+	if err != nil {
+		e.logger.WithContext(ctx).Info("express checkout not started: ", err)
+		return nil, err
+	}
+
+	return pctx, nil
+}
+
+func (e *ExpressCheckout) start(ctx context.Context, session *web.Session, command BuyNowCommand) (*process.Context, error) {
+	deliveryInfo := command.DeliveryInfo
+	if deliveryInfo.Code == "" {
+		deliveryInfo.Code = e.cartService.GetDefaultDeliveryCode()
+	}
+
+	addRequest := e.cartService.BuildAddRequest(ctx, command.MarketplaceCode, command.VariantMarketplaceCode, command.Qty, nil)
+	_, err := e.cartService.AddProduct(ctx, session, deliveryInfo.Code, addRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.cartService.UpdateDeliveryInfo(ctx, session, deliveryInfo.Code, cartDomain.CreateDeliveryInfoUpdateCommand(deliveryInfo))
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.cartService.UpdateBillingAddress(ctx, session, command.BillingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err := e.cartService.GetCartReceiverService().ViewCart(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	if command.PaymentGateway != "" {
+		paymentSelection, err := cartDomain.NewDefaultPaymentSelection(
+			command.PaymentGateway,
+			map[string]string{priceDomain.ChargeTypeMain: command.PaymentMethod},
+			*cart,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = e.cartService.UpdatePaymentSelection(ctx, session, paymentSelection)
+		if err != nil {
+			return nil, err
+		}
+
+		cart, err = e.cartService.GetCartReceiverService().ViewCart(ctx, session)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return e.coordinator.NewExpress(ctx, *cart, command.ReturnURL, command.IdempotencyKey)
+}
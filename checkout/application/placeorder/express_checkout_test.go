@@ -0,0 +1,39 @@
+package placeorder
+
+import (
+	"context"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpressCheckout_BuyNow(t *testing.T) {
+	expressCheckout := new(ExpressCheckout).Inject(nil, new(Coordinator), flamingo.NullLogger{})
+
+	tests := []struct {
+		name    string
+		command BuyNowCommand
+	}{
+		{
+			name:    "marketplace code missing",
+			command: BuyNowCommand{Qty: 1},
+		},
+		{
+			name:    "qty missing",
+			command: BuyNowCommand{MarketplaceCode: "fake_simple"},
+		},
+		{
+			name:    "negative qty",
+			command: BuyNowCommand{MarketplaceCode: "fake_simple", Qty: -1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pctx, err := expressCheckout.BuyNow(context.Background(), tt.command)
+			assert.Equal(t, ErrInvalidBuyNowCommand, err)
+			assert.Nil(t, pctx)
+		})
+	}
+}
@@ -0,0 +1,319 @@
+package placeorder
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+)
+
+const (
+	// GraphFormatDOT exports the place order graph in the DOT language of Graphviz
+	GraphFormatDOT = "dot"
+	// GraphFormatMermaid exports the place order graph as Mermaid flowchart
+	GraphFormatMermaid = "mermaid"
+)
+
+type (
+	// GraphExporter exports the place order states and their transitions, optionally with the path of a process
+	GraphExporter struct {
+		transitions *process.Transitions
+		allStates   map[string]process.State
+		startState  process.State
+		failedState process.State
+	}
+
+	// graph is the format independent representation of the export
+	graph struct {
+		start       string
+		states      []string
+		final       map[string]bool
+		transitions []process.Transition
+		// path of the process context, visited holds all states of the path except the current one
+		path        []string
+		visited     map[string]bool
+		current     string
+		failed      bool
+		failedEdge  *process.Transition
+		failedLabel string
+	}
+)
+
+// ErrUnknownGraphFormat is returned for export formats other than dot and mermaid
+var ErrUnknownGraphFormat = errors.New("unknown graph format, use dot or mermaid")
+
+var mermaidID = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// Inject dependencies
+func (e *GraphExporter) Inject(
+	transitions *process.Transitions,
+	allStates map[string]process.State,
+	dep *struct {
+		StartState  process.State `inject:"startState"`
+		FailedState process.State `inject:"failedState"`
+	},
+) *GraphExporter {
+	e.transitions = transitions
+	e.allStates = allStates
+
+	if dep != nil {
+		e.startState = dep.StartState
+		e.failedState = dep.FailedState
+	}
+
+	return e
+}
+
+// Export the place order graph in the given format, the path of the optional process context is highlighted
+func (e *GraphExporter) Export(format string, pctx *process.Context) (string, error) {
+	g := e.graph(pctx)
+
+	switch format {
+	case GraphFormatDOT:
+		return g.dot(), nil
+	case GraphFormatMermaid:
+		return g.mermaid(), nil
+	}
+
+	return "", ErrUnknownGraphFormat
+}
+
+// Path returns the states the process passed as far as they are known: the start state,
+// the states that left rollback data and the current state
+func (e *GraphExporter) Path(pctx process.Context) []string {
+	var path []string
+	add := func(name string) {
+		if name != "" && (len(path) == 0 || path[len(path)-1] != name) {
+			path = append(path, name)
+		}
+	}
+
+	if e.startState != nil {
+		add(e.startState.Name())
+	}
+
+	for _, reference := range pctx.RollbackReferences {
+		add(reference.StateName)
+	}
+
+	add(pctx.CurrentStateName)
+
+	return path
+}
+
+func (e *GraphExporter) graph(pctx *process.Context) *graph {
+	g := &graph{
+		final:   make(map[string]bool),
+		visited: make(map[string]bool),
+	}
+
+	if e.startState != nil {
+		g.start = e.startState.Name()
+	}
+
+	for name, state := range e.allStates {
+		g.states = append(g.states, name)
+		g.final[name] = state.IsFinal()
+	}
+	sort.Strings(g.states)
+
+	if e.transitions != nil {
+		g.transitions = e.transitions.Graph(e.allStates)
+	}
+
+	if pctx == nil {
+		return g
+	}
+
+	g.path = e.Path(*pctx)
+	for _, name := range g.path {
+		g.visited[name] = true
+	}
+	g.current = pctx.CurrentStateName
+	delete(g.visited, g.current)
+
+	if e.failedState != nil && g.current == e.failedState.Name() && len(g.path) > 1 {
+		g.failed = true
+		g.failedEdge = &process.Transition{From: g.path[len(g.path)-2], To: g.current}
+		if pctx.FailedReason != nil {
+			g.failedLabel = pctx.FailedReason.Reason()
+		}
+	}
+
+	return g
+}
+
+// onPath checks if the transition is a step of the process path
+func (g *graph) onPath(transition process.Transition) bool {
+	for i := 1; i < len(g.path); i++ {
+		if g.path[i-1] == transition.From && g.path[i] == transition.To {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pathGaps returns the steps of the path that are no direct transition, e.g. because the states in between left no rollback data
+func (g *graph) pathGaps() []process.Transition {
+	direct := make(map[process.Transition]bool, len(g.transitions))
+	for _, transition := range g.transitions {
+		direct[transition] = true
+	}
+
+	var gaps []process.Transition
+	for i := 1; i < len(g.path); i++ {
+		step := process.Transition{From: g.path[i-1], To: g.path[i]}
+		if !direct[step] && (g.failedEdge == nil || step != *g.failedEdge) {
+			gaps = append(gaps, step)
+		}
+	}
+
+	return gaps
+}
+
+func (g *graph) dot() string {
+	b := new(strings.Builder)
+
+	b.WriteString("digraph placeorder {\n")
+	b.WriteString("\tnode [shape=box, style=rounded];\n")
+
+	if g.start != "" {
+		b.WriteString("\t\"__start\" [shape=point];\n")
+		fmt.Fprintf(b, "\t\"__start\" -> %q;\n", g.start)
+	}
+
+	for _, name := range g.states {
+		var attributes []string
+		if g.final[name] {
+			attributes = append(attributes, "peripheries=2")
+		}
+
+		switch {
+		case name == g.current && g.failed:
+			attributes = append(attributes, `style="rounded,filled"`, `fillcolor="#f4cccc"`)
+		case name == g.current:
+			attributes = append(attributes, `style="rounded,filled"`, `fillcolor="#ffd966"`)
+		case g.visited[name]:
+			attributes = append(attributes, `style="rounded,filled"`, `fillcolor="#cce5ff"`)
+		}
+
+		if len(attributes) == 0 {
+			fmt.Fprintf(b, "\t%q;\n", name)
+			continue
+		}
+
+		fmt.Fprintf(b, "\t%q [%s];\n", name, strings.Join(attributes, ", "))
+	}
+
+	for _, transition := range g.transitions {
+		if g.onPath(transition) {
+			fmt.Fprintf(b, "\t%q -> %q [color=\"#1f77b4\", penwidth=2];\n", transition.From, transition.To)
+			continue
+		}
+
+		fmt.Fprintf(b, "\t%q -> %q;\n", transition.From, transition.To)
+	}
+
+	for _, gap := range g.pathGaps() {
+		fmt.Fprintf(b, "\t%q -> %q [color=\"#1f77b4\", penwidth=2, style=dashed];\n", gap.From, gap.To)
+	}
+
+	if g.failedEdge != nil {
+		fmt.Fprintf(b, "\t%q -> %q [color=\"#cc0000\", penwidth=2, style=dashed, label=%q];\n", g.failedEdge.From, g.failedEdge.To, g.failedLabel)
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+func (g *graph) mermaid() string {
+	b := new(strings.Builder)
+	var highlighted []string
+	links := 0
+
+	b.WriteString("graph TD\n")
+
+	if g.start != "" {
+		b.WriteString("\t__start(( ))\n")
+	}
+
+	for _, name := range g.states {
+		if g.final[name] {
+			fmt.Fprintf(b, "\t%s([%q])\n", mermaidNode(name), name)
+			continue
+		}
+
+		fmt.Fprintf(b, "\t%s[%q]\n", mermaidNode(name), name)
+	}
+
+	if g.start != "" {
+		fmt.Fprintf(b, "\t__start --> %s\n", mermaidNode(g.start))
+		links++
+	}
+
+	for _, transition := range g.transitions {
+		if g.onPath(transition) {
+			highlighted = append(highlighted, fmt.Sprint(links))
+		}
+
+		fmt.Fprintf(b, "\t%s --> %s\n", mermaidNode(transition.From), mermaidNode(transition.To))
+		links++
+	}
+
+	for _, gap := range g.pathGaps() {
+		highlighted = append(highlighted, fmt.Sprint(links))
+		fmt.Fprintf(b, "\t%s -.-> %s\n", mermaidNode(gap.From), mermaidNode(gap.To))
+		links++
+	}
+
+	if g.failedEdge != nil {
+		label := strings.ReplaceAll(g.failedLabel, `"`, "#quot;")
+		if label == "" {
+			fmt.Fprintf(b, "\t%s -.-> %s\n", mermaidNode(g.failedEdge.From), mermaidNode(g.failedEdge.To))
+		} else {
+			fmt.Fprintf(b, "\t%s -. \"%s\" .-> %s\n", mermaidNode(g.failedEdge.From), label, mermaidNode(g.failedEdge.To))
+		}
+		fmt.Fprintf(b, "\tlinkStyle %d stroke:#cc0000,stroke-width:2px\n", links)
+	}
+
+	if len(highlighted) > 0 {
+		fmt.Fprintf(b, "\tlinkStyle %s stroke:#1f77b4,stroke-width:2px\n", strings.Join(highlighted, ","))
+	}
+
+	if g.current == "" {
+		return b.String()
+	}
+
+	b.WriteString("\tclassDef visited fill:#cce5ff\n")
+	b.WriteString("\tclassDef current fill:#ffd966\n")
+	b.WriteString("\tclassDef failed fill:#f4cccc\n")
+
+	var visited []string
+	for _, name := range g.states {
+		if g.visited[name] {
+			visited = append(visited, mermaidNode(name))
+		}
+	}
+
+	if len(visited) > 0 {
+		fmt.Fprintf(b, "\tclass %s visited\n", strings.Join(visited, ","))
+	}
+
+	if g.failed {
+		fmt.Fprintf(b, "\tclass %s failed\n", mermaidNode(g.current))
+	} else {
+		fmt.Fprintf(b, "\tclass %s current\n", mermaidNode(g.current))
+	}
+
+	return b.String()
+}
+
+// mermaidNode returns a valid mermaid node id for the state name
+func mermaidNode(name string) string {
+	return "state_" + mermaidID.ReplaceAllString(name, "_")
+}
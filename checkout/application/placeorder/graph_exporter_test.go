@@ -0,0 +1,91 @@
+package placeorder_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/application/placeorder"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/states"
+)
+
+func provideGraphExporter() *placeorder.GraphExporter {
+	allStates := make(map[string]process.State)
+	for _, state := range []process.State{
+		states.New{},
+		states.PrepareCart{},
+		states.ValidateCart{},
+		states.ValidatePaymentSelection{},
+		states.CreatePayment{},
+		states.CompleteCart{},
+		states.PlaceOrder{},
+		states.ValidatePayment{},
+		states.Success{},
+		states.Failed{},
+	} {
+		allStates[state.Name()] = state
+	}
+
+	return new(placeorder.GraphExporter).Inject(
+		new(process.Transitions).Inject(nil, nil),
+		allStates,
+		&struct {
+			StartState  process.State `inject:"startState"`
+			FailedState process.State `inject:"failedState"`
+		}{
+			StartState:  states.New{},
+			FailedState: states.Failed{},
+		},
+	)
+}
+
+func TestGraphExporter_Export(t *testing.T) {
+	exporter := provideGraphExporter()
+
+	_, err := exporter.Export("svg", nil)
+	assert.Equal(t, placeorder.ErrUnknownGraphFormat, err)
+
+	dot, err := exporter.Export(placeorder.GraphFormatDOT, nil)
+	require.NoError(t, err)
+	assert.Contains(t, dot, "digraph placeorder {")
+	assert.Contains(t, dot, `"__start" -> "New";`)
+	assert.Contains(t, dot, `"ValidateCart" -> "ValidatePaymentSelection";`)
+	assert.Contains(t, dot, `"Success" [peripheries=2];`)
+	assert.NotContains(t, dot, "fillcolor")
+
+	mermaid, err := exporter.Export(placeorder.GraphFormatMermaid, nil)
+	require.NoError(t, err)
+	assert.Contains(t, mermaid, "graph TD\n")
+	assert.Contains(t, mermaid, "state_ValidateCart --> state_CompleteCart")
+	assert.Contains(t, mermaid, `state_Success(["Success"])`)
+	assert.NotContains(t, mermaid, "classDef")
+}
+
+func TestGraphExporter_ExportWithPath(t *testing.T) {
+	exporter := provideGraphExporter()
+	pctx := &process.Context{
+		CurrentStateName: states.Failed{}.Name(),
+		RollbackReferences: []process.RollbackReference{
+			{StateName: states.CreatePayment{}.Name()},
+			{StateName: states.PlaceOrder{}.Name()},
+		},
+		FailedReason: process.PaymentErrorOccurredReason{Error: "declined"},
+	}
+
+	assert.Equal(t, []string{"New", "CreatePayment", "PlaceOrder", "Failed"}, exporter.Path(*pctx))
+
+	dot, err := exporter.Export(placeorder.GraphFormatDOT, pctx)
+	require.NoError(t, err)
+	assert.Contains(t, dot, `"CreatePayment" [style="rounded,filled", fillcolor="#cce5ff"];`)
+	assert.Contains(t, dot, `"Failed" [peripheries=2, style="rounded,filled", fillcolor="#f4cccc"];`)
+	assert.Contains(t, dot, `"New" -> "CreatePayment" [color="#1f77b4", penwidth=2, style=dashed];`, "states without rollback data are a gap in the path")
+	assert.Contains(t, dot, `"PlaceOrder" -> "Failed" [color="#cc0000", penwidth=2, style=dashed, label="declined"];`)
+
+	mermaid, err := exporter.Export(placeorder.GraphFormatMermaid, pctx)
+	require.NoError(t, err)
+	assert.Contains(t, mermaid, "class state_CreatePayment,state_New,state_PlaceOrder visited")
+	assert.Contains(t, mermaid, "class state_Failed failed")
+	assert.Contains(t, mermaid, `state_PlaceOrder -. "declined" .-> state_Failed`)
+}
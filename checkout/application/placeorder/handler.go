@@ -2,31 +2,74 @@ package placeorder
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"flamingo.me/flamingo/v3/framework/web"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/notification"
 	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
 )
 
 // Handler for handling PlaceOrder related commands
 type Handler struct {
-	coordinator *Coordinator
+	coordinator       *Coordinator
+	stateChangeBroker *StateChangeBroker
+	expressCheckout   *ExpressCheckout
+	verifiers         map[string]notification.Verifier
+	// liveUpdatesRefreshInterval to load the context of subscriptions from the context store, e.g. for changes on other instances
+	liveUpdatesRefreshInterval time.Duration
 }
 
 // Inject dependencies
 func (h *Handler) Inject(
 	c *Coordinator,
+	stateChangeBroker *StateChangeBroker,
+	expressCheckout *ExpressCheckout,
+	optionals *struct {
+		Verifiers map[string]notification.Verifier `inject:",optional"`
+	},
+	cfg *struct {
+		LiveUpdatesRefreshInterval float64 `inject:"config:commerce.checkout.placeorder.liveUpdates.refreshInterval,optional"`
+	},
 ) *Handler {
 	h.coordinator = c
+	h.stateChangeBroker = stateChangeBroker
+	h.expressCheckout = expressCheckout
+
+	if cfg != nil {
+		h.liveUpdatesRefreshInterval = time.Duration(cfg.LiveUpdatesRefreshInterval * float64(time.Second))
+	}
+
+	if optionals != nil {
+		h.verifiers = optionals.Verifiers
+	}
 
 	return h
 }
 
 // StartPlaceOrder handles start place order command
 func (h *Handler) StartPlaceOrder(ctx context.Context, command StartPlaceOrderCommand) (*process.Context, error) {
-	return h.coordinator.New(ctx, command.Cart, command.ReturnURL)
+	return h.coordinator.NewWithIdempotencyKey(ctx, command.Cart, command.ReturnURL, command.IdempotencyKey)
 }
 
-// CurrentContext returns the last saved state
+// BuyNow handles the buy now command, it starts an express checkout with a secondary cart
+func (h *Handler) BuyNow(ctx context.Context, command BuyNowCommand) (*process.Context, error) {
+	return h.expressCheckout.BuyNow(ctx, command)
+}
+
+// ReplayPlaceOrder returns the context of the process started with the idempotency key, if there is one
+func (h *Handler) ReplayPlaceOrder(ctx context.Context, idempotencyKey string) (*process.Context, bool) {
+	return h.coordinator.Replay(ctx, idempotencyKey)
+}
+
+// CurrentContext returns the last saved state, a timed out process is failed before
 func (h *Handler) CurrentContext(ctx context.Context) (*process.Context, error) {
+	err := h.coordinator.ExpireTimedOutProcess(ctx)
+	if err != nil && err != ErrNoPlaceOrderProcess {
+		return nil, err
+	}
+
 	p, err := h.coordinator.LastProcess(ctx)
 	if err != nil {
 		return nil, err
@@ -36,6 +79,79 @@ func (h *Handler) CurrentContext(ctx context.Context) (*process.Context, error)
 	return &currentContext, nil
 }
 
+// SubscribePlaceOrderChanges returns the current context of the session, if there is one, followed by the state
+// changes of its processes. The channel is closed when the context is done.
+// The StateChangeBroker only knows the changes of this instance, so the stored context is additionally checked for
+// changes on other instances every liveUpdatesRefreshInterval.
+func (h *Handler) SubscribePlaceOrderChanges(ctx context.Context) (<-chan process.Context, error) {
+	session := web.SessionFromContext(ctx)
+	if session == nil {
+		return nil, errors.New("session not available to subscribe to place order changes")
+	}
+
+	// subscribe before loading the current context to not miss a change in between
+	changes, unsubscribe := h.stateChangeBroker.Subscribe(session.ID())
+
+	current, err := h.CurrentContext(ctx)
+	if err != nil && err != ErrNoPlaceOrderProcess {
+		unsubscribe()
+		return nil, err
+	}
+
+	contexts := make(chan process.Context, 1)
+	if current != nil {
+		contexts <- *current
+	}
+
+	go func() {
+		defer close(contexts)
+		defer unsubscribe()
+
+		var refresh <-chan time.Time
+		if h.liveUpdatesRefreshInterval > 0 {
+			ticker := time.NewTicker(h.liveUpdatesRefreshInterval)
+			defer ticker.Stop()
+			refresh = ticker.C
+		}
+
+		last := current
+		for {
+			var change process.Context
+			select {
+			case <-ctx.Done():
+				return
+			case changed, ok := <-changes:
+				if !ok {
+					return
+				}
+				change = changed
+			case <-refresh:
+				p, err := h.coordinator.LastProcess(ctx)
+				if err != nil || (last != nil && !stateChanged(*last, p.Context())) {
+					continue
+				}
+				change = p.Context()
+			}
+
+			select {
+			case contexts <- change:
+				last = &change
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return contexts, nil
+}
+
+// stateChanged checks if the process or its state differ, e.g. after a state switch on another instance
+func stateChanged(last process.Context, current process.Context) bool {
+	return last.UUID != current.UUID ||
+		last.CurrentStateName != current.CurrentStateName ||
+		!last.StateEnteredAt.Equal(current.StateEnteredAt)
+}
+
 // ClearPlaceOrder clears the last placed order from the context store, only possible if order in final state
 func (h *Handler) ClearPlaceOrder(ctx context.Context) error {
 	return h.coordinator.ClearLastProcess(ctx)
@@ -69,3 +185,23 @@ func (h *Handler) HasUnfinishedProcess(ctx context.Context) (bool, error) {
 func (h *Handler) CancelPlaceOrder(ctx context.Context, _ CancelPlaceOrderCommand) error {
 	return h.coordinator.Cancel(ctx)
 }
+
+// HandlePaymentNotification verifies the notification with the Verifier of the gateway and advances the process of the payment
+func (h *Handler) HandlePaymentNotification(ctx context.Context, command PaymentNotificationCommand) (*process.Context, error) {
+	verifier, found := h.verifiers[command.Gateway]
+	if !found {
+		return nil, notification.ErrUnknownGateway
+	}
+
+	paymentNotification, err := verifier.Verify(ctx, command.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	processUUID, err := h.coordinator.ProcessUUIDByCorrelationID(ctx, paymentNotification.CorrelationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.coordinator.RunByUUID(ctx, processUUID)
+}
@@ -0,0 +1,20 @@
+package placeorder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+)
+
+func TestStateChanged(t *testing.T) {
+	enteredAt := time.Now()
+	last := process.Context{UUID: "uuid", CurrentStateName: "WaitForCustomer", StateEnteredAt: enteredAt}
+
+	assert.False(t, stateChanged(last, last))
+	assert.True(t, stateChanged(last, process.Context{UUID: "other", CurrentStateName: "WaitForCustomer", StateEnteredAt: enteredAt}))
+	assert.True(t, stateChanged(last, process.Context{UUID: "uuid", CurrentStateName: "Success", StateEnteredAt: enteredAt}))
+	assert.True(t, stateChanged(last, process.Context{UUID: "uuid", CurrentStateName: "WaitForCustomer", StateEnteredAt: enteredAt.Add(time.Second)}), "state entered again")
+}
@@ -0,0 +1,110 @@
+package placeorder
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+)
+
+type (
+	// Janitor periodically fails place order processes that timed out in a waiting state, e.g. abandoned payment pages,
+	// while the server is running. With multiple instances only the one holding the janitor lock runs a check.
+	Janitor struct {
+		coordinator *Coordinator
+		locker      TryLocker
+		logger      flamingo.Logger
+		interval    time.Duration
+		mx          sync.Mutex
+		stop        chan struct{}
+	}
+)
+
+// janitorLockKey is the lock the janitors of all instances compete for
+const janitorLockKey = "checkout_placeorder_janitor"
+
+// Inject dependencies
+func (j *Janitor) Inject(
+	coordinator *Coordinator,
+	locker TryLocker,
+	logger flamingo.Logger,
+	config *struct {
+		Interval float64 `inject:"config:commerce.checkout.placeorder.timeouts.janitorInterval,optional"`
+	},
+) *Janitor {
+	j.coordinator = coordinator
+	j.locker = locker
+	j.logger = logger.WithField(flamingo.LogKeyModule, "checkout").WithField(flamingo.LogKeyCategory, "placeorder")
+	j.interval = time.Minute
+
+	if config != nil && config.Interval > 0 {
+		j.interval = time.Duration(config.Interval * float64(time.Second))
+	}
+
+	return j
+}
+
+// Notify starts the janitor on server start and stops it on shutdown
+func (j *Janitor) Notify(_ context.Context, event flamingo.Event) {
+	switch event.(type) {
+	case *flamingo.ServerStartEvent:
+		j.start()
+	case *flamingo.ServerShutdownEvent:
+		j.shutdown()
+	}
+}
+
+func (j *Janitor) start() {
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	if j.stop != nil || len(j.coordinator.stateTimeouts) == 0 {
+		return
+	}
+
+	j.stop = make(chan struct{})
+	go func(stop chan struct{}) {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				j.run(context.Background())
+			case <-stop:
+				return
+			}
+		}
+	}(j.stop)
+}
+
+// run expires the timed out processes unless the janitor of another instance is currently doing it
+func (j *Janitor) run(ctx context.Context) {
+	unlock, err := j.locker.TryLock(ctx, janitorLockKey, j.interval)
+	if err != nil {
+		if err != ErrLockTaken {
+			j.logger.Error("janitor lock not acquired: ", err)
+		}
+		return
+	}
+	defer func() {
+		_ = unlock()
+	}()
+
+	if err := j.coordinator.ExpireTimedOutProcesses(ctx); err != nil {
+		j.logger.Error("expiring timed out place order processes failed: ", err)
+	}
+}
+
+func (j *Janitor) shutdown() {
+	j.mx.Lock()
+	defer j.mx.Unlock()
+
+	if j.stop == nil {
+		return
+	}
+
+	close(j.stop)
+	j.stop = nil
+}
@@ -0,0 +1,101 @@
+package placeorder
+
+import (
+	"context"
+	"sync"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+)
+
+type (
+	// StateChangeBroker forwards the state changes of place order processes to the subscribers of the session,
+	// e.g. GraphQL subscriptions or server sent events. The subscriptions are kept in memory per instance.
+	StateChangeBroker struct {
+		mx            sync.Mutex
+		subscriptions map[string]map[*stateChangeSubscription]struct{}
+	}
+
+	stateChangeSubscription struct {
+		changes chan process.Context
+	}
+)
+
+// stateChangeBufferSize of a subscription, the oldest change is dropped for slow subscribers
+const stateChangeBufferSize = 10
+
+// Notify forwards the process.StateChangedEvent to the subscribers of the session
+func (b *StateChangeBroker) Notify(ctx context.Context, event flamingo.Event) {
+	stateChangedEvent, ok := event.(*process.StateChangedEvent)
+	if !ok {
+		return
+	}
+
+	session := web.SessionFromContext(ctx)
+	if session == nil {
+		return
+	}
+
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	for subscription := range b.subscriptions[session.ID()] {
+		subscription.send(stateChangedEvent.Context)
+	}
+}
+
+// Subscribe returns the state changes of the processes of the session, the returned func has to be called
+// to unsubscribe, it closes the channel
+func (b *StateChangeBroker) Subscribe(sessionID string) (<-chan process.Context, func()) {
+	subscription := &stateChangeSubscription{changes: make(chan process.Context, stateChangeBufferSize)}
+
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	if b.subscriptions == nil {
+		b.subscriptions = make(map[string]map[*stateChangeSubscription]struct{})
+	}
+
+	if b.subscriptions[sessionID] == nil {
+		b.subscriptions[sessionID] = make(map[*stateChangeSubscription]struct{})
+	}
+
+	b.subscriptions[sessionID][subscription] = struct{}{}
+
+	var once sync.Once
+	return subscription.changes, func() {
+		once.Do(func() {
+			b.unsubscribe(sessionID, subscription)
+		})
+	}
+}
+
+func (b *StateChangeBroker) unsubscribe(sessionID string, subscription *stateChangeSubscription) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	delete(b.subscriptions[sessionID], subscription)
+	if len(b.subscriptions[sessionID]) == 0 {
+		delete(b.subscriptions, sessionID)
+	}
+
+	close(subscription.changes)
+}
+
+// send never blocks the process, the oldest change is dropped if the buffer is full
+func (s *stateChangeSubscription) send(pctx process.Context) {
+	for {
+		select {
+		case s.changes <- pctx:
+			return
+		default:
+		}
+
+		select {
+		case <-s.changes:
+		default:
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package placeorder
+
+import (
+	"context"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+)
+
+func TestStateChangeBroker_Notify(t *testing.T) {
+	session := web.EmptySession()
+	ctx := web.ContextWithSession(context.Background(), session)
+
+	t.Run("forwards state changes of the session", func(t *testing.T) {
+		broker := new(StateChangeBroker)
+		changes, unsubscribe := broker.Subscribe(session.ID())
+		defer unsubscribe()
+
+		broker.Notify(ctx, &flamingo.ServerStartEvent{})
+		broker.Notify(context.Background(), &process.StateChangedEvent{Context: process.Context{UUID: "no session"}})
+		broker.Notify(ctx, &process.StateChangedEvent{FromState: "New", Context: process.Context{UUID: "uuid", CurrentStateName: "PrepareCart"}})
+
+		require.Len(t, changes, 1)
+		assert.Equal(t, process.Context{UUID: "uuid", CurrentStateName: "PrepareCart"}, <-changes)
+	})
+
+	t.Run("slow subscribers lose the oldest changes", func(t *testing.T) {
+		broker := new(StateChangeBroker)
+		changes, unsubscribe := broker.Subscribe(session.ID())
+		defer unsubscribe()
+
+		for i := 0; i <= stateChangeBufferSize; i++ {
+			broker.Notify(ctx, &process.StateChangedEvent{Context: process.Context{RetryCount: i}})
+		}
+
+		require.Len(t, changes, stateChangeBufferSize)
+		assert.Equal(t, 1, (<-changes).RetryCount)
+	})
+
+	t.Run("unsubscribe closes the changes", func(t *testing.T) {
+		broker := new(StateChangeBroker)
+		changes, unsubscribe := broker.Subscribe(session.ID())
+
+		unsubscribe()
+		unsubscribe()
+		broker.Notify(ctx, &process.StateChangedEvent{})
+
+		_, ok := <-changes
+		assert.False(t, ok)
+		assert.Empty(t, broker.subscriptions)
+	})
+}
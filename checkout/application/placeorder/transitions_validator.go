@@ -0,0 +1,85 @@
+package placeorder
+
+import (
+	"context"
+	"fmt"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+)
+
+// TransitionsValidator validates the place order state transitions on server start
+type TransitionsValidator struct {
+	transitions *process.Transitions
+	allStates   map[string]process.State
+	startState  process.State
+	failedState process.State
+	logger      flamingo.Logger
+	strict      bool
+}
+
+// Inject dependencies
+func (v *TransitionsValidator) Inject(
+	transitions *process.Transitions,
+	allStates map[string]process.State,
+	logger flamingo.Logger,
+	dep *struct {
+		StartState  process.State `inject:"startState"`
+		FailedState process.State `inject:"failedState"`
+	},
+	cfg *struct {
+		Strict bool `inject:"config:commerce.checkout.placeorder.transitions.strictValidation,optional"`
+	},
+) *TransitionsValidator {
+	v.transitions = transitions
+	v.allStates = allStates
+	v.logger = logger.
+		WithField(flamingo.LogKeyModule, "checkout").
+		WithField(flamingo.LogKeyCategory, "process")
+
+	if dep != nil {
+		v.startState = dep.StartState
+		v.failedState = dep.FailedState
+	}
+
+	if cfg != nil {
+		v.strict = cfg.Strict
+	}
+
+	return v
+}
+
+// Notify validates the transitions on server start, invalid transitions are logged as warning
+// and only stop the server if strict validation is enabled
+func (v *TransitionsValidator) Notify(_ context.Context, event flamingo.Event) {
+	if _, ok := event.(*flamingo.ServerStartEvent); !ok {
+		return
+	}
+
+	if err := v.Validate(); err != nil {
+		if v.strict {
+			panic(err)
+		}
+
+		v.logger.Warn(err)
+	}
+}
+
+// Validate the transitions of all bound states
+func (v *TransitionsValidator) Validate() error {
+	if v.startState == nil {
+		return fmt.Errorf("invalid place order transitions: no start state given")
+	}
+
+	failedState := ""
+	if v.failedState != nil {
+		failedState = v.failedState.Name()
+	}
+
+	if err := v.transitions.Validate(v.allStates, v.startState.Name(), failedState); err != nil {
+		return fmt.Errorf("invalid place order transitions: %w", err)
+	}
+
+	return nil
+}
@@ -30,7 +30,8 @@ func PaymentValidator(ctx context.Context, p *process.Process, paymentService *a
 	flowStatus, err := gateway.FlowStatus(ctx, &cart, p.Context().UUID)
 	if err != nil {
 		return process.RunResult{
-			Failed: process.ErrorOccurredReason{Error: err.Error()},
+			Failed:    process.ErrorOccurredReason{Error: err.Error()},
+			Transient: process.IsTransientError(err),
 		}
 	}
 
@@ -0,0 +1,100 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+)
+
+const (
+	// StatusPending is the status of a request that waits for the decision of an approver
+	StatusPending = "pending"
+	// StatusApproved is the status of an approved request, the place order process continues
+	StatusApproved = "approved"
+	// StatusRejected is the status of a rejected request, the place order process fails
+	StatusRejected = "rejected"
+	// StatusCancelled is the status of a request whose place order process has been cancelled or failed
+	StatusCancelled = "cancelled"
+)
+
+type (
+	// Policy is the secondary port that decides if an order needs to be approved before it can be placed
+	Policy interface {
+		// Check returns the approval requirement of the cart
+		Check(ctx context.Context, cart cart.Cart) (Requirement, error)
+	}
+
+	// Store is the secondary port that persists the approval requests
+	Store interface {
+		// Save adds or replaces the request
+		Save(ctx context.Context, request Request) error
+		// Get returns the request or ErrNotFound
+		Get(ctx context.Context, id string) (*Request, error)
+	}
+
+	// Requirement returned by the Policy
+	Requirement struct {
+		Required bool
+		// Reason why the approval is required, e.g. the exceeded budget
+		Reason string
+		// Approvers that may decide the request, a required approval without approvers is an error
+		Approvers []string
+	}
+
+	// Request for the approval of an order, it contains a snapshot of the cart at the time of the request
+	Request struct {
+		ID          string
+		ProcessUUID string
+		Cart        cart.Cart
+		Reason      string
+		Approvers   []string
+		Status      string
+		RequestedAt time.Time
+		DecidedBy   string
+		DecidedAt   time.Time
+		Comment     string
+	}
+
+	// RequestedEvent is dispatched when an order needs approval, subscribe to it to notify the approvers
+	RequestedEvent struct {
+		Request Request
+	}
+
+	// DecidedEvent is dispatched when an approver approved or rejected the request
+	DecidedEvent struct {
+		Request Request
+	}
+)
+
+var (
+	// ErrNotFound is returned by the Store for unknown requests
+	ErrNotFound = errors.New("approval request not found")
+	// ErrAlreadyDecided is returned if the request is not pending anymore
+	ErrAlreadyDecided = errors.New("approval request already decided")
+	// ErrNotAllowed is returned if the approver may not decide the request
+	ErrNotAllowed = errors.New("approver not allowed to decide the approval request")
+	// ErrNoApprovers is returned if the policy requires an approval but names no approvers
+	ErrNoApprovers = errors.New("approval required but no approvers given")
+)
+
+// IsPending checks if the request waits for a decision
+func (r Request) IsPending() bool {
+	return r.Status == StatusPending
+}
+
+// MayBeDecidedBy checks if the approver is one of the approvers of the request, buyers can never approve their own orders
+func (r Request) MayBeDecidedBy(approver string) bool {
+	if approver == "" || approver == r.Cart.AuthenticatedUserID {
+		return false
+	}
+
+	for _, allowed := range r.Approvers {
+		if allowed == approver {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,22 @@
+package approval_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/approval"
+)
+
+func TestRequest_MayBeDecidedBy(t *testing.T) {
+	request := approval.Request{Cart: cart.Cart{AuthenticatedUserID: "buyer"}, Approvers: []string{"manager", "buyer"}}
+
+	assert.True(t, request.MayBeDecidedBy("manager"))
+	assert.False(t, request.MayBeDecidedBy("buyer"), "buyers can never approve their own orders")
+	assert.False(t, request.MayBeDecidedBy("colleague"))
+	assert.False(t, request.MayBeDecidedBy(""))
+
+	request.Approvers = nil
+	assert.False(t, request.MayBeDecidedBy("manager"), "requests without approvers are denied")
+}
@@ -0,0 +1,119 @@
+package approval
+
+import (
+	"context"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/google/uuid"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+)
+
+type (
+	// Service handles the approval requests of orders
+	Service struct {
+		store       Store
+		eventRouter flamingo.EventRouter
+	}
+)
+
+// Inject dependencies
+func (s *Service) Inject(
+	store Store,
+	eventRouter flamingo.EventRouter,
+) *Service {
+	s.store = store
+	s.eventRouter = eventRouter
+
+	return s
+}
+
+// Request stores a snapshot of the cart as pending request and notifies the approvers with the RequestedEvent,
+// ErrNoApprovers is returned if the requirement names no approvers
+func (s *Service) Request(ctx context.Context, processUUID string, cart cart.Cart, requirement Requirement) (*Request, error) {
+	if len(requirement.Approvers) == 0 {
+		return nil, ErrNoApprovers
+	}
+
+	request := Request{
+		ID:          uuid.New().String(),
+		ProcessUUID: processUUID,
+		Cart:        cart,
+		Reason:      requirement.Reason,
+		Approvers:   requirement.Approvers,
+		Status:      StatusPending,
+		RequestedAt: time.Now(),
+	}
+
+	if err := s.store.Save(ctx, request); err != nil {
+		return nil, err
+	}
+
+	if s.eventRouter != nil {
+		s.eventRouter.Dispatch(ctx, &RequestedEvent{Request: request})
+	}
+
+	return &request, nil
+}
+
+// Get returns the request
+func (s *Service) Get(ctx context.Context, id string) (*Request, error) {
+	return s.store.Get(ctx, id)
+}
+
+// Approve the pending request, the place order process continues on its next run
+func (s *Service) Approve(ctx context.Context, id string, approver string, comment string) (*Request, error) {
+	return s.decide(ctx, id, approver, comment, StatusApproved)
+}
+
+// Reject the pending request, the place order process fails on its next run
+func (s *Service) Reject(ctx context.Context, id string, approver string, comment string) (*Request, error) {
+	return s.decide(ctx, id, approver, comment, StatusRejected)
+}
+
+// Cancel the request if it is still pending
+func (s *Service) Cancel(ctx context.Context, id string) error {
+	request, err := s.store.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !request.IsPending() {
+		return nil
+	}
+
+	request.Status = StatusCancelled
+
+	return s.store.Save(ctx, *request)
+}
+
+func (s *Service) decide(ctx context.Context, id string, approver string, comment string, status string) (*Request, error) {
+	request, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !request.IsPending() {
+		return nil, ErrAlreadyDecided
+	}
+
+	if !request.MayBeDecidedBy(approver) {
+		return nil, ErrNotAllowed
+	}
+
+	request.Status = status
+	request.DecidedBy = approver
+	request.DecidedAt = time.Now()
+	request.Comment = comment
+
+	if err := s.store.Save(ctx, *request); err != nil {
+		return nil, err
+	}
+
+	if s.eventRouter != nil {
+		s.eventRouter.Dispatch(ctx, &DecidedEvent{Request: *request})
+	}
+
+	return request, nil
+}
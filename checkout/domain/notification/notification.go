@@ -0,0 +1,35 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+type (
+	// Verifier is the secondary port of a payment gateway that checks the authenticity of the server-to-server
+	// notifications of its payment provider, bind it with the gateway code:
+	// injector.BindMap(new(notification.Verifier), "gateway code").To(...)
+	Verifier interface {
+		// Verify the notification request and extract the notification, return ErrInvalid for unauthentic or malformed requests.
+		// The verifier may persist the payment status, so that the FlowStatus of the gateway reflects the notification.
+		Verify(ctx context.Context, request *http.Request) (*Notification, error)
+	}
+
+	// Notification of a payment provider about a payment
+	Notification struct {
+		Gateway string
+		// CorrelationID of the payment, the place order process passes its UUID as correlation id to the gateway,
+		// gateways that use other references register them with the Coordinator
+		CorrelationID string
+		// Status of the payment reported by the provider, informational only
+		Status string
+	}
+)
+
+var (
+	// ErrInvalid is returned by the Verifier for unauthentic or malformed notifications
+	ErrInvalid = errors.New("invalid payment notification")
+	// ErrUnknownGateway is returned for notifications of gateways without Verifier
+	ErrUnknownGateway = errors.New("no payment notification verifier for gateway")
+)
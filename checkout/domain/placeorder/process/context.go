@@ -3,6 +3,7 @@ package process
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
 	"flamingo.me/flamingo-commerce/v3/checkout/application"
@@ -12,6 +13,8 @@ type (
 	// Context contains information (state etc) about a place order process
 	Context struct {
 		UUID               string
+		StartedAt          time.Time
+		StateEnteredAt     time.Time
 		CurrentStateName   string
 		CurrentStateData   StateData
 		PlaceOrderInfo     *application.PlaceOrderInfo
@@ -19,6 +22,22 @@ type (
 		ReturnURL          *url.URL
 		RollbackReferences []RollbackReference
 		FailedReason       FailedReason
+		// PendingStateNames are the remaining states of a transition interrupted by inserted states
+		PendingStateNames []string
+		// PendingStateData is the state data for the target of the interrupted transition
+		PendingStateData StateData
+		// RetryCount of the current state after transient failures
+		RetryCount int
+		// RetryAt is the earliest time of the next retry, zero if no retry is pending
+		RetryAt time.Time
+		// ClientIP and ClientUserAgent of the request that started the process
+		ClientIP        string
+		ClientUserAgent string
+		// ReviewRequired is set by the risk assessment, the placed orders need a manual review
+		ReviewRequired bool
+		RiskScore      float64
+		// ExpressCartID is the cart of an express checkout, the states work on it instead of the cart of the session
+		ExpressCartID string
 	}
 	// StateData holding state relevant data
 	StateData interface{}
@@ -29,4 +48,25 @@ type (
 		Get(ctx context.Context, key string) (Context, bool)
 		Delete(ctx context.Context, key string) error
 	}
+
+	// ExpiringContextStore is an optional interface for context stores that remove contexts after the given expiration
+	ExpiringContextStore interface {
+		StoreWithExpiration(ctx context.Context, key string, placeOrderContext Context, expiration time.Duration) error
+	}
+
+	// ReferenceStore maps references to keys, e.g. payment correlation ids to process UUIDs and
+	// process UUIDs to sessions, to advance processes without the session of the customer
+	ReferenceStore interface {
+		StoreReference(ctx context.Context, reference string, key string, expiration time.Duration) error
+		// GetReference returns the key of the reference, found is false for unknown or expired references
+		GetReference(ctx context.Context, reference string) (key string, found bool, err error)
+	}
+
+	// DeadlineContextStore is an optional interface for context stores that index contexts by a deadline, e.g. to find timed out processes
+	DeadlineContextStore interface {
+		// StoreWithDeadline stores a given context and indexes its key by the deadline until it is stored again or deleted
+		StoreWithDeadline(ctx context.Context, key string, placeOrderContext Context, deadline time.Time) error
+		// KeysDueBefore returns the keys of all indexed contexts with a deadline before the given time
+		KeysDueBefore(ctx context.Context, before time.Time) ([]string, error)
+	}
 )
@@ -0,0 +1,106 @@
+package process
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"flamingo.me/flamingo/v3/framework/opencensus"
+)
+
+var (
+	// stateDuration measures the time spent per state
+	stateDuration = stats.Int64("flamingo-commerce/checkout/placeorder/state_duration", "Time spent in a state", stats.UnitMilliseconds)
+	// processDuration measures the time from the start of the process until it reached a final state
+	processDuration = stats.Int64("flamingo-commerce/checkout/placeorder/duration", "Time from the start of the process until a final state", stats.UnitMilliseconds)
+	// stateTransition counts the transitions between the states
+	stateTransition = stats.Int64("flamingo-commerce/checkout/placeorder/transition_count", "Counts the transitions between states", stats.UnitDimensionless)
+	// rollbackCount counts the rollbacks of states
+	rollbackCount = stats.Int64("flamingo-commerce/checkout/placeorder/rollback_count", "Counts the rollbacks of states", stats.UnitDimensionless)
+	// rollbackFailedCount counts the failed rollbacks of states
+	rollbackFailedCount = stats.Int64("flamingo-commerce/checkout/placeorder/rollback_failed_count", "Counts the failed rollbacks of states", stats.UnitDimensionless)
+	// failedCount counts the failed processes per failed reason
+	failedCount = stats.Int64("flamingo-commerce/checkout/placeorder/failed_count", "Counts the failed processes per failed reason", stats.UnitDimensionless)
+
+	keyFromState, _ = tag.NewKey("from_state")
+	keyToState, _   = tag.NewKey("to_state")
+	keyReason, _    = tag.NewKey("reason")
+
+	// durationDistribution in milliseconds from 10ms up to one day
+	durationDistribution = view.Distribution(10, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000, 60000, 300000, 900000, 3600000, 86400000)
+)
+
+func init() {
+	views := []struct {
+		name        string
+		measure     stats.Measure
+		aggregation *view.Aggregation
+		keys        []tag.Key
+	}{
+		{name: "flamingo-commerce/checkout/placeorder/state_duration", measure: stateDuration, aggregation: durationDistribution, keys: []tag.Key{keyState}},
+		{name: "flamingo-commerce/checkout/placeorder/duration", measure: processDuration, aggregation: durationDistribution, keys: []tag.Key{keyState}},
+		{name: "flamingo-commerce/checkout/placeorder/transition_count", measure: stateTransition, aggregation: view.Count(), keys: []tag.Key{keyFromState, keyToState}},
+		{name: "flamingo-commerce/checkout/placeorder/rollback_count", measure: rollbackCount, aggregation: view.Count(), keys: []tag.Key{keyState}},
+		{name: "flamingo-commerce/checkout/placeorder/rollback_failed_count", measure: rollbackFailedCount, aggregation: view.Count(), keys: []tag.Key{keyState}},
+		{name: "flamingo-commerce/checkout/placeorder/failed_count", measure: failedCount, aggregation: view.Count(), keys: []tag.Key{keyState, keyReason}},
+	}
+
+	for _, v := range views {
+		if err := opencensus.View(v.name, v.measure, v.aggregation, v.keys...); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ReasonName returns the type name of the failed reason, e.g. PaymentErrorOccurredReason, used as metric tag
+func ReasonName(reason FailedReason) string {
+	if reason == nil {
+		return ""
+	}
+
+	t := reflect.TypeOf(reason)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
+
+// recordStateChange records the time spent in the state that is left, the transition
+// and the duration of the process if the new state is final
+func (p *Process) recordStateChange(to string) {
+	from := p.context.CurrentStateName
+	now := time.Now()
+	ctx, _ := tag.New(context.Background(), tag.Upsert(opencensus.KeyArea, p.area))
+
+	fromCtx, _ := tag.New(ctx, tag.Upsert(keyState, from))
+	if !p.context.StateEnteredAt.IsZero() {
+		stats.Record(fromCtx, stateDuration.M(now.Sub(p.context.StateEnteredAt).Milliseconds()))
+	}
+
+	transitionCtx, _ := tag.New(ctx, tag.Upsert(keyFromState, from), tag.Upsert(keyToState, to))
+	stats.Record(transitionCtx, stateTransition.M(1))
+
+	if state, found := p.allStates[to]; found && state.IsFinal() && !p.context.StartedAt.IsZero() {
+		toCtx, _ := tag.New(ctx, tag.Upsert(keyState, to))
+		stats.Record(toCtx, processDuration.M(now.Sub(p.context.StartedAt).Milliseconds()))
+	}
+}
+
+func (p *Process) recordRollback(ctx context.Context, stateName string, err error) {
+	ctx, _ = tag.New(ctx, tag.Upsert(opencensus.KeyArea, p.area), tag.Upsert(keyState, stateName))
+	stats.Record(ctx, rollbackCount.M(1))
+
+	if err != nil {
+		stats.Record(ctx, rollbackFailedCount.M(1))
+	}
+}
+
+func (p *Process) recordFailed(ctx context.Context, reason FailedReason) {
+	ctx, _ = tag.New(ctx, tag.Upsert(opencensus.KeyArea, p.area), tag.Upsert(keyState, p.context.CurrentStateName), tag.Upsert(keyReason, ReasonName(reason)))
+	stats.Record(ctx, failedCount.M(1))
+}
@@ -0,0 +1,16 @@
+package process_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+)
+
+func TestReasonName(t *testing.T) {
+	assert.Equal(t, "", process.ReasonName(nil))
+	assert.Equal(t, "PaymentErrorOccurredReason", process.ReasonName(process.PaymentErrorOccurredReason{Error: "declined"}))
+	assert.Equal(t, "CartValidationErrorReason", process.ReasonName(&process.CartValidationErrorReason{}))
+	assert.Equal(t, "TimeoutReason", process.ReasonName(process.TimeoutReason{State: "WaitForCustomer"}))
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -13,9 +14,11 @@ import (
 	"go.opencensus.io/stats/view"
 	"go.opencensus.io/tag"
 
+	"flamingo.me/flamingo/v3/framework/config"
 	"flamingo.me/flamingo/v3/framework/flamingo"
 	"flamingo.me/flamingo/v3/framework/opencensus"
 
+	cartApplication "flamingo.me/flamingo-commerce/v3/cart/application"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
 	"flamingo.me/flamingo-commerce/v3/checkout/application"
@@ -29,9 +32,13 @@ type (
 	Process struct {
 		context     Context
 		allStates   map[string]State
+		transitions *Transitions
 		failedState State
 		logger      flamingo.Logger
+		eventRouter flamingo.EventRouter
 		area        string
+		// retryPolicies per state name
+		retryPolicies map[string]RetryPolicy
 	}
 
 	// Factory use to get Process instance
@@ -75,6 +82,36 @@ type (
 	CartValidationErrorReason struct {
 		ValidationResult validation.Result
 	}
+
+	// DeliveryValidationErrorReason contains the DeliveryValidationResult of the incomplete deliveries
+	DeliveryValidationErrorReason struct {
+		ValidationResult validation.DeliveryValidationResult
+	}
+
+	// ApprovalRejectedReason is used when an approver rejected the order
+	ApprovalRejectedReason struct {
+		ApprovalID string
+		Comment    string
+	}
+
+	// RiskRejectedReason is used when the risk assessment rejected the order
+	RiskRejectedReason struct {
+		Score   float64
+		Reasons []string
+	}
+
+	// StateChangedEvent is dispatched after a run or a failure switched the state of the process, e.g. to push the
+	// new state to the customer. The context is not yet stored when the event is dispatched.
+	StateChangedEvent struct {
+		FromState string
+		Context   Context
+	}
+
+	// TimeoutReason is used when the process stayed longer than allowed in a state, e.g. an abandoned payment page
+	TimeoutReason struct {
+		State   string
+		Timeout time.Duration
+	}
 )
 
 var (
@@ -90,7 +127,11 @@ func init() {
 	gob.Register(PaymentErrorOccurredReason{})
 	gob.Register(PaymentCanceledByCustomerReason{})
 	gob.Register(CartValidationErrorReason{})
+	gob.Register(DeliveryValidationErrorReason{})
 	gob.Register(CanceledByCustomerReason{})
+	gob.Register(ApprovalRejectedReason{})
+	gob.Register(TimeoutReason{})
+	gob.Register(RiskRejectedReason{})
 
 	if err := opencensus.View("flamingo-commerce/checkout/placeorder/state_run_count", processedState, view.Count(), keyState); err != nil {
 		panic(err)
@@ -125,6 +166,30 @@ func (e CartValidationErrorReason) Reason() string {
 	return "Cart invalid"
 }
 
+// Reason for failing
+func (e DeliveryValidationErrorReason) Reason() string {
+	return "Deliveries incomplete"
+}
+
+// Reason for failing
+func (e ApprovalRejectedReason) Reason() string {
+	if e.Comment == "" {
+		return "Order rejected by approver"
+	}
+
+	return "Order rejected by approver: " + e.Comment
+}
+
+// Reason for failing
+func (e RiskRejectedReason) Reason() string {
+	return "Order rejected by risk assessment"
+}
+
+// Reason for failing
+func (e TimeoutReason) Reason() string {
+	return fmt.Sprintf("Place order timed out in state %s after %s", e.State, e.Timeout)
+}
+
 // Inject dependencies
 func (f *Factory) Inject(
 	provider Provider,
@@ -148,8 +213,11 @@ func (f *Factory) New(returnURL *url.URL, cart cart.Cart) (*Process, error) {
 	}
 	p := f.provider()
 	p.failedState = f.failedState
+	now := time.Now()
 	p.context = Context{
 		UUID:             uuid.New().String(),
+		StartedAt:        now,
+		StateEnteredAt:   now,
 		CurrentStateName: f.startState.Name(),
 		Cart:             cart,
 		ReturnURL:        returnURL,
@@ -170,34 +238,65 @@ func (f *Factory) NewFromProcessContext(pctx Context) (*Process, error) {
 // Inject dependencies
 func (p *Process) Inject(
 	allStates map[string]State,
+	transitions *Transitions,
 	logger flamingo.Logger,
+	eventRouter flamingo.EventRouter,
 	cfg *struct {
-		Area string `inject:"config:area"`
+		Area  string     `inject:"config:area"`
+		Retry config.Map `inject:"config:commerce.checkout.placeorder.retry,optional"`
 	},
 ) *Process {
 	p.allStates = allStates
+	p.transitions = transitions
+	p.eventRouter = eventRouter
 	p.logger = logger.
 		WithField(flamingo.LogKeyModule, "checkout").
 		WithField(flamingo.LogKeyCategory, "process")
 
 	if cfg != nil {
 		p.area = cfg.Area
+
+		var retry map[string]struct {
+			MaxRetries int     `json:"maxRetries"`
+			Backoff    float64 `json:"backoff"`
+			MaxBackoff float64 `json:"maxBackoff"`
+		}
+		if err := cfg.Retry.MapInto(&retry); err != nil {
+			panic(fmt.Errorf("invalid config commerce.checkout.placeorder.retry: %w", err))
+		}
+
+		p.retryPolicies = make(map[string]RetryPolicy, len(retry))
+		for state, policy := range retry {
+			p.retryPolicies[state] = RetryPolicy{
+				MaxRetries: policy.MaxRetries,
+				Backoff:    time.Duration(policy.Backoff * float64(time.Second)),
+				MaxBackoff: time.Duration(policy.MaxBackoff * float64(time.Second)),
+			}
+		}
 	}
 
 	return p
 }
 
-// Run triggers run on current state
+// Run triggers run on current state, a pending retry is not run before its backoff passed
 func (p *Process) Run(ctx context.Context) {
+	ctx = p.cartContext(ctx)
+	defer p.dispatchStateChanged(ctx, p.context.CurrentStateName)
+
 	currentState, err := p.CurrentState()
 	if err != nil {
-		p.Failed(ctx, ErrorOccurredReason{Error: err.Error()})
+		p.fail(ctx, ErrorOccurredReason{Error: err.Error()})
+		return
+	}
+
+	if p.RetryPending() && time.Now().Before(p.context.RetryAt) {
 		return
 	}
 
 	censusCtx, _ := tag.New(ctx, tag.Upsert(opencensus.KeyArea, p.area), tag.Upsert(keyState, currentState.Name()))
 	stats.Record(censusCtx, processedState.M(1))
 
+	beforeRun := p.context
 	runResult := currentState.Run(ctx, p)
 	if runResult.RollbackData != nil {
 		p.context.RollbackReferences = append(p.context.RollbackReferences, RollbackReference{
@@ -206,10 +305,42 @@ func (p *Process) Run(ctx context.Context) {
 		})
 	}
 
-	if runResult.Failed != nil {
-		stats.Record(censusCtx, failedStateTransition.M(1))
-		p.Failed(ctx, runResult.Failed)
+	if runResult.Failed == nil {
+		p.context.RetryCount = 0
+		p.context.RetryAt = time.Time{}
+		return
+	}
+
+	if runResult.Transient && p.scheduleRetry(currentState.Name(), beforeRun) {
+		p.logger.WithContext(ctx).Info(fmt.Sprintf("state %q failed transient, retry %d at %s: %s", currentState.Name(), p.context.RetryCount, p.context.RetryAt.Format(time.RFC3339Nano), runResult.Failed.Reason()))
+		return
+	}
+
+	stats.Record(censusCtx, failedStateTransition.M(1))
+	p.fail(ctx, runResult.Failed)
+}
+
+// RetryPending checks if the current state is retried after a transient failure
+func (p *Process) RetryPending() bool {
+	return !p.context.RetryAt.IsZero()
+}
+
+// scheduleRetry of the state if its retry policy allows another retry, the state switches of the failed run are reverted
+func (p *Process) scheduleRetry(stateName string, beforeRun Context) bool {
+	policy, found := p.retryPolicies[stateName]
+	if !found || beforeRun.RetryCount >= policy.MaxRetries {
+		return false
 	}
+
+	p.context.CurrentStateName = beforeRun.CurrentStateName
+	p.context.CurrentStateData = beforeRun.CurrentStateData
+	p.context.PendingStateNames = beforeRun.PendingStateNames
+	p.context.PendingStateData = beforeRun.PendingStateData
+	p.context.StateEnteredAt = beforeRun.StateEnteredAt
+	p.context.RetryCount = beforeRun.RetryCount + 1
+	p.context.RetryAt = time.Now().Add(policy.BackoffFor(p.context.RetryCount))
+
+	return true
 }
 
 // CurrentState of the process context
@@ -231,6 +362,7 @@ func (p *Process) rollback(ctx context.Context) error {
 		}
 
 		err := state.Rollback(ctx, rollbackRef.Data)
+		p.recordRollback(ctx, state.Name(), err)
 		if _, ok := err.(*FatalRollbackError); ok {
 			return err
 		}
@@ -248,29 +380,125 @@ func (p *Process) Context() Context {
 	return p.context
 }
 
-// UpdateState updates the current state in the context and its related state data
+// UpdateState updates the current state in the context and its related state data,
+// states inserted into the transition are run first
 func (p *Process) UpdateState(s string, stateData StateData) {
+	if s != p.context.CurrentStateName {
+		p.context.PendingStateNames = nil
+		p.context.PendingStateData = nil
+
+		if p.transitions != nil && (p.failedState == nil || s != p.failedState.Name()) {
+			route := p.transitions.Route(p.allStates, p.context.CurrentStateName, s)
+			if len(route) > 1 {
+				p.context.PendingStateNames = route[1:]
+				p.context.PendingStateData = stateData
+				s, stateData = route[0], nil
+			}
+		}
+
+		p.recordStateChange(s)
+		p.context.StateEnteredAt = time.Now()
+		p.context.RetryCount = 0
+		p.context.RetryAt = time.Time{}
+	}
+
 	p.context.CurrentStateName = s
 	p.context.CurrentStateData = stateData
 }
 
+// Continue switches to the next state of the transition interrupted by the current inserted state
+func (p *Process) Continue() error {
+	if len(p.context.PendingStateNames) == 0 {
+		return ErrNoPendingTransition
+	}
+
+	p.recordStateChange(p.context.PendingStateNames[0])
+	p.context.CurrentStateName = p.context.PendingStateNames[0]
+	p.context.CurrentStateData = nil
+	p.context.StateEnteredAt = time.Now()
+	p.context.RetryCount = 0
+	p.context.RetryAt = time.Time{}
+	p.context.PendingStateNames = p.context.PendingStateNames[1:]
+
+	if len(p.context.PendingStateNames) == 0 {
+		p.context.CurrentStateData = p.context.PendingStateData
+		p.context.PendingStateNames = nil
+		p.context.PendingStateData = nil
+	}
+
+	return nil
+}
+
 // UpdateCart updates the cart in the current state context
 func (p *Process) UpdateCart(cartToStore cart.Cart) {
 	p.context.Cart = cartToStore
 }
 
-// UpdateOrderInfo updates the order infos of the current context
+// UseExpressCart lets the states work on the express cart instead of the cart of the session
+func (p *Process) UseExpressCart(expressCartID string) {
+	p.context.ExpressCartID = expressCartID
+}
+
+// cartContext returns the context for the states, the express cart of an express checkout replaces the cart of the session
+func (p *Process) cartContext(ctx context.Context) context.Context {
+	if p.context.ExpressCartID == "" {
+		return ctx
+	}
+
+	return cartApplication.ContextWithExpressCart(ctx, p.context.ExpressCartID)
+}
+
+// UpdateOrderInfo updates the order infos of the current context, orders marked for review keep the mark
 func (p *Process) UpdateOrderInfo(info *application.PlaceOrderInfo) {
+	if info != nil && p.context.ReviewRequired {
+		info.ReviewRequired = true
+		info.RiskScore = p.context.RiskScore
+	}
+
 	p.context.PlaceOrderInfo = info
 }
 
+// UpdateClient stores the client that started the process, e.g. for the risk assessment
+func (p *Process) UpdateClient(ip string, userAgent string) {
+	p.context.ClientIP = ip
+	p.context.ClientUserAgent = userAgent
+}
+
+// MarkForReview marks the process and its placed orders for a manual review
+func (p *Process) MarkForReview(riskScore float64) {
+	p.context.ReviewRequired = true
+	p.context.RiskScore = riskScore
+
+	if p.context.PlaceOrderInfo != nil {
+		p.context.PlaceOrderInfo.ReviewRequired = true
+		p.context.PlaceOrderInfo.RiskScore = riskScore
+	}
+}
+
 // Failed performs all collected rollbacks and switches to FailedState
 func (p *Process) Failed(ctx context.Context, reason FailedReason) {
+	ctx = p.cartContext(ctx)
+	defer p.dispatchStateChanged(ctx, p.context.CurrentStateName)
+
+	p.fail(ctx, reason)
+}
+
+func (p *Process) fail(ctx context.Context, reason FailedReason) {
 	err := p.rollback(ctx)
 	if err != nil {
 		p.logger.WithContext(ctx).Error("fatal rollback error: ", err)
 	}
 
+	p.recordFailed(ctx, reason)
 	p.context.FailedReason = reason
 	p.UpdateState(p.failedState.Name(), nil)
 }
+
+// dispatchStateChanged dispatches the StateChangedEvent if the state differs from the given state
+func (p *Process) dispatchStateChanged(ctx context.Context, fromState string) {
+	if p.eventRouter == nil || p.context.CurrentStateName == fromState {
+		return
+	}
+
+	p.eventRouter.Dispatch(ctx, &StateChangedEvent{FromState: fromState, Context: p.context})
+}
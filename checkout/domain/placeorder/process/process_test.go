@@ -0,0 +1,84 @@
+package process_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cartApplication "flamingo.me/flamingo-commerce/v3/cart/application"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+)
+
+type eventRouter struct {
+	events         []flamingo.Event
+	expressCartIDs []string
+}
+
+func (e *eventRouter) Dispatch(ctx context.Context, event flamingo.Event) {
+	e.events = append(e.events, event)
+	expressCartID, _ := cartApplication.ExpressCartIDFromContext(ctx)
+	e.expressCartIDs = append(e.expressCartIDs, expressCartID)
+}
+
+func TestProcess_DispatchesStateChangedEvent(t *testing.T) {
+	t.Run("run switches the state", func(t *testing.T) {
+		router := new(eventRouter)
+		p, _ := provideFlakyProcess(t, 2, router)
+
+		p.Run(context.Background())
+		assert.Empty(t, router.events, "a pending retry keeps the state")
+
+		time.Sleep(20 * time.Millisecond)
+		p.Run(context.Background())
+		require.Len(t, router.events, 1)
+		event, ok := router.events[0].(*process.StateChangedEvent)
+		require.True(t, ok)
+		assert.Equal(t, "Flaky", event.FromState)
+		assert.Equal(t, "Success", event.Context.CurrentStateName)
+	})
+
+	t.Run("failed switches the state", func(t *testing.T) {
+		router := new(eventRouter)
+		p, _ := provideFlakyProcess(t, 2, router)
+
+		p.Failed(context.Background(), process.CanceledByCustomerReason{})
+		require.Len(t, router.events, 1)
+		event := router.events[0].(*process.StateChangedEvent)
+		assert.Equal(t, "Flaky", event.FromState)
+		assert.Equal(t, "Failed", event.Context.CurrentStateName)
+		assert.Equal(t, process.CanceledByCustomerReason{}, event.Context.FailedReason)
+	})
+}
+
+func TestProcess_UseExpressCart(t *testing.T) {
+	t.Run("run", func(t *testing.T) {
+		router := new(eventRouter)
+		p, _ := provideFlakyProcess(t, 0, router)
+		p.UseExpressCart("express")
+
+		p.Run(context.Background())
+		assert.Equal(t, []string{"express"}, router.expressCartIDs, "the states work on the express cart")
+		assert.Equal(t, "express", p.Context().ExpressCartID)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		router := new(eventRouter)
+		p, _ := provideFlakyProcess(t, 0, router)
+		p.UseExpressCart("express")
+
+		p.Failed(context.Background(), process.CanceledByCustomerReason{})
+		assert.Equal(t, []string{"express"}, router.expressCartIDs, "the rollbacks work on the express cart")
+	})
+
+	t.Run("without express cart", func(t *testing.T) {
+		router := new(eventRouter)
+		p, _ := provideFlakyProcess(t, 0, router)
+
+		p.Run(context.Background())
+		assert.Equal(t, []string{""}, router.expressCartIDs)
+	})
+}
@@ -0,0 +1,88 @@
+package process
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type (
+	// RetryPolicy defines how often a state is retried after a transient failure before the process fails
+	RetryPolicy struct {
+		MaxRetries int
+		// Backoff is the wait before the first retry, it doubles with every further retry
+		Backoff time.Duration
+		// MaxBackoff limits the wait between two retries, no limit if zero
+		MaxBackoff time.Duration
+	}
+
+	// TransientError marks an error that may not occur on a retry, e.g. an unavailable external service
+	TransientError struct {
+		err error
+	}
+)
+
+// NewTransientError marks the error as transient, states return a transient RunResult for it, see IsTransientError
+func NewTransientError(err error) error {
+	return &TransientError{err: err}
+}
+
+func (e *TransientError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the marked error
+func (e *TransientError) Unwrap() error {
+	return e.err
+}
+
+// Temporary marks the error as transient
+func (e *TransientError) Temporary() bool {
+	return true
+}
+
+// IsMarkedTransientError checks if the error is explicitly marked with NewTransientError, e.g. for operations that are
+// not safe to repeat after a timeout like placing the order
+func IsMarkedTransientError(err error) bool {
+	var transient *TransientError
+
+	return errors.As(err, &transient)
+}
+
+// IsTransientError checks if the error may not occur on a retry: errors marked with NewTransientError,
+// temporary and timeout errors like net.Error and exceeded context deadlines
+func IsTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var temporary interface{ Temporary() bool }
+	if errors.As(err, &temporary) && temporary.Temporary() {
+		return true
+	}
+
+	var timeout interface{ Timeout() bool }
+
+	return errors.As(err, &timeout) && timeout.Timeout()
+}
+
+// BackoffFor returns the wait before the given retry, starting with 1
+func (r RetryPolicy) BackoffFor(retry int) time.Duration {
+	backoff := r.Backoff
+	for i := 1; i < retry; i++ {
+		backoff *= 2
+		if r.MaxBackoff > 0 && backoff >= r.MaxBackoff {
+			break
+		}
+	}
+
+	if r.MaxBackoff > 0 && backoff > r.MaxBackoff {
+		return r.MaxBackoff
+	}
+
+	return backoff
+}
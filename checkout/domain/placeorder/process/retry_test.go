@@ -0,0 +1,138 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+)
+
+// flakyState fails transient until it ran the given number of times
+type flakyState struct {
+	runs      *int
+	failUntil int
+}
+
+func (s flakyState) Run(_ context.Context, p *process.Process) process.RunResult {
+	*s.runs++
+	// a switch before the failure is reverted for the retry
+	p.UpdateState("Success", nil)
+	if *s.runs < s.failUntil {
+		return process.RunResult{
+			Failed:    process.ErrorOccurredReason{Error: "service unavailable"},
+			Transient: true,
+		}
+	}
+
+	return process.RunResult{}
+}
+func (s flakyState) Rollback(context.Context, process.RollbackData) error { return nil }
+func (s flakyState) IsFinal() bool                                        { return false }
+func (s flakyState) Name() string                                         { return "Flaky" }
+
+func provideFlakyProcess(t *testing.T, failUntil int, eventRouter flamingo.EventRouter) (*process.Process, *int) {
+	t.Helper()
+
+	runs := 0
+	allStates := provideStates()
+	allStates["Flaky"] = flakyState{runs: &runs, failUntil: failUntil}
+
+	factory := new(process.Factory)
+	factory.Inject(
+		func() *process.Process {
+			return new(process.Process).Inject(allStates, nil, flamingo.NullLogger{}, eventRouter, &struct {
+				Area  string     `inject:"config:area"`
+				Retry config.Map `inject:"config:commerce.checkout.placeorder.retry,optional"`
+			}{
+				Retry: config.Map{"Flaky": map[string]interface{}{"maxRetries": 2.0, "backoff": 0.01, "maxBackoff": 0.01}},
+			})
+		},
+		&struct {
+			StartState  process.State `inject:"startState"`
+			FailedState process.State `inject:"failedState"`
+		}{
+			StartState:  allStates["Flaky"],
+			FailedState: allStates["Failed"],
+		},
+	)
+
+	p, err := factory.New(nil, cart.Cart{})
+	require.NoError(t, err)
+
+	return p, &runs
+}
+
+func TestProcess_RunWithRetry(t *testing.T) {
+	t.Run("succeeds within the retries", func(t *testing.T) {
+		p, runs := provideFlakyProcess(t, 3, nil)
+
+		p.Run(context.Background())
+		assert.Equal(t, "Flaky", p.Context().CurrentStateName, "the state switch of the failed run is reverted")
+		assert.Equal(t, 1, p.Context().RetryCount)
+		assert.True(t, p.RetryPending())
+
+		p.Run(context.Background())
+		assert.Equal(t, 1, *runs, "the retry waits for the backoff")
+
+		time.Sleep(20 * time.Millisecond)
+		p.Run(context.Background())
+		assert.Equal(t, 2, p.Context().RetryCount)
+
+		time.Sleep(20 * time.Millisecond)
+		p.Run(context.Background())
+		assert.Equal(t, 3, *runs)
+		assert.Equal(t, "Success", p.Context().CurrentStateName)
+		assert.Equal(t, 0, p.Context().RetryCount)
+		assert.False(t, p.RetryPending())
+	})
+
+	t.Run("fails after the retries", func(t *testing.T) {
+		p, runs := provideFlakyProcess(t, 10, nil)
+
+		for i := 0; i < 3; i++ {
+			p.Run(context.Background())
+			time.Sleep(20 * time.Millisecond)
+		}
+
+		assert.Equal(t, 3, *runs)
+		assert.Equal(t, "Failed", p.Context().CurrentStateName)
+		assert.Equal(t, process.ErrorOccurredReason{Error: "service unavailable"}, p.Context().FailedReason)
+		assert.False(t, p.RetryPending())
+	})
+}
+
+func TestRetryPolicy_BackoffFor(t *testing.T) {
+	policy := process.RetryPolicy{MaxRetries: 5, Backoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	assert.Equal(t, time.Second, policy.BackoffFor(1))
+	assert.Equal(t, 2*time.Second, policy.BackoffFor(2))
+	assert.Equal(t, 4*time.Second, policy.BackoffFor(3))
+	assert.Equal(t, 5*time.Second, policy.BackoffFor(4))
+	assert.Equal(t, 8*time.Second, process.RetryPolicy{Backoff: time.Second}.BackoffFor(4), "no limit without max backoff")
+}
+
+func TestIsTransientError(t *testing.T) {
+	assert.False(t, process.IsTransientError(nil))
+	assert.False(t, process.IsTransientError(errors.New("invalid cart")))
+	assert.True(t, process.IsTransientError(process.NewTransientError(errors.New("unavailable"))))
+	assert.True(t, process.IsTransientError(fmt.Errorf("wrapped: %w", process.NewTransientError(errors.New("unavailable")))))
+	assert.True(t, process.IsTransientError(fmt.Errorf("wrapped: %w", context.DeadlineExceeded)))
+	assert.True(t, process.IsTransientError(&net.DNSError{IsTimeout: true}))
+}
+
+func TestIsMarkedTransientError(t *testing.T) {
+	assert.False(t, process.IsMarkedTransientError(nil))
+	assert.True(t, process.IsMarkedTransientError(fmt.Errorf("wrapped: %w", process.NewTransientError(errors.New("unavailable")))))
+	assert.False(t, process.IsMarkedTransientError(context.DeadlineExceeded), "timeouts are not marked")
+	assert.False(t, process.IsMarkedTransientError(&net.DNSError{IsTimeout: true}), "timeouts are not marked")
+}
@@ -15,10 +15,19 @@ type (
 		Name() string
 	}
 
+	// StateWithSuccessors is an optional interface for states to declare the states their Run may switch to,
+	// failing is always possible and doesn't have to be declared
+	StateWithSuccessors interface {
+		Successors() []string
+	}
+
 	// RunResult of a state
 	RunResult struct {
 		RollbackData RollbackData
 		Failed       FailedReason
+		// Transient marks a failure that may not occur on a retry, the process retries the state
+		// according to its RetryPolicy before it fails
+		Transient bool
 	}
 
 	// FatalRollbackError which causes the premature end of rollback process
@@ -0,0 +1,38 @@
+package process
+
+import (
+	"time"
+)
+
+// StateTimeouts are the maximum durations a process may stay in a state, states without timeout wait forever
+type StateTimeouts map[string]time.Duration
+
+// TimedOut checks if the process context stayed longer than allowed in its current state
+func (t StateTimeouts) TimedOut(pctx Context, now time.Time) (TimeoutReason, bool) {
+	deadline, found := t.Deadline(pctx)
+	if !found || !now.After(deadline) {
+		return TimeoutReason{}, false
+	}
+
+	return TimeoutReason{State: pctx.CurrentStateName, Timeout: t[pctx.CurrentStateName]}, true
+}
+
+// Deadline returns the time the process context times out in its current state, false if the state has no timeout
+func (t StateTimeouts) Deadline(pctx Context) (time.Time, bool) {
+	timeout, found := t[pctx.CurrentStateName]
+	if !found || timeout <= 0 {
+		return time.Time{}, false
+	}
+
+	// contexts stored before the state entry was tracked fall back to the start of the process
+	enteredAt := pctx.StateEnteredAt
+	if enteredAt.IsZero() {
+		enteredAt = pctx.StartedAt
+	}
+
+	if enteredAt.IsZero() {
+		return time.Time{}, false
+	}
+
+	return enteredAt.Add(timeout), true
+}
@@ -0,0 +1,69 @@
+package process_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+)
+
+func TestStateTimeouts_TimedOut(t *testing.T) {
+	now := time.Now()
+	timeouts := process.StateTimeouts{"WaitForCustomer": 30 * time.Minute}
+
+	tests := []struct {
+		name     string
+		pctx     process.Context
+		expected bool
+	}{
+		{
+			name:     "state without timeout",
+			pctx:     process.Context{CurrentStateName: "PlaceOrder", StateEnteredAt: now.Add(-time.Hour)},
+			expected: false,
+		},
+		{
+			name:     "within timeout",
+			pctx:     process.Context{CurrentStateName: "WaitForCustomer", StateEnteredAt: now.Add(-10 * time.Minute)},
+			expected: false,
+		},
+		{
+			name:     "timeout exceeded",
+			pctx:     process.Context{CurrentStateName: "WaitForCustomer", StateEnteredAt: now.Add(-31 * time.Minute)},
+			expected: true,
+		},
+		{
+			name:     "falls back to the start of the process",
+			pctx:     process.Context{CurrentStateName: "WaitForCustomer", StartedAt: now.Add(-time.Hour)},
+			expected: true,
+		},
+		{
+			name:     "unknown entry time",
+			pctx:     process.Context{CurrentStateName: "WaitForCustomer"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, timedOut := timeouts.TimedOut(tt.pctx, now)
+			assert.Equal(t, tt.expected, timedOut)
+			if tt.expected {
+				assert.Equal(t, process.TimeoutReason{State: "WaitForCustomer", Timeout: 30 * time.Minute}, reason)
+			}
+		})
+	}
+}
+
+func TestStateTimeouts_Deadline(t *testing.T) {
+	now := time.Now()
+	timeouts := process.StateTimeouts{"WaitForCustomer": 30 * time.Minute}
+
+	deadline, found := timeouts.Deadline(process.Context{CurrentStateName: "WaitForCustomer", StateEnteredAt: now})
+	assert.True(t, found)
+	assert.Equal(t, now.Add(30*time.Minute), deadline)
+
+	_, found = timeouts.Deadline(process.Context{CurrentStateName: "PlaceOrder", StateEnteredAt: now})
+	assert.False(t, found, "states without timeout have no deadline")
+}
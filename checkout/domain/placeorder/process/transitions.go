@@ -0,0 +1,319 @@
+package process
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"flamingo.me/flamingo/v3/framework/config"
+)
+
+type (
+	// Transition from one state to another as performed by the Run of a state,
+	// states that can't implement StateWithSuccessors register their transitions with a multi binding
+	Transition struct {
+		From string
+		To   string
+	}
+
+	// Insertion of a state before or after an existing state,
+	// the inserted state has to call Process.Continue to proceed with the interrupted transition
+	Insertion struct {
+		State  string `json:"state"`
+		Before string `json:"before"`
+		After  string `json:"after"`
+	}
+
+	// Transitions is the registry of all place order state transitions and state insertions
+	Transitions struct {
+		transitions []Transition
+		insertions  []Insertion
+	}
+)
+
+// ErrNoPendingTransition is returned if a state continues although no transition was interrupted
+var ErrNoPendingTransition = errors.New("no pending transition to continue with")
+
+// Inject dependencies
+func (t *Transitions) Inject(
+	optionals *struct {
+		Transitions []Transition `inject:",optional"`
+		Insertions  []Insertion  `inject:",optional"`
+	},
+	cfg *struct {
+		Insert config.Slice `inject:"config:commerce.checkout.placeorder.transitions.insert,optional"`
+		Add    config.Map   `inject:"config:commerce.checkout.placeorder.transitions.add,optional"`
+	},
+) *Transitions {
+	if optionals != nil {
+		t.transitions = append(t.transitions, optionals.Transitions...)
+		t.insertions = append(t.insertions, optionals.Insertions...)
+	}
+
+	if cfg != nil {
+		var insertions []Insertion
+		if err := cfg.Insert.MapInto(&insertions); err != nil {
+			panic(fmt.Errorf("invalid config commerce.checkout.placeorder.transitions.insert: %w", err))
+		}
+		t.insertions = append(t.insertions, insertions...)
+
+		var additional map[string][]string
+		if err := cfg.Add.MapInto(&additional); err != nil {
+			panic(fmt.Errorf("invalid config commerce.checkout.placeorder.transitions.add: %w", err))
+		}
+		froms := make([]string, 0, len(additional))
+		for from := range additional {
+			froms = append(froms, from)
+		}
+		sort.Strings(froms)
+
+		for _, from := range froms {
+			for _, to := range additional[from] {
+				t.transitions = append(t.transitions, Transition{From: from, To: to})
+			}
+		}
+	}
+
+	return t
+}
+
+// Route returns the states the process passes when switching from one state to another,
+// the last state of the route is always the requested one
+func (t *Transitions) Route(allStates map[string]State, from string, to string) []string {
+	var route []string
+
+	for _, insertion := range t.insertions {
+		if insertion.After == from && insertion.State != to {
+			route = append(route, insertion.State)
+		}
+	}
+
+	// an inserted state only leads to the states inserted behind itself, states inserted before the target
+	// that lead to the current state without passing the target have already been passed, e.g. FraudCheck -> ManualReview -> CreatePayment
+	before := t.insertedBefore(to)
+	if len(before) > 0 {
+		successors := t.successors(allStates)
+		for i := len(before) - 1; i >= 0; i-- {
+			passed := reach(before[i], func(name string) []string {
+				if name == to {
+					return nil
+				}
+				return successors[name]
+			})
+
+			if passed[from] {
+				before = before[i+1:]
+				break
+			}
+		}
+	}
+
+	route = append(route, before...)
+
+	return append(route, to)
+}
+
+// successors returns the declared next states of all states
+func (t *Transitions) successors(allStates map[string]State) map[string][]string {
+	successors := make(map[string][]string)
+	for _, transition := range t.declared(allStates) {
+		successors[transition.From] = append(successors[transition.From], transition.To)
+	}
+
+	return successors
+}
+
+func (t *Transitions) insertedBefore(to string) []string {
+	var states []string
+	for _, insertion := range t.insertions {
+		if insertion.Before == to {
+			states = append(states, insertion.State)
+		}
+	}
+
+	return states
+}
+
+// Graph returns all transitions of the states with the inserted states in between, sorted by state names
+func (t *Transitions) Graph(allStates map[string]State) []Transition {
+	seen := make(map[Transition]bool)
+	var graph []Transition
+
+	for _, transition := range t.declared(allStates) {
+		from := transition.From
+		for _, to := range t.Route(allStates, transition.From, transition.To) {
+			edge := Transition{From: from, To: to}
+			if from != to && !seen[edge] {
+				seen[edge] = true
+				graph = append(graph, edge)
+			}
+			from = to
+		}
+	}
+
+	sort.Slice(graph, func(i, j int) bool {
+		if graph[i].From != graph[j].From {
+			return graph[i].From < graph[j].From
+		}
+		return graph[i].To < graph[j].To
+	})
+
+	return graph
+}
+
+// declared returns the registered transitions and the successors declared by the states
+func (t *Transitions) declared(allStates map[string]State) []Transition {
+	transitions := append([]Transition(nil), t.transitions...)
+
+	names := make([]string, 0, len(allStates))
+	for name := range allStates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if state, ok := allStates[name].(StateWithSuccessors); ok {
+			for _, successor := range state.Successors() {
+				transitions = append(transitions, Transition{From: name, To: successor})
+			}
+		}
+	}
+
+	return transitions
+}
+
+// Validate the transition graph: all states have to be known and reachable from the start state
+// and every reachable state needs a path to a final state other than the failed state
+func (t *Transitions) Validate(allStates map[string]State, startState string, failedState string) error {
+	var problems []string
+
+	known := func(name string) bool {
+		_, found := allStates[name]
+		return found
+	}
+
+	for _, insertion := range t.insertions {
+		if (insertion.Before == "") == (insertion.After == "") {
+			problems = append(problems, fmt.Sprintf("insertion of %q needs either before or after", insertion.State))
+		}
+
+		for _, name := range []string{insertion.State, insertion.Before, insertion.After} {
+			if name != "" && !known(name) {
+				problems = append(problems, fmt.Sprintf("unknown state %q in insertion", name))
+			}
+		}
+	}
+
+	graph := make(map[string]map[string]bool)
+	addEdge := func(from, to string) {
+		if graph[from] == nil {
+			graph[from] = make(map[string]bool)
+		}
+		graph[from][to] = true
+	}
+
+	for _, transition := range t.declared(allStates) {
+		for _, name := range []string{transition.From, transition.To} {
+			if !known(name) {
+				problems = append(problems, fmt.Sprintf("unknown state %q in transition %s -> %s", name, transition.From, transition.To))
+			}
+		}
+	}
+
+	for _, transition := range t.Graph(allStates) {
+		addEdge(transition.From, transition.To)
+	}
+
+	if !known(startState) {
+		problems = append(problems, fmt.Sprintf("unknown start state %q", startState))
+	}
+
+	if failedState != "" && !known(failedState) {
+		problems = append(problems, fmt.Sprintf("unknown failed state %q", failedState))
+	}
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, ", "))
+	}
+
+	// every non final state may fail
+	reachable := reach(startState, func(name string) []string {
+		next := sortedKeys(graph[name])
+		if !allStates[name].IsFinal() && failedState != "" {
+			next = append(next, failedState)
+		}
+		return next
+	})
+
+	var unreachable []string
+	for name := range allStates {
+		if !reachable[name] {
+			unreachable = append(unreachable, name)
+		}
+	}
+	sort.Strings(unreachable)
+
+	if len(unreachable) > 0 {
+		problems = append(problems, fmt.Sprintf("unreachable states: %s", strings.Join(unreachable, ", ")))
+	}
+
+	var withoutFinal []string
+	for _, name := range sortedKeys(reachable) {
+		if allStates[name].IsFinal() {
+			continue
+		}
+
+		finishes := false
+		for other := range reach(name, func(name string) []string { return sortedKeys(graph[name]) }) {
+			if allStates[other].IsFinal() && other != failedState {
+				finishes = true
+				break
+			}
+		}
+
+		if !finishes {
+			withoutFinal = append(withoutFinal, name)
+		}
+	}
+
+	if len(withoutFinal) > 0 {
+		problems = append(problems, fmt.Sprintf("states without transition to a final state: %s", strings.Join(withoutFinal, ", ")))
+	}
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, ", "))
+	}
+
+	return nil
+}
+
+// reach returns all states reachable from the given state including itself
+func reach(start string, next func(string) []string) map[string]bool {
+	visited := map[string]bool{start: true}
+	queue := []string{start}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, name := range next(current) {
+			if !visited[name] {
+				visited[name] = true
+				queue = append(queue, name)
+			}
+		}
+	}
+
+	return visited
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
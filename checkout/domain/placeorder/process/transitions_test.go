@@ -0,0 +1,172 @@
+package process_test
+
+import (
+	"context"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+)
+
+type testState struct {
+	name  string
+	final bool
+}
+
+func (s testState) Run(context.Context, *process.Process) process.RunResult {
+	return process.RunResult{}
+}
+func (s testState) Rollback(context.Context, process.RollbackData) error { return nil }
+func (s testState) IsFinal() bool                                        { return s.final }
+func (s testState) Name() string                                         { return s.name }
+
+func provideStates(names ...string) map[string]process.State {
+	allStates := map[string]process.State{
+		"Success": testState{name: "Success", final: true},
+		"Failed":  testState{name: "Failed", final: true},
+	}
+	for _, name := range names {
+		allStates[name] = testState{name: name}
+	}
+
+	return allStates
+}
+
+func provideTransitions(insertions []process.Insertion, insert config.Slice, add config.Map) *process.Transitions {
+	return new(process.Transitions).Inject(
+		&struct {
+			Transitions []process.Transition `inject:",optional"`
+			Insertions  []process.Insertion  `inject:",optional"`
+		}{
+			Transitions: []process.Transition{
+				{From: "New", To: "Validate"},
+				{From: "Validate", To: "Pay"},
+				{From: "Validate", To: "Place"},
+				{From: "Pay", To: "Place"},
+				{From: "Place", To: "Success"},
+			},
+			Insertions: insertions,
+		},
+		&struct {
+			Insert config.Slice `inject:"config:commerce.checkout.placeorder.transitions.insert,optional"`
+			Add    config.Map   `inject:"config:commerce.checkout.placeorder.transitions.add,optional"`
+		}{
+			Insert: insert,
+			Add:    add,
+		},
+	)
+}
+
+func TestTransitions_Route(t *testing.T) {
+	transitions := provideTransitions(
+		[]process.Insertion{{State: "Approve", After: "Validate"}},
+		config.Slice{
+			config.Map{"state": "Fraud", "before": "Pay"},
+			config.Map{"state": "Risk", "before": "Pay"},
+		},
+		nil,
+	)
+
+	allStates := provideStates("New", "Validate", "Pay", "Place", "Approve", "Fraud", "Risk")
+
+	assert.Equal(t, []string{"Validate"}, transitions.Route(allStates, "New", "Validate"))
+	assert.Equal(t, []string{"Approve", "Fraud", "Risk", "Pay"}, transitions.Route(allStates, "Validate", "Pay"))
+	assert.Equal(t, []string{"Approve", "Place"}, transitions.Route(allStates, "Validate", "Place"))
+	assert.Equal(t, []string{"Risk", "Pay"}, transitions.Route(allStates, "Fraud", "Pay"), "an inserted state leads to the states inserted behind itself")
+}
+
+func TestTransitions_RouteFromSuccessorOfInsertedState(t *testing.T) {
+	transitions := provideTransitions(
+		nil,
+		config.Slice{config.Map{"state": "Fraud", "before": "Pay"}},
+		config.Map{"Fraud": []interface{}{"Review"}, "Review": []interface{}{"Pay"}},
+	)
+	allStates := provideStates("New", "Validate", "Pay", "Place", "Fraud", "Review")
+
+	assert.Equal(t, []string{"Fraud", "Pay"}, transitions.Route(allStates, "Validate", "Pay"))
+	assert.Equal(t, []string{"Pay"}, transitions.Route(allStates, "Review", "Pay"), "the review follows the inserted state, so it is not passed again")
+
+	p := new(process.Process).Inject(allStates, transitions, flamingo.NullLogger{}, nil, nil)
+	p.UpdateState("Validate", nil)
+	p.UpdateState("Pay", nil)
+	require.Equal(t, "Fraud", p.Context().CurrentStateName)
+	p.UpdateState("Review", nil)
+	p.UpdateState("Pay", "pay data")
+	assert.Equal(t, "Pay", p.Context().CurrentStateName)
+	assert.Equal(t, "pay data", p.Context().CurrentStateData)
+}
+
+func TestTransitions_Validate(t *testing.T) {
+	t.Run("default graph", func(t *testing.T) {
+		transitions := provideTransitions(nil, nil, nil)
+		assert.NoError(t, transitions.Validate(provideStates("New", "Validate", "Pay", "Place"), "New", "Failed"))
+	})
+
+	t.Run("inserted states", func(t *testing.T) {
+		transitions := provideTransitions(nil, config.Slice{config.Map{"state": "Fraud", "after": "Validate"}}, config.Map{"Fraud": []interface{}{"Review"}, "Review": []interface{}{"Place"}})
+		assert.NoError(t, transitions.Validate(provideStates("New", "Validate", "Pay", "Place", "Fraud", "Review"), "New", "Failed"))
+	})
+
+	t.Run("unknown states", func(t *testing.T) {
+		transitions := provideTransitions([]process.Insertion{{State: "Fraud", Before: "Missing"}}, nil, nil)
+		err := transitions.Validate(provideStates("New", "Validate", "Pay", "Place", "Fraud"), "New", "Failed")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown state "Missing" in insertion`)
+	})
+
+	t.Run("insertion without anchor", func(t *testing.T) {
+		transitions := provideTransitions([]process.Insertion{{State: "Fraud"}}, nil, nil)
+		err := transitions.Validate(provideStates("New", "Validate", "Pay", "Place", "Fraud"), "New", "Failed")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `insertion of "Fraud" needs either before or after`)
+	})
+
+	t.Run("unreachable states", func(t *testing.T) {
+		transitions := provideTransitions(nil, nil, nil)
+		err := transitions.Validate(provideStates("New", "Validate", "Pay", "Place", "Fraud"), "New", "Failed")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unreachable states: Fraud")
+	})
+
+	t.Run("missing final state", func(t *testing.T) {
+		transitions := provideTransitions(nil, nil, nil)
+		allStates := provideStates("New", "Validate", "Pay", "Place", "Success")
+		err := transitions.Validate(allStates, "New", "Failed")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "states without transition to a final state: New, Pay, Place, Success, Validate")
+	})
+
+	t.Run("dead end", func(t *testing.T) {
+		transitions := provideTransitions(nil, nil, config.Map{"Validate": []interface{}{"Hold"}})
+		err := transitions.Validate(provideStates("New", "Validate", "Pay", "Place", "Hold"), "New", "Failed")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "states without transition to a final state: Hold")
+	})
+}
+
+func TestProcess_UpdateState(t *testing.T) {
+	transitions := provideTransitions([]process.Insertion{{State: "Approve", After: "Validate"}}, nil, nil)
+	p := new(process.Process).Inject(provideStates("New", "Validate", "Pay", "Place", "Approve"), transitions, flamingo.NullLogger{}, nil, nil)
+
+	p.UpdateState("Validate", nil)
+	assert.Equal(t, "Validate", p.Context().CurrentStateName)
+
+	p.UpdateState("Pay", "pay data")
+	assert.Equal(t, "Approve", p.Context().CurrentStateName)
+	assert.Nil(t, p.Context().CurrentStateData)
+
+	// inserted states may update their own data without losing the interrupted transition
+	p.UpdateState("Approve", "approve data")
+	assert.Equal(t, "approve data", p.Context().CurrentStateData)
+
+	require.NoError(t, p.Continue())
+	assert.Equal(t, "Pay", p.Context().CurrentStateName)
+	assert.Equal(t, "pay data", p.Context().CurrentStateData)
+	assert.Empty(t, p.Context().PendingStateNames)
+
+	assert.Equal(t, process.ErrNoPendingTransition, p.Continue())
+}
@@ -28,7 +28,10 @@ func init() {
 	gob.Register(CompleteCartRollbackData{})
 }
 
-var _ process.State = CompleteCart{}
+var (
+	_ process.State               = CompleteCart{}
+	_ process.StateWithSuccessors = CompleteCart{}
+)
 
 // Inject dependencies
 func (c *CompleteCart) Inject(
@@ -46,6 +49,11 @@ func (CompleteCart) Name() string {
 	return "CompleteCart"
 }
 
+// Successors get possible next states
+func (CompleteCart) Successors() []string {
+	return []string{PlaceOrder{}.Name()}
+}
+
 // Run the state operations
 func (c CompleteCart) Run(ctx context.Context, p *process.Process) process.RunResult {
 	ctx, span := trace.StartSpan(ctx, "placeorder/state/CompleteCart/Run")
@@ -15,7 +15,10 @@ type (
 	}
 )
 
-var _ process.State = CompletePayment{}
+var (
+	_ process.State               = CompletePayment{}
+	_ process.StateWithSuccessors = CompletePayment{}
+)
 
 // Inject dependencies
 func (c *CompletePayment) Inject(
@@ -31,6 +34,11 @@ func (CompletePayment) Name() string {
 	return "CompletePayment"
 }
 
+// Successors get possible next states
+func (CompletePayment) Successors() []string {
+	return []string{ValidatePayment{}.Name()}
+}
+
 // Run the state operations
 func (c CompletePayment) Run(ctx context.Context, p *process.Process) process.RunResult {
 	ctx, span := trace.StartSpan(ctx, "placeorder/state/CompletePayment/Run")
@@ -47,7 +55,8 @@ func (c CompletePayment) Run(ctx context.Context, p *process.Process) process.Ru
 	payment, err := paymentGateway.OrderPaymentFromFlow(ctx, &cart, p.Context().UUID)
 	if err != nil {
 		return process.RunResult{
-			Failed: process.PaymentErrorOccurredReason{Error: err.Error()},
+			Failed:    process.PaymentErrorOccurredReason{Error: err.Error()},
+			Transient: process.IsTransientError(err),
 		}
 	}
 
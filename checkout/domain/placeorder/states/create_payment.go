@@ -8,23 +8,29 @@ import (
 	"flamingo.me/flamingo-commerce/v3/cart/domain/placeorder"
 	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
 	"flamingo.me/flamingo-commerce/v3/payment/application"
+	"flamingo.me/flamingo-commerce/v3/payment/domain"
 	"go.opencensus.io/trace"
 )
 
 type (
 	// CreatePayment state
 	CreatePayment struct {
-		paymentService *application.PaymentService
+		paymentService        *application.PaymentService
+		loyaltyPaymentService *application.LoyaltyPaymentService
 	}
 
 	// CreatePaymentRollbackData needed for rollback
 	CreatePaymentRollbackData struct {
-		PaymentID string
-		Gateway   string
+		PaymentID           string
+		Gateway             string
+		LoyaltyReservations []domain.LoyaltyReservation
 	}
 )
 
-var _ process.State = CreatePayment{}
+var (
+	_ process.State               = CreatePayment{}
+	_ process.StateWithSuccessors = CreatePayment{}
+)
 
 func init() {
 	gob.Register(CreatePaymentRollbackData{})
@@ -33,8 +39,10 @@ func init() {
 // Inject dependencies
 func (c *CreatePayment) Inject(
 	paymentService *application.PaymentService,
+	loyaltyPaymentService *application.LoyaltyPaymentService,
 ) *CreatePayment {
 	c.paymentService = paymentService
+	c.loyaltyPaymentService = loyaltyPaymentService
 
 	return c
 }
@@ -44,6 +52,11 @@ func (CreatePayment) Name() string {
 	return "CreatePayment"
 }
 
+// Successors get possible next states
+func (CreatePayment) Successors() []string {
+	return []string{CompleteCart{}.Name()}
+}
+
 // Run the state operations
 func (c CreatePayment) Run(ctx context.Context, p *process.Process) process.RunResult {
 	ctx, span := trace.StartSpan(ctx, "placeorder/state/CreatePayment/Run")
@@ -57,8 +70,19 @@ func (c CreatePayment) Run(ctx context.Context, p *process.Process) process.RunR
 		}
 	}
 
+	var loyaltyReservations []domain.LoyaltyReservation
+	if c.loyaltyPaymentService != nil {
+		loyaltyReservations, err = c.loyaltyPaymentService.ReservePoints(ctx, cart, p.Context().UUID)
+		if err != nil {
+			return process.RunResult{
+				Failed: process.PaymentErrorOccurredReason{Error: err.Error()},
+			}
+		}
+	}
+
 	_, err = paymentGateway.StartFlow(ctx, &cart, p.Context().UUID, p.Context().ReturnURL)
 	if err != nil {
+		c.releaseLoyaltyPoints(ctx, loyaltyReservations)
 		return process.RunResult{
 			Failed: process.PaymentErrorOccurredReason{Error: err.Error()},
 		}
@@ -66,6 +90,7 @@ func (c CreatePayment) Run(ctx context.Context, p *process.Process) process.RunR
 
 	payment, err := paymentGateway.OrderPaymentFromFlow(ctx, &cart, p.Context().UUID)
 	if err != nil {
+		c.releaseLoyaltyPoints(ctx, loyaltyReservations)
 		return process.RunResult{
 			Failed: process.PaymentErrorOccurredReason{Error: err.Error()},
 		}
@@ -73,7 +98,7 @@ func (c CreatePayment) Run(ctx context.Context, p *process.Process) process.RunR
 
 	p.UpdateState(CompleteCart{}.Name(), nil)
 	return process.RunResult{
-		RollbackData: CreatePaymentRollbackData{PaymentID: payment.PaymentID, Gateway: payment.Gateway},
+		RollbackData: CreatePaymentRollbackData{PaymentID: payment.PaymentID, Gateway: payment.Gateway, LoyaltyReservations: loyaltyReservations},
 	}
 }
 
@@ -87,6 +112,8 @@ func (c CreatePayment) Rollback(ctx context.Context, data process.RollbackData)
 		return fmt.Errorf("rollback data not of expected type 'CreatePaymentRollbackData', but %T", rollbackData)
 	}
 
+	c.releaseLoyaltyPoints(ctx, rollbackData.LoyaltyReservations)
+
 	paymentGateway, err := c.paymentService.PaymentGateway(rollbackData.Gateway)
 	if err != nil {
 		return err
@@ -104,3 +131,12 @@ func (c CreatePayment) Rollback(ctx context.Context, data process.RollbackData)
 func (c CreatePayment) IsFinal() bool {
 	return false
 }
+
+// releaseLoyaltyPoints frees reserved points, errors are logged by the service
+func (c CreatePayment) releaseLoyaltyPoints(ctx context.Context, reservations []domain.LoyaltyReservation) {
+	if c.loyaltyPaymentService == nil || len(reservations) == 0 {
+		return
+	}
+
+	_ = c.loyaltyPaymentService.ReleasePoints(ctx, reservations)
+}
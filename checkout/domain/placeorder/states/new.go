@@ -13,13 +13,21 @@ type (
 	}
 )
 
-var _ process.State = New{}
+var (
+	_ process.State               = New{}
+	_ process.StateWithSuccessors = New{}
+)
 
 // Name get state name
 func (New) Name() string {
 	return "New"
 }
 
+// Successors get possible next states
+func (New) Successors() []string {
+	return []string{PrepareCart{}.Name()}
+}
+
 // Run the state operations
 func (n New) Run(ctx context.Context, p *process.Process) process.RunResult {
 	_, span := trace.StartSpan(ctx, "placeorder/state/New/Run")
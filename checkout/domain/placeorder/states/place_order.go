@@ -34,7 +34,10 @@ func init() {
 	gob.Register(PlaceOrderRollbackData{})
 }
 
-var _ process.State = PlaceOrder{}
+var (
+	_ process.State               = PlaceOrder{}
+	_ process.StateWithSuccessors = PlaceOrder{}
+)
 
 // Inject dependencies
 func (po *PlaceOrder) Inject(
@@ -56,6 +59,11 @@ func (PlaceOrder) Name() string {
 	return "PlaceOrder"
 }
 
+// Successors get possible next states
+func (PlaceOrder) Successors() []string {
+	return []string{ValidatePayment{}.Name(), Success{}.Name()}
+}
+
 // Run the state operations
 func (po PlaceOrder) Run(ctx context.Context, p *process.Process) process.RunResult {
 	ctx, span := trace.StartSpan(ctx, "placeorder/state/PlaceOrder/Run")
@@ -76,7 +84,8 @@ func (po PlaceOrder) Run(ctx context.Context, p *process.Process) process.RunRes
 		payment, err = paymentGateway.OrderPaymentFromFlow(ctx, &cart, p.Context().UUID)
 		if err != nil {
 			return process.RunResult{
-				Failed: process.ErrorOccurredReason{Error: err.Error()},
+				Failed:    process.ErrorOccurredReason{Error: err.Error()},
+				Transient: process.IsTransientError(err),
 			}
 		}
 
@@ -87,8 +96,10 @@ func (po PlaceOrder) Run(ctx context.Context, p *process.Process) process.RunRes
 
 	infos, err := po.orderService.CartPlaceOrder(ctx, decoratedCart, *payment)
 	if err != nil {
+		// a timeout may hide an already placed order, only the adapter knows if placing the order again is safe
 		return process.RunResult{
-			Failed: process.ErrorOccurredReason{Error: err.Error()},
+			Failed:    process.ErrorOccurredReason{Error: err.Error()},
+			Transient: process.IsMarkedTransientError(err),
 		}
 	}
 
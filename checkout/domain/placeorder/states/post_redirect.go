@@ -33,7 +33,10 @@ func init() {
 	gob.Register(PostRedirectData{})
 }
 
-var _ process.State = PostRedirect{}
+var (
+	_ process.State               = PostRedirect{}
+	_ process.StateWithSuccessors = PostRedirect{}
+)
 
 // NewPostRedirectStateData creates new StateData with (persisted) Data required for this state
 func NewPostRedirectStateData(url *url.URL, formParameter map[string]FormField) process.StateData {
@@ -59,6 +62,11 @@ func (PostRedirect) Name() string {
 	return "PostRedirect"
 }
 
+// Successors get possible next states, the payment validator decides which one is taken
+func (PostRedirect) Successors() []string {
+	return paymentFlowSuccessors()
+}
+
 // Run the state operations
 func (pr PostRedirect) Run(ctx context.Context, p *process.Process) process.RunResult {
 	ctx, span := trace.StartSpan(ctx, "placeorder/state/PostRedirect/Run")
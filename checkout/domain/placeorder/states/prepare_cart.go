@@ -18,7 +18,10 @@ type (
 	}
 )
 
-var _ process.State = PrepareCart{}
+var (
+	_ process.State               = PrepareCart{}
+	_ process.StateWithSuccessors = PrepareCart{}
+)
 
 // Inject dependencies
 func (v *PrepareCart) Inject(
@@ -34,6 +37,11 @@ func (PrepareCart) Name() string {
 	return "PrepareCart"
 }
 
+// Successors get possible next states
+func (PrepareCart) Successors() []string {
+	return []string{ValidateCart{}.Name()}
+}
+
 // Run the state operations
 func (v PrepareCart) Run(ctx context.Context, p *process.Process) process.RunResult {
 	ctx, span := trace.StartSpan(ctx, "placeorder/state/PrepareCart/Run")
@@ -18,7 +18,10 @@ type (
 	}
 )
 
-var _ process.State = Redirect{}
+var (
+	_ process.State               = Redirect{}
+	_ process.StateWithSuccessors = Redirect{}
+)
 
 func init() {
 	gob.Register(&url.URL{})
@@ -45,6 +48,11 @@ func (Redirect) Name() string {
 	return "Redirect"
 }
 
+// Successors get possible next states, the payment validator decides which one is taken
+func (Redirect) Successors() []string {
+	return paymentFlowSuccessors()
+}
+
 // Run the state operations
 func (r Redirect) Run(ctx context.Context, p *process.Process) process.RunResult {
 	ctx, span := trace.StartSpan(ctx, "placeorder/state/Redirect/Run")
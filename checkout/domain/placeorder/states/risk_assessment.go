@@ -0,0 +1,77 @@
+package states
+
+import (
+	"context"
+
+	"go.opencensus.io/trace"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/risk"
+)
+
+type (
+	// RiskAssessment state is inserted after ValidatePaymentSelection, it screens the order before the payment is captured
+	RiskAssessment struct {
+		riskService *risk.Service
+	}
+)
+
+var _ process.State = RiskAssessment{}
+
+// Inject dependencies
+func (r *RiskAssessment) Inject(
+	riskService *risk.Service,
+) *RiskAssessment {
+	r.riskService = riskService
+
+	return r
+}
+
+// Name get state name
+func (RiskAssessment) Name() string {
+	return "RiskAssessment"
+}
+
+// Run the state operations
+func (r RiskAssessment) Run(ctx context.Context, p *process.Process) process.RunResult {
+	ctx, span := trace.StartSpan(ctx, "placeorder/state/RiskAssessment/Run")
+	defer span.End()
+
+	pctx := p.Context()
+	assessment, err := r.riskService.Assess(ctx, risk.NewInput(pctx.UUID, pctx.Cart, pctx.ClientIP, pctx.ClientUserAgent))
+	if err != nil {
+		return process.RunResult{
+			Failed:    process.ErrorOccurredReason{Error: err.Error()},
+			Transient: process.IsTransientError(err),
+		}
+	}
+
+	if assessment.IsRejected() {
+		return process.RunResult{
+			Failed: process.RiskRejectedReason{Score: assessment.Score, Reasons: assessment.Reasons},
+		}
+	}
+
+	if !assessment.IsAccepted() {
+		p.MarkForReview(assessment.Score)
+	}
+
+	// proceed with the transition of ValidatePaymentSelection
+	if err := p.Continue(); err != nil {
+		return process.RunResult{
+			Failed: process.ErrorOccurredReason{Error: err.Error()},
+		}
+	}
+
+	return process.RunResult{}
+}
+
+// Rollback the state operations
+func (r RiskAssessment) Rollback(context.Context, process.RollbackData) error {
+	return nil
+}
+
+// IsFinal if state is a final state
+func (r RiskAssessment) IsFinal() bool {
+	return false
+}
@@ -0,0 +1,120 @@
+package states_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/checkout/application"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/states"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/risk"
+)
+
+type (
+	riskChecker struct {
+		assessment risk.Assessment
+		input      risk.Input
+	}
+)
+
+func (r *riskChecker) Check(_ context.Context, input risk.Input) (risk.Assessment, error) {
+	r.input = input
+	return r.assessment, nil
+}
+
+// provideRiskAssessmentProcess returns a process that left ValidatePaymentSelection and waits for the risk assessment
+func provideRiskAssessmentProcess(t *testing.T) *process.Process {
+	t.Helper()
+
+	transitions := new(process.Transitions).Inject(&struct {
+		Transitions []process.Transition `inject:",optional"`
+		Insertions  []process.Insertion  `inject:",optional"`
+	}{
+		Insertions: []process.Insertion{{State: states.RiskAssessment{}.Name(), After: states.ValidatePaymentSelection{}.Name()}},
+	}, nil)
+
+	factory := &process.Factory{}
+	factory.Inject(
+		func() *process.Process {
+			return new(process.Process).Inject(nil, transitions, flamingo.NullLogger{}, nil, nil)
+		},
+		&struct {
+			StartState  process.State `inject:"startState"`
+			FailedState process.State `inject:"failedState"`
+		}{
+			StartState: &states.New{},
+		},
+	)
+
+	p, err := factory.New(&url.URL{}, cartDomain.Cart{AuthenticatedUserID: "customer"})
+	require.NoError(t, err)
+	p.UpdateClient("127.0.0.1", "agent")
+
+	p.UpdateState(states.ValidatePaymentSelection{}.Name(), nil)
+	p.UpdateState(states.CreatePayment{}.Name(), nil)
+	require.Equal(t, states.RiskAssessment{}.Name(), p.Context().CurrentStateName)
+
+	return p
+}
+
+func provideRiskService(checker risk.Checker) *risk.Service {
+	return new(risk.Service).Inject(&struct {
+		Checkers []risk.Checker `inject:",optional"`
+	}{
+		Checkers: []risk.Checker{checker},
+	})
+}
+
+func TestRiskAssessment_IsFinal(t *testing.T) {
+	s := states.RiskAssessment{}
+	assert.False(t, s.IsFinal())
+}
+
+func TestRiskAssessment_Name(t *testing.T) {
+	s := states.RiskAssessment{}
+	assert.Equal(t, "RiskAssessment", s.Name())
+}
+
+func TestRiskAssessment_Run(t *testing.T) {
+	t.Run("accept", func(t *testing.T) {
+		checker := &riskChecker{assessment: risk.Assessment{Decision: risk.DecisionAccept, Score: 10}}
+		state := new(states.RiskAssessment).Inject(provideRiskService(checker))
+		p := provideRiskAssessmentProcess(t)
+
+		assert.Equal(t, process.RunResult{}, state.Run(context.Background(), p))
+		assert.Equal(t, states.CreatePayment{}.Name(), p.Context().CurrentStateName)
+		assert.False(t, p.Context().ReviewRequired)
+		assert.Equal(t, "customer", checker.input.Customer.ID)
+		assert.Equal(t, "127.0.0.1", checker.input.IP)
+		assert.Equal(t, "agent", checker.input.UserAgent)
+	})
+
+	t.Run("review marks the placed order info", func(t *testing.T) {
+		checker := &riskChecker{assessment: risk.Assessment{Decision: risk.DecisionReview, Score: 60}}
+		state := new(states.RiskAssessment).Inject(provideRiskService(checker))
+		p := provideRiskAssessmentProcess(t)
+
+		assert.Equal(t, process.RunResult{}, state.Run(context.Background(), p))
+		assert.Equal(t, states.CreatePayment{}.Name(), p.Context().CurrentStateName)
+		assert.True(t, p.Context().ReviewRequired)
+
+		p.UpdateOrderInfo(&application.PlaceOrderInfo{ContactEmail: "customer@example.com"})
+		assert.True(t, p.Context().PlaceOrderInfo.ReviewRequired)
+		assert.Equal(t, 60.0, p.Context().PlaceOrderInfo.RiskScore)
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		checker := &riskChecker{assessment: risk.Assessment{Decision: risk.DecisionReject, Score: 100, Reasons: []string{"velocity"}}}
+		state := new(states.RiskAssessment).Inject(provideRiskService(checker))
+		p := provideRiskAssessmentProcess(t)
+
+		result := state.Run(context.Background(), p)
+		assert.Equal(t, process.RiskRejectedReason{Score: 100, Reasons: []string{"velocity"}}, result.Failed)
+	})
+}
@@ -16,7 +16,10 @@ type (
 	}
 )
 
-var _ process.State = ShowHTML{}
+var (
+	_ process.State               = ShowHTML{}
+	_ process.StateWithSuccessors = ShowHTML{}
+)
 
 // NewShowHTMLStateData creates new StateData required for this ShowHTML state
 func NewShowHTMLStateData(html string) process.StateData {
@@ -38,6 +41,11 @@ func (ShowHTML) Name() string {
 	return "ShowHTML"
 }
 
+// Successors get possible next states, the payment validator decides which one is taken
+func (ShowHTML) Successors() []string {
+	return paymentFlowSuccessors()
+}
+
 // Run the state operations
 func (sh ShowHTML) Run(ctx context.Context, p *process.Process) process.RunResult {
 	ctx, span := trace.StartSpan(ctx, "placeorder/state/ShowHTML/Run")
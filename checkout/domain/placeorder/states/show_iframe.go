@@ -18,7 +18,10 @@ type (
 	}
 )
 
-var _ process.State = ShowIframe{}
+var (
+	_ process.State               = ShowIframe{}
+	_ process.StateWithSuccessors = ShowIframe{}
+)
 
 func init() {
 	gob.Register(&url.URL{})
@@ -45,6 +48,11 @@ func (ShowIframe) Name() string {
 	return "ShowIframe"
 }
 
+// Successors get possible next states, the payment validator decides which one is taken
+func (ShowIframe) Successors() []string {
+	return paymentFlowSuccessors()
+}
+
 // Run the state operations
 func (si ShowIframe) Run(ctx context.Context, p *process.Process) process.RunResult {
 	ctx, span := trace.StartSpan(ctx, "placeorder/state/ShowIframe/Run")
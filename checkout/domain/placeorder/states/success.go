@@ -4,26 +4,60 @@ import (
 	"context"
 
 	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+	"flamingo.me/flamingo-commerce/v3/payment/application"
+	"flamingo.me/flamingo/v3/framework/flamingo"
 	"go.opencensus.io/trace"
 )
 
 type (
 	// Success state
-	Success struct{}
+	Success struct {
+		loyaltyPaymentService *application.LoyaltyPaymentService
+		eventRouter           flamingo.EventRouter
+	}
+
+	// SuccessEvent is dispatched when a place order process reached the Success state, e.g. to notify the customer
+	SuccessEvent struct {
+		Context process.Context
+	}
 )
 
 var _ process.State = Success{}
 
+// Inject dependencies
+func (s *Success) Inject(
+	loyaltyPaymentService *application.LoyaltyPaymentService,
+	eventRouter flamingo.EventRouter,
+) *Success {
+	s.loyaltyPaymentService = loyaltyPaymentService
+	s.eventRouter = eventRouter
+
+	return s
+}
+
 // Name get state name
 func (s Success) Name() string {
 	return "Success"
 }
 
 // Run the state operations
-func (s Success) Run(ctx context.Context, _ *process.Process) process.RunResult {
-	_, span := trace.StartSpan(ctx, "placeorder/state/Success/Run")
+func (s Success) Run(ctx context.Context, p *process.Process) process.RunResult {
+	ctx, span := trace.StartSpan(ctx, "placeorder/state/Success/Run")
 	defer span.End()
 
+	if s.loyaltyPaymentService != nil {
+		for _, reference := range p.Context().RollbackReferences {
+			if rollbackData, ok := reference.Data.(CreatePaymentRollbackData); ok && len(rollbackData.LoyaltyReservations) > 0 {
+				// the order is placed anyways, errors are logged by the service
+				_ = s.loyaltyPaymentService.CommitPoints(ctx, rollbackData.LoyaltyReservations)
+			}
+		}
+	}
+
+	if s.eventRouter != nil {
+		s.eventRouter.Dispatch(ctx, &SuccessEvent{Context: p.Context()})
+	}
+
 	return process.RunResult{}
 }
 
@@ -6,9 +6,20 @@ import (
 
 	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
 	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/states"
+	"flamingo.me/flamingo/v3/framework/flamingo"
 	"github.com/stretchr/testify/assert"
 )
 
+type (
+	eventRouter struct {
+		events []flamingo.Event
+	}
+)
+
+func (e *eventRouter) Dispatch(_ context.Context, event flamingo.Event) {
+	e.events = append(e.events, event)
+}
+
 func TestSuccess_IsFinal(t *testing.T) {
 	s := states.Success{}
 	assert.True(t, s.IsFinal())
@@ -28,3 +39,12 @@ func TestSuccess_Run(t *testing.T) {
 	s := states.Success{}
 	assert.Equal(t, s.Run(context.Background(), &process.Process{}), process.RunResult{})
 }
+
+func TestSuccess_RunDispatchesSuccessEvent(t *testing.T) {
+	router := &eventRouter{}
+	s := new(states.Success).Inject(nil, router)
+	p := &process.Process{}
+
+	assert.Equal(t, process.RunResult{}, s.Run(context.Background(), p))
+	assert.Equal(t, []flamingo.Event{&states.SuccessEvent{Context: p.Context()}}, router.events)
+}
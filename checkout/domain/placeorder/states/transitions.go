@@ -0,0 +1,42 @@
+package states
+
+import (
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+)
+
+// DefaultTransitions returns the transitions performed by the core place order states, derived from their Successors.
+// The process.Transitions read the successors of all bound states, so the core transitions don't have to be bound,
+// use it to set up process.Transitions without the map of all states.
+func DefaultTransitions() []process.Transition {
+	coreStates := []interface {
+		process.State
+		process.StateWithSuccessors
+	}{
+		New{},
+		PrepareCart{},
+		ValidateCart{},
+		ValidatePaymentSelection{},
+		CreatePayment{},
+		CompleteCart{},
+		PlaceOrder{},
+		CompletePayment{},
+		ValidatePayment{},
+		PostRedirect{},
+		Redirect{},
+		ShowHTML{},
+		ShowIframe{},
+		WaitForCustomer{},
+	}
+
+	var transitions []process.Transition
+	for _, state := range coreStates {
+		from := state.Name()
+		for _, to := range state.Successors() {
+			if from != to {
+				transitions = append(transitions, process.Transition{From: from, To: to})
+			}
+		}
+	}
+
+	return transitions
+}
@@ -0,0 +1,61 @@
+package states_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/states"
+)
+
+func coreStates() map[string]process.State {
+	allStates := make(map[string]process.State)
+	for _, state := range []process.State{
+		states.New{},
+		states.PrepareCart{},
+		states.ValidateCart{},
+		states.ValidatePaymentSelection{},
+		states.CreatePayment{},
+		states.CompleteCart{},
+		states.CompletePayment{},
+		states.PlaceOrder{},
+		states.ValidatePayment{},
+		states.WaitForCustomer{},
+		states.Success{},
+		states.Failed{},
+		states.ShowIframe{},
+		states.ShowHTML{},
+		states.Redirect{},
+		states.PostRedirect{},
+	} {
+		allStates[state.Name()] = state
+	}
+
+	return allStates
+}
+
+// TestDefaultTransitions checks that the default transitions are the successors declared by the core states
+func TestDefaultTransitions(t *testing.T) {
+	transitions := new(process.Transitions).Inject(nil, nil)
+	assert.ElementsMatch(t, transitions.Graph(coreStates()), states.DefaultTransitions())
+}
+
+// TestSuccessors checks that the successors declared by the core states alone form a valid graph
+func TestSuccessors(t *testing.T) {
+	allStates := coreStates()
+
+	transitions := new(process.Transitions).Inject(nil, nil)
+	assert.NoError(t, transitions.Validate(allStates, states.New{}.Name(), states.Failed{}.Name()))
+
+	allStates[states.WaitForApproval{}.Name()] = states.WaitForApproval{}
+	assert.Error(t, transitions.Validate(allStates, states.New{}.Name(), states.Failed{}.Name()), "WaitForApproval is unreachable if not inserted")
+
+	transitions = new(process.Transitions).Inject(&struct {
+		Transitions []process.Transition `inject:",optional"`
+		Insertions  []process.Insertion  `inject:",optional"`
+	}{
+		Insertions: []process.Insertion{{State: states.WaitForApproval{}.Name(), After: states.ValidateCart{}.Name()}},
+	}, nil)
+	assert.NoError(t, transitions.Validate(allStates, states.New{}.Name(), states.Failed{}.Name()))
+}
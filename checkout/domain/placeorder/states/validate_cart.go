@@ -17,7 +17,10 @@ type (
 	}
 )
 
-var _ process.State = ValidateCart{}
+var (
+	_ process.State               = ValidateCart{}
+	_ process.StateWithSuccessors = ValidateCart{}
+)
 
 // Inject dependencies
 func (v *ValidateCart) Inject(
@@ -33,12 +36,35 @@ func (ValidateCart) Name() string {
 	return "ValidateCart"
 }
 
+// Successors get possible next states
+func (ValidateCart) Successors() []string {
+	return []string{ValidatePaymentSelection{}.Name(), CompleteCart{}.Name()}
+}
+
 // Run the state operations
 func (v ValidateCart) Run(ctx context.Context, p *process.Process) process.RunResult {
 	ctx, span := trace.StartSpan(ctx, "placeorder/state/ValidateCart/Run")
 	defer span.End()
 
-	result, err := v.cartService.ValidateCurrentCart(ctx, web.SessionFromContext(ctx))
+	session := web.SessionFromContext(ctx)
+	result, err := v.cartService.ValidateCurrentCart(ctx, session)
+	if err != nil {
+		return process.RunResult{
+			Failed: process.ErrorOccurredReason{Error: err.Error()},
+		}
+	}
+
+	if !result.IsValid() {
+		return process.RunResult{
+			Failed: process.CartValidationErrorReason{
+				ValidationResult: result,
+			},
+		}
+	}
+
+	// re-check the qty restrictions, e.g. purchase limits may be exceeded by orders placed in the meantime
+	cart := p.Context().Cart
+	result, err = v.cartService.ValidateQtyRestrictions(ctx, session, &cart)
 	if err != nil {
 		return process.RunResult{
 			Failed: process.ErrorOccurredReason{Error: err.Error()},
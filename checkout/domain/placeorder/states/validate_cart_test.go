@@ -13,6 +13,7 @@ import (
 	"flamingo.me/flamingo-commerce/v3/cart/application"
 	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/cart/mocks"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/coupon"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/placeorder"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
@@ -91,6 +92,7 @@ func TestValidateCart_Run(t *testing.T) {
 			result.Inject(
 				nil,
 				flamingo.NullLogger{},
+				nil,
 			)
 
 			return result
@@ -115,10 +117,12 @@ func TestValidateCart_Run(t *testing.T) {
 				new(flamingo.NullLogger),
 				nil,
 				&struct {
-					CartValidator     validation.Validator     `inject:",optional"`
-					ItemValidator     validation.ItemValidator `inject:",optional"`
-					CartCache         application.CartCache    `inject:",optional"`
-					PlaceOrderService placeorder.Service       `inject:",optional"`
+					CartValidator       validation.Validator            `inject:",optional"`
+					ItemValidator       validation.ItemValidator        `inject:",optional"`
+					CartCache           application.CartCache           `inject:",optional"`
+					PlaceOrderService   placeorder.Service              `inject:",optional"`
+					CodeAttemptLimiter  *application.CodeAttemptLimiter `inject:",optional"`
+					CouponUsageReserver coupon.UsageReserver            `inject:",optional"`
 				}{CartValidator: &validator{Valid: tt.isValid}, ItemValidator: nil, CartCache: nil, PlaceOrderService: nil},
 			)
 			state := new(states.ValidateCart).Inject(&cartService)
@@ -0,0 +1,101 @@
+package states
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/web"
+	"go.opencensus.io/trace"
+
+	"flamingo.me/flamingo-commerce/v3/cart/application"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+)
+
+type (
+	// ValidateDeliveries state is inserted after ValidateCart, it checks that all deliveries are complete before the payment
+	ValidateDeliveries struct {
+		cartService       *application.CartService
+		deliveryValidator *validation.DeliveryValidator
+	}
+)
+
+var _ process.State = ValidateDeliveries{}
+
+// Inject dependencies
+func (v *ValidateDeliveries) Inject(
+	cartService *application.CartService,
+	deliveryValidator *validation.DeliveryValidator,
+) *ValidateDeliveries {
+	v.cartService = cartService
+	v.deliveryValidator = deliveryValidator
+
+	return v
+}
+
+// Name get state name
+func (ValidateDeliveries) Name() string {
+	return "ValidateDeliveries"
+}
+
+// Run the state operations
+func (v ValidateDeliveries) Run(ctx context.Context, p *process.Process) process.RunResult {
+	ctx, span := trace.StartSpan(ctx, "placeorder/state/ValidateDeliveries/Run")
+	defer span.End()
+
+	c := p.Context().Cart
+	if hasEmptyDelivery(c.Deliveries) {
+		updatedCart, err := v.cartService.DeleteEmptyDeliveries(ctx, web.SessionFromContext(ctx))
+		if err != nil {
+			return process.RunResult{
+				Failed: process.ErrorOccurredReason{Error: err.Error()},
+			}
+		}
+
+		c = *updatedCart
+		p.UpdateCart(c)
+	}
+
+	result, err := v.deliveryValidator.Validate(ctx, c)
+	if err != nil {
+		return process.RunResult{
+			Failed:    process.ErrorOccurredReason{Error: err.Error()},
+			Transient: process.IsTransientError(err),
+		}
+	}
+
+	if !result.IsValid() {
+		return process.RunResult{
+			Failed: process.DeliveryValidationErrorReason{ValidationResult: result},
+		}
+	}
+
+	// proceed with the transition of ValidateCart
+	if err := p.Continue(); err != nil {
+		return process.RunResult{
+			Failed: process.ErrorOccurredReason{Error: err.Error()},
+		}
+	}
+
+	return process.RunResult{}
+}
+
+// Rollback the state operations
+func (v ValidateDeliveries) Rollback(context.Context, process.RollbackData) error {
+	return nil
+}
+
+// IsFinal if state is a final state
+func (v ValidateDeliveries) IsFinal() bool {
+	return false
+}
+
+func hasEmptyDelivery(deliveries []cart.Delivery) bool {
+	for _, delivery := range deliveries {
+		if len(delivery.Cartitems) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,112 @@
+package states_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/states"
+)
+
+// provideValidateDeliveriesProcess returns a process that left ValidateCart and waits for the delivery validation
+func provideValidateDeliveriesProcess(t *testing.T, cart cartDomain.Cart) *process.Process {
+	t.Helper()
+
+	transitions := new(process.Transitions).Inject(&struct {
+		Transitions []process.Transition `inject:",optional"`
+		Insertions  []process.Insertion  `inject:",optional"`
+	}{
+		Insertions: []process.Insertion{{State: states.ValidateDeliveries{}.Name(), After: states.ValidateCart{}.Name()}},
+	}, nil)
+
+	factory := &process.Factory{}
+	factory.Inject(
+		func() *process.Process {
+			return new(process.Process).Inject(nil, transitions, flamingo.NullLogger{}, nil, nil)
+		},
+		&struct {
+			StartState  process.State `inject:"startState"`
+			FailedState process.State `inject:"failedState"`
+		}{
+			StartState: &states.New{},
+		},
+	)
+
+	p, err := factory.New(&url.URL{}, cart)
+	require.NoError(t, err)
+
+	p.UpdateState(states.ValidateCart{}.Name(), nil)
+	p.UpdateState(states.ValidatePaymentSelection{}.Name(), nil)
+	require.Equal(t, states.ValidateDeliveries{}.Name(), p.Context().CurrentStateName)
+
+	return p
+}
+
+func TestValidateDeliveries_IsFinal(t *testing.T) {
+	s := states.ValidateDeliveries{}
+	assert.False(t, s.IsFinal())
+}
+
+func TestValidateDeliveries_Name(t *testing.T) {
+	s := states.ValidateDeliveries{}
+	assert.Equal(t, "ValidateDeliveries", s.Name())
+}
+
+func TestValidateDeliveries_Rollback(t *testing.T) {
+	s := states.ValidateDeliveries{}
+	assert.Nil(t, s.Rollback(context.Background(), nil))
+}
+
+func TestValidateDeliveries_Run(t *testing.T) {
+	state := new(states.ValidateDeliveries).Inject(nil, new(validation.DeliveryValidator))
+
+	t.Run("complete deliveries", func(t *testing.T) {
+		p := provideValidateDeliveriesProcess(t, cartDomain.Cart{
+			Deliveries: []cartDomain.Delivery{
+				{
+					DeliveryInfo: cartDomain.DeliveryInfo{
+						Code:             "store",
+						Workflow:         cartDomain.DeliveryWorkflowPickup,
+						Method:           "pickup",
+						DeliveryLocation: cartDomain.DeliveryLocation{Code: "store1"},
+					},
+					Cartitems: []cartDomain.Item{{ID: "item"}},
+				},
+			},
+		})
+
+		assert.Equal(t, process.RunResult{}, state.Run(context.Background(), p))
+		assert.Equal(t, states.ValidatePaymentSelection{}.Name(), p.Context().CurrentStateName)
+	})
+
+	t.Run("incomplete deliveries", func(t *testing.T) {
+		p := provideValidateDeliveriesProcess(t, cartDomain.Cart{
+			Deliveries: []cartDomain.Delivery{
+				{
+					DeliveryInfo: cartDomain.DeliveryInfo{
+						Code:     "home",
+						Workflow: cartDomain.DeliveryWorkflowDelivery,
+						Method:   "standard",
+					},
+					Cartitems: []cartDomain.Item{{ID: "item"}},
+				},
+			},
+		})
+
+		result := state.Run(context.Background(), p)
+		assert.Equal(t, process.DeliveryValidationErrorReason{
+			ValidationResult: validation.DeliveryValidationResult{
+				DeliveryErrors: []validation.DeliveryValidationError{
+					{DeliveryCode: "home", ErrorMessageKey: validation.DeliveryErrorAddressMissing},
+				},
+			},
+		}, result.Failed)
+	})
+}
@@ -16,7 +16,10 @@ type (
 	}
 )
 
-var _ process.State = ValidatePayment{}
+var (
+	_ process.State               = ValidatePayment{}
+	_ process.StateWithSuccessors = ValidatePayment{}
+)
 
 // Inject dependencies
 func (v *ValidatePayment) Inject(
@@ -34,6 +37,11 @@ func (ValidatePayment) Name() string {
 	return "ValidatePayment"
 }
 
+// Successors get possible next states, the payment validator decides which one is taken
+func (ValidatePayment) Successors() []string {
+	return paymentFlowSuccessors()
+}
+
 // Run the state operations
 func (v ValidatePayment) Run(ctx context.Context, p *process.Process) process.RunResult {
 	ctx, span := trace.StartSpan(ctx, "placeorder/state/ValidatePayment/Run")
@@ -51,3 +59,16 @@ func (v ValidatePayment) Rollback(ctx context.Context, _ process.RollbackData) e
 func (v ValidatePayment) IsFinal() bool {
 	return false
 }
+
+// paymentFlowSuccessors are the states the payment validator switches to
+func paymentFlowSuccessors() []string {
+	return []string{
+		PostRedirect{}.Name(),
+		Redirect{}.Name(),
+		ShowHTML{}.Name(),
+		ShowIframe{}.Name(),
+		WaitForCustomer{}.Name(),
+		CompletePayment{}.Name(),
+		Success{}.Name(),
+	}
+}
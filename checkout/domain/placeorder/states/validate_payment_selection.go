@@ -19,7 +19,10 @@ type (
 	}
 )
 
-var _ process.State = ValidatePaymentSelection{}
+var (
+	_ process.State               = ValidatePaymentSelection{}
+	_ process.StateWithSuccessors = ValidatePaymentSelection{}
+)
 
 // Inject dependencies
 func (v *ValidatePaymentSelection) Inject(
@@ -41,6 +44,11 @@ func (ValidatePaymentSelection) Name() string {
 	return "ValidatePaymentSelection"
 }
 
+// Successors get possible next states
+func (ValidatePaymentSelection) Successors() []string {
+	return []string{CreatePayment{}.Name()}
+}
+
 // Run the state operations
 func (v ValidatePaymentSelection) Run(ctx context.Context, p *process.Process) process.RunResult {
 	_, span := trace.StartSpan(ctx, "placeorder/state/ValidatePaymentSelection/Run")
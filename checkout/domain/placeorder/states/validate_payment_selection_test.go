@@ -110,6 +110,7 @@ func TestValidatePaymentSelection_Run(t *testing.T) {
 					result.Inject(
 						nil,
 						flamingo.NullLogger{},
+						nil,
 					)
 
 					return result
@@ -0,0 +1,137 @@
+package states
+
+import (
+	"context"
+	"encoding/gob"
+	"fmt"
+
+	"go.opencensus.io/trace"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/approval"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+)
+
+type (
+	// WaitForApproval state is inserted after ValidateCart, the process waits here until an approver decided about the order
+	WaitForApproval struct {
+		approvalService *approval.Service
+		policy          approval.Policy
+	}
+
+	// WaitForApprovalData holds the id of the pending approval request
+	WaitForApprovalData struct {
+		ApprovalID string
+	}
+
+	// WaitForApprovalRollbackData needed for rollback
+	WaitForApprovalRollbackData struct {
+		ApprovalID string
+	}
+)
+
+var _ process.State = WaitForApproval{}
+
+func init() {
+	gob.Register(WaitForApprovalData{})
+	gob.Register(WaitForApprovalRollbackData{})
+}
+
+// Inject dependencies
+func (w *WaitForApproval) Inject(
+	approvalService *approval.Service,
+	policy approval.Policy,
+) *WaitForApproval {
+	w.approvalService = approvalService
+	w.policy = policy
+
+	return w
+}
+
+// Name get state name
+func (WaitForApproval) Name() string {
+	return "WaitForApproval"
+}
+
+// Run the state operations
+func (w WaitForApproval) Run(ctx context.Context, p *process.Process) process.RunResult {
+	ctx, span := trace.StartSpan(ctx, "placeorder/state/WaitForApproval/Run")
+	defer span.End()
+
+	stateData, ok := p.Context().CurrentStateData.(WaitForApprovalData)
+	if !ok {
+		return w.requestApproval(ctx, p)
+	}
+
+	request, err := w.approvalService.Get(ctx, stateData.ApprovalID)
+	if err != nil {
+		return process.RunResult{
+			Failed: process.ErrorOccurredReason{Error: err.Error()},
+		}
+	}
+
+	switch request.Status {
+	case approval.StatusPending:
+		return process.RunResult{}
+	case approval.StatusApproved:
+		return w.proceed(p)
+	case approval.StatusRejected:
+		return process.RunResult{
+			Failed: process.ApprovalRejectedReason{ApprovalID: request.ID, Comment: request.Comment},
+		}
+	}
+
+	return process.RunResult{
+		Failed: process.ErrorOccurredReason{Error: fmt.Sprintf("approval request %q is %s", request.ID, request.Status)},
+	}
+}
+
+func (w WaitForApproval) requestApproval(ctx context.Context, p *process.Process) process.RunResult {
+	requirement, err := w.policy.Check(ctx, p.Context().Cart)
+	if err != nil {
+		return process.RunResult{
+			Failed: process.ErrorOccurredReason{Error: err.Error()},
+		}
+	}
+
+	if !requirement.Required {
+		return w.proceed(p)
+	}
+
+	request, err := w.approvalService.Request(ctx, p.Context().UUID, p.Context().Cart, requirement)
+	if err != nil {
+		return process.RunResult{
+			Failed: process.ErrorOccurredReason{Error: err.Error()},
+		}
+	}
+
+	p.UpdateState(w.Name(), WaitForApprovalData{ApprovalID: request.ID})
+	return process.RunResult{
+		RollbackData: WaitForApprovalRollbackData{ApprovalID: request.ID},
+	}
+}
+
+// proceed with the transition of ValidateCart
+func (w WaitForApproval) proceed(p *process.Process) process.RunResult {
+	if err := p.Continue(); err != nil {
+		return process.RunResult{
+			Failed: process.ErrorOccurredReason{Error: err.Error()},
+		}
+	}
+
+	return process.RunResult{}
+}
+
+// Rollback the state operations, a still pending approval request is cancelled
+func (w WaitForApproval) Rollback(ctx context.Context, data process.RollbackData) error {
+	rollbackData, ok := data.(WaitForApprovalRollbackData)
+	if !ok {
+		return fmt.Errorf("rollback data not of expected type 'WaitForApprovalRollbackData', but %T", rollbackData)
+	}
+
+	return w.approvalService.Cancel(ctx, rollbackData.ApprovalID)
+}
+
+// IsFinal if state is a final state
+func (w WaitForApproval) IsFinal() bool {
+	return false
+}
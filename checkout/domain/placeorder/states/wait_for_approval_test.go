@@ -0,0 +1,189 @@
+package states_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/approval"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/states"
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+type (
+	approvalPolicy struct {
+		requirement approval.Requirement
+	}
+
+	approvalStore struct {
+		requests map[string]approval.Request
+	}
+)
+
+func (a *approvalPolicy) Check(context.Context, cartDomain.Cart) (approval.Requirement, error) {
+	return a.requirement, nil
+}
+
+func (a *approvalStore) Save(_ context.Context, request approval.Request) error {
+	a.requests[request.ID] = request
+	return nil
+}
+
+func (a *approvalStore) Get(_ context.Context, id string) (*approval.Request, error) {
+	request, ok := a.requests[id]
+	if !ok {
+		return nil, approval.ErrNotFound
+	}
+	return &request, nil
+}
+
+// provideApprovalProcess returns a process that left ValidateCart and waits for the approval
+func provideApprovalProcess(t *testing.T, cart cartDomain.Cart) *process.Process {
+	t.Helper()
+
+	transitions := new(process.Transitions).Inject(&struct {
+		Transitions []process.Transition `inject:",optional"`
+		Insertions  []process.Insertion  `inject:",optional"`
+	}{
+		Transitions: states.DefaultTransitions(),
+		Insertions:  []process.Insertion{{State: states.WaitForApproval{}.Name(), After: states.ValidateCart{}.Name()}},
+	}, nil)
+
+	factory := &process.Factory{}
+	factory.Inject(
+		func() *process.Process {
+			return new(process.Process).Inject(nil, transitions, flamingo.NullLogger{}, nil, nil)
+		},
+		&struct {
+			StartState  process.State `inject:"startState"`
+			FailedState process.State `inject:"failedState"`
+		}{
+			StartState: &states.New{},
+		},
+	)
+
+	p, err := factory.New(&url.URL{}, cart)
+	require.NoError(t, err)
+
+	p.UpdateState(states.ValidateCart{}.Name(), nil)
+	p.UpdateState(states.ValidatePaymentSelection{}.Name(), nil)
+	require.Equal(t, states.WaitForApproval{}.Name(), p.Context().CurrentStateName)
+
+	return p
+}
+
+func TestWaitForApproval_IsFinal(t *testing.T) {
+	s := states.WaitForApproval{}
+	assert.False(t, s.IsFinal())
+}
+
+func TestWaitForApproval_Name(t *testing.T) {
+	s := states.WaitForApproval{}
+	assert.Equal(t, "WaitForApproval", s.Name())
+}
+
+func TestWaitForApproval_Run(t *testing.T) {
+	payableCart := cartDomain.Cart{
+		AuthenticatedUserID: "buyer",
+		Totalitems:          []cartDomain.Totalitem{{Code: "total", Price: domain.NewFromFloat(500, "EUR")}},
+	}
+
+	t.Run("approval not required", func(t *testing.T) {
+		state := new(states.WaitForApproval).Inject(new(approval.Service).Inject(&approvalStore{requests: map[string]approval.Request{}}, nil), &approvalPolicy{})
+		p := provideApprovalProcess(t, payableCart)
+
+		assert.Equal(t, process.RunResult{}, state.Run(context.Background(), p))
+		assert.Equal(t, states.ValidatePaymentSelection{}.Name(), p.Context().CurrentStateName)
+	})
+
+	t.Run("continues with the transition of ValidateCart", func(t *testing.T) {
+		state := new(states.WaitForApproval).Inject(new(approval.Service).Inject(&approvalStore{requests: map[string]approval.Request{}}, nil), &approvalPolicy{})
+		p := provideApprovalProcess(t, payableCart)
+		p.UpdateState(states.ValidateCart{}.Name(), nil)
+		p.UpdateState(states.CompleteCart{}.Name(), nil)
+
+		assert.Equal(t, process.RunResult{}, state.Run(context.Background(), p))
+		assert.Equal(t, states.CompleteCart{}.Name(), p.Context().CurrentStateName)
+	})
+
+	t.Run("not inserted", func(t *testing.T) {
+		state := new(states.WaitForApproval).Inject(new(approval.Service).Inject(&approvalStore{requests: map[string]approval.Request{}}, nil), &approvalPolicy{})
+		p, _ := provideProcessFactory(t).New(&url.URL{}, payableCart)
+
+		result := state.Run(context.Background(), p)
+		assert.Equal(t, process.ErrorOccurredReason{Error: process.ErrNoPendingTransition.Error()}, result.Failed)
+	})
+
+	t.Run("approved", func(t *testing.T) {
+		approvalService := new(approval.Service).Inject(&approvalStore{requests: map[string]approval.Request{}}, nil)
+		state := new(states.WaitForApproval).Inject(approvalService, &approvalPolicy{requirement: approval.Requirement{Required: true, Approvers: []string{"manager"}}})
+		p := provideApprovalProcess(t, payableCart)
+
+		result := state.Run(context.Background(), p)
+		stateData, ok := p.Context().CurrentStateData.(states.WaitForApprovalData)
+		require.True(t, ok)
+		assert.Equal(t, states.WaitForApprovalRollbackData{ApprovalID: stateData.ApprovalID}, result.RollbackData)
+		assert.Equal(t, state.Name(), p.Context().CurrentStateName)
+
+		// the process waits until the request is decided
+		assert.Equal(t, process.RunResult{}, state.Run(context.Background(), p))
+		assert.Equal(t, state.Name(), p.Context().CurrentStateName)
+
+		_, err := approvalService.Approve(context.Background(), stateData.ApprovalID, "buyer", "")
+		assert.Equal(t, approval.ErrNotAllowed, err)
+		_, err = approvalService.Approve(context.Background(), stateData.ApprovalID, "manager", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, process.RunResult{}, state.Run(context.Background(), p))
+		assert.Equal(t, states.ValidatePaymentSelection{}.Name(), p.Context().CurrentStateName)
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		approvalService := new(approval.Service).Inject(&approvalStore{requests: map[string]approval.Request{}}, nil)
+		state := new(states.WaitForApproval).Inject(approvalService, &approvalPolicy{requirement: approval.Requirement{Required: true, Approvers: []string{"manager"}}})
+		p := provideApprovalProcess(t, payableCart)
+
+		state.Run(context.Background(), p)
+		stateData := p.Context().CurrentStateData.(states.WaitForApprovalData)
+		_, err := approvalService.Reject(context.Background(), stateData.ApprovalID, "manager", "budget exhausted")
+		require.NoError(t, err)
+
+		expected := process.RunResult{
+			Failed: process.ApprovalRejectedReason{ApprovalID: stateData.ApprovalID, Comment: "budget exhausted"},
+		}
+		assert.Equal(t, expected, state.Run(context.Background(), p))
+	})
+
+	t.Run("required approval without approvers", func(t *testing.T) {
+		store := &approvalStore{requests: map[string]approval.Request{}}
+		state := new(states.WaitForApproval).Inject(new(approval.Service).Inject(store, nil), &approvalPolicy{requirement: approval.Requirement{Required: true}})
+		p := provideApprovalProcess(t, payableCart)
+
+		result := state.Run(context.Background(), p)
+		assert.Equal(t, process.ErrorOccurredReason{Error: approval.ErrNoApprovers.Error()}, result.Failed)
+		assert.Empty(t, store.requests)
+	})
+}
+
+func TestWaitForApproval_Rollback(t *testing.T) {
+	store := &approvalStore{requests: map[string]approval.Request{}}
+	approvalService := new(approval.Service).Inject(store, nil)
+	state := new(states.WaitForApproval).Inject(approvalService, &approvalPolicy{})
+
+	request, err := approvalService.Request(context.Background(), "process", cartDomain.Cart{}, approval.Requirement{Required: true, Approvers: []string{"manager"}})
+	require.NoError(t, err)
+
+	assert.NoError(t, state.Rollback(context.Background(), states.WaitForApprovalRollbackData{ApprovalID: request.ID}))
+	assert.Equal(t, approval.StatusCancelled, store.requests[request.ID].Status)
+
+	_, err = approvalService.Approve(context.Background(), request.ID, "manager", "")
+	assert.Equal(t, approval.ErrAlreadyDecided, err)
+
+	assert.Error(t, state.Rollback(context.Background(), nil))
+}
@@ -16,7 +16,10 @@ type (
 	}
 )
 
-var _ process.State = WaitForCustomer{}
+var (
+	_ process.State               = WaitForCustomer{}
+	_ process.StateWithSuccessors = WaitForCustomer{}
+)
 
 // Inject dependencies
 func (wc *WaitForCustomer) Inject(
@@ -34,6 +37,11 @@ func (WaitForCustomer) Name() string {
 	return "WaitForCustomer"
 }
 
+// Successors get possible next states, the payment validator decides which one is taken
+func (WaitForCustomer) Successors() []string {
+	return paymentFlowSuccessors()
+}
+
 // Run the state operations
 func (wc WaitForCustomer) Run(ctx context.Context, p *process.Process) process.RunResult {
 	ctx, span := trace.StartSpan(ctx, "placeorder/state/WaitForCustomer/Run")
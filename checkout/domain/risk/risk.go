@@ -0,0 +1,118 @@
+package risk
+
+import (
+	"context"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+)
+
+const (
+	// DecisionAccept lets the place order process continue
+	DecisionAccept = "accept"
+	// DecisionReview lets the place order process continue, the placed orders are marked for a manual review
+	DecisionReview = "review"
+	// DecisionReject fails the place order process
+	DecisionReject = "reject"
+)
+
+type (
+	// Checker is the secondary port that screens an order before the payment is captured, multiple checkers can be bound
+	Checker interface {
+		// Check returns the assessment of the order
+		Check(ctx context.Context, input Input) (Assessment, error)
+	}
+
+	// Input of the Checker
+	Input struct {
+		ProcessUUID       string
+		Cart              cart.Cart
+		BillingAddress    *cart.Address
+		ShippingAddresses []cart.Address
+		Customer          Customer
+		// IP of the client that started the place order process
+		IP string
+		// UserAgent of the client that started the place order process
+		UserAgent string
+	}
+
+	// Customer identity of the order
+	Customer struct {
+		// ID of the authenticated user, empty for guest orders
+		ID    string
+		Email string
+	}
+
+	// Assessment returned by the Checker
+	Assessment struct {
+		Decision string
+		// Score of the order, higher scores are riskier
+		Score float64
+		// Reasons explain the score, e.g. the triggered rules
+		Reasons []string
+	}
+)
+
+// NewInput collects the addresses and the customer identity of the cart
+func NewInput(processUUID string, c cart.Cart, ip string, userAgent string) Input {
+	input := Input{
+		ProcessUUID:    processUUID,
+		Cart:           c,
+		BillingAddress: c.BillingAddress,
+		Customer: Customer{
+			ID:    c.AuthenticatedUserID,
+			Email: c.GetContactMail(),
+		},
+		IP:        ip,
+		UserAgent: userAgent,
+	}
+
+	for _, delivery := range c.Deliveries {
+		location := delivery.DeliveryInfo.DeliveryLocation
+		if location.UseBillingAddress && c.BillingAddress != nil {
+			input.ShippingAddresses = append(input.ShippingAddresses, *c.BillingAddress)
+			continue
+		}
+
+		if location.Address != nil {
+			input.ShippingAddresses = append(input.ShippingAddresses, *location.Address)
+		}
+	}
+
+	return input
+}
+
+// IsAccepted checks if the order may be placed without a review
+func (a Assessment) IsAccepted() bool {
+	return a.Decision == DecisionAccept
+}
+
+// IsRejected checks if the order must not be placed
+func (a Assessment) IsRejected() bool {
+	return a.Decision == DecisionReject
+}
+
+// Combine merges the assessments of several checkers: the strictest decision wins, the scores are summed up
+func Combine(assessments ...Assessment) Assessment {
+	combined := Assessment{Decision: DecisionAccept}
+	for _, assessment := range assessments {
+		if severity(assessment.Decision) > severity(combined.Decision) {
+			combined.Decision = assessment.Decision
+		}
+
+		combined.Score += assessment.Score
+		combined.Reasons = append(combined.Reasons, assessment.Reasons...)
+	}
+
+	return combined
+}
+
+func severity(decision string) int {
+	switch decision {
+	case DecisionReview:
+		return 1
+	case DecisionReject:
+		return 2
+	}
+
+	return 0
+}
@@ -0,0 +1,82 @@
+package risk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/risk"
+)
+
+type (
+	checker struct {
+		assessment risk.Assessment
+		err        error
+	}
+)
+
+func (c checker) Check(context.Context, risk.Input) (risk.Assessment, error) {
+	return c.assessment, c.err
+}
+
+func TestNewInput(t *testing.T) {
+	billing := &cart.Address{Email: "billing@example.com", CountryCode: "DE"}
+	shipping := &cart.Address{CountryCode: "FR"}
+	c := cart.Cart{
+		AuthenticatedUserID: "customer",
+		BillingAddress:      billing,
+		Deliveries: []cart.Delivery{
+			{DeliveryInfo: cart.DeliveryInfo{DeliveryLocation: cart.DeliveryLocation{Address: shipping}}},
+			{DeliveryInfo: cart.DeliveryInfo{DeliveryLocation: cart.DeliveryLocation{UseBillingAddress: true}}},
+			{DeliveryInfo: cart.DeliveryInfo{DeliveryLocation: cart.DeliveryLocation{Type: cart.DeliverylocationTypeCollectionpoint}}},
+		},
+	}
+
+	input := risk.NewInput("uuid", c, "127.0.0.1", "agent")
+
+	assert.Equal(t, "uuid", input.ProcessUUID)
+	assert.Equal(t, billing, input.BillingAddress)
+	assert.Equal(t, []cart.Address{*shipping, *billing}, input.ShippingAddresses)
+	assert.Equal(t, risk.Customer{ID: "customer", Email: "billing@example.com"}, input.Customer)
+	assert.Equal(t, "127.0.0.1", input.IP)
+	assert.Equal(t, "agent", input.UserAgent)
+}
+
+func TestService_Assess(t *testing.T) {
+	t.Run("no checkers", func(t *testing.T) {
+		assessment, err := new(risk.Service).Inject(nil).Assess(context.Background(), risk.Input{})
+		assert.NoError(t, err)
+		assert.True(t, assessment.IsAccepted())
+	})
+
+	t.Run("strictest decision wins", func(t *testing.T) {
+		service := new(risk.Service).Inject(&struct {
+			Checkers []risk.Checker `inject:",optional"`
+		}{
+			Checkers: []risk.Checker{
+				checker{assessment: risk.Assessment{Decision: risk.DecisionReview, Score: 20, Reasons: []string{"review"}}},
+				checker{assessment: risk.Assessment{Decision: risk.DecisionAccept, Score: 5}},
+				checker{assessment: risk.Assessment{Decision: risk.DecisionReject, Score: 80, Reasons: []string{"reject"}}},
+			},
+		})
+
+		assessment, err := service.Assess(context.Background(), risk.Input{})
+		assert.NoError(t, err)
+		assert.Equal(t, risk.Assessment{Decision: risk.DecisionReject, Score: 105, Reasons: []string{"review", "reject"}}, assessment)
+		assert.True(t, assessment.IsRejected())
+	})
+
+	t.Run("checker error", func(t *testing.T) {
+		service := new(risk.Service).Inject(&struct {
+			Checkers []risk.Checker `inject:",optional"`
+		}{
+			Checkers: []risk.Checker{checker{err: errors.New("unavailable")}},
+		})
+
+		_, err := service.Assess(context.Background(), risk.Input{})
+		assert.Error(t, err)
+	})
+}
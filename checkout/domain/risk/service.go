@@ -0,0 +1,41 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+)
+
+type (
+	// Service asks all bound checkers for their assessment of an order
+	Service struct {
+		checkers []Checker
+	}
+)
+
+// Inject dependencies
+func (s *Service) Inject(
+	optionals *struct {
+		Checkers []Checker `inject:",optional"`
+	},
+) *Service {
+	if optionals != nil {
+		s.checkers = optionals.Checkers
+	}
+
+	return s
+}
+
+// Assess the order with all checkers, an order without checkers is accepted
+func (s *Service) Assess(ctx context.Context, input Input) (Assessment, error) {
+	assessments := make([]Assessment, 0, len(s.checkers))
+	for _, checker := range s.checkers {
+		assessment, err := checker.Check(ctx, input)
+		if err != nil {
+			return Assessment{}, fmt.Errorf("risk checker %T failed: %w", checker, err)
+		}
+
+		assessments = append(assessments, assessment)
+	}
+
+	return Combine(assessments...), nil
+}
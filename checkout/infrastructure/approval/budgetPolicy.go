@@ -0,0 +1,60 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"flamingo.me/flamingo/v3/framework/config"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	approvalDomain "flamingo.me/flamingo-commerce/v3/checkout/domain/approval"
+)
+
+type (
+	// BudgetPolicy requires an approval for all orders with a grand total above the configured budget
+	BudgetPolicy struct {
+		budget    float64
+		approvers []string
+	}
+)
+
+var _ approvalDomain.Policy = new(BudgetPolicy)
+
+// Inject dependencies
+func (b *BudgetPolicy) Inject(
+	config *struct {
+		Budget    float64      `inject:"config:commerce.checkout.placeorder.approval.budget,optional"`
+		Approvers config.Slice `inject:"config:commerce.checkout.placeorder.approval.approvers,optional"`
+	},
+) *BudgetPolicy {
+	if config != nil {
+		b.budget = config.Budget
+		if config.Approvers != nil {
+			if err := config.Approvers.MapInto(&b.approvers); err != nil {
+				panic(fmt.Errorf("invalid config commerce.checkout.placeorder.approval.approvers: %w", err))
+			}
+		}
+	}
+
+	if len(b.approvers) == 0 {
+		panic(errors.New("invalid config commerce.checkout.placeorder.approval.approvers: the budget policy requires at least one approver"))
+	}
+
+	return b
+}
+
+// Check if the grand total of the cart exceeds the budget
+func (b *BudgetPolicy) Check(_ context.Context, cart cart.Cart) (approvalDomain.Requirement, error) {
+	grandTotal := cart.GrandTotal()
+	if !grandTotal.IsGreaterThenValue(*big.NewFloat(b.budget)) {
+		return approvalDomain.Requirement{}, nil
+	}
+
+	return approvalDomain.Requirement{
+		Required:  true,
+		Reason:    fmt.Sprintf("order total %.2f %s exceeds the budget of %.2f", grandTotal.FloatAmount(), grandTotal.Currency(), b.budget),
+		Approvers: b.approvers,
+	}, nil
+}
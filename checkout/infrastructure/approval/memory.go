@@ -0,0 +1,46 @@
+package approval
+
+import (
+	"context"
+	"sync"
+
+	approvalDomain "flamingo.me/flamingo-commerce/v3/checkout/domain/approval"
+)
+
+type (
+	// Memory saves all approval requests in a simple map
+	Memory struct {
+		mx       sync.RWMutex
+		requests map[string]approvalDomain.Request
+	}
+)
+
+var _ approvalDomain.Store = new(Memory)
+
+// Inject dependencies
+func (m *Memory) Inject() *Memory {
+	m.requests = make(map[string]approvalDomain.Request)
+
+	return m
+}
+
+// Save a given request
+func (m *Memory) Save(_ context.Context, request approvalDomain.Request) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	m.requests[request.ID] = request
+
+	return nil
+}
+
+// Get a stored request
+func (m *Memory) Get(_ context.Context, id string) (*approvalDomain.Request, error) {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+	request, ok := m.requests[id]
+	if !ok {
+		return nil, approvalDomain.ErrNotFound
+	}
+
+	return &request, nil
+}
@@ -3,6 +3,7 @@ package contextstore
 import (
 	"context"
 	"sync"
+	"time"
 
 	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
 )
@@ -10,16 +11,32 @@ import (
 type (
 	// Memory saves all contexts in a simple map
 	Memory struct {
-		mx      sync.RWMutex
-		storage map[string]process.Context
+		mx         sync.RWMutex
+		storage    map[string]process.Context
+		expiresAt  map[string]time.Time
+		deadlines  map[string]time.Time
+		references map[string]memoryReference
+	}
+
+	memoryReference struct {
+		key       string
+		expiresAt time.Time
 	}
 )
 
-var _ process.ContextStore = new(Memory)
+var (
+	_ process.ContextStore         = new(Memory)
+	_ process.ExpiringContextStore = new(Memory)
+	_ process.DeadlineContextStore = new(Memory)
+	_ process.ReferenceStore       = new(Memory)
+)
 
 // Inject dependencies
 func (m *Memory) Inject() *Memory {
 	m.storage = make(map[string]process.Context)
+	m.expiresAt = make(map[string]time.Time)
+	m.deadlines = make(map[string]time.Time)
+	m.references = make(map[string]memoryReference)
 
 	return m
 }
@@ -29,24 +46,108 @@ func (m *Memory) Store(_ context.Context, key string, value process.Context) err
 	m.mx.Lock()
 	defer m.mx.Unlock()
 	m.storage[key] = value
+	delete(m.expiresAt, key)
+	delete(m.deadlines, key)
 
 	return nil
 }
 
+// StoreWithDeadline stores a given context and indexes its key by the deadline
+func (m *Memory) StoreWithDeadline(_ context.Context, key string, value process.Context, deadline time.Time) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	m.storage[key] = value
+	delete(m.expiresAt, key)
+	m.deadlines[key] = deadline
+
+	return nil
+}
+
+// StoreWithExpiration stores a given context that is removed after the expiration
+func (m *Memory) StoreWithExpiration(_ context.Context, key string, value process.Context, expiration time.Duration) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	m.storage[key] = value
+	m.expiresAt[key] = time.Now().Add(expiration)
+	delete(m.deadlines, key)
+
+	m.removeExpired()
+
+	return nil
+}
+
+// removeExpired contexts, the caller has to hold the write lock
+func (m *Memory) removeExpired() {
+	now := time.Now()
+	for key, expiresAt := range m.expiresAt {
+		if now.After(expiresAt) {
+			delete(m.storage, key)
+			delete(m.expiresAt, key)
+		}
+	}
+}
+
 // Get a stored context
 func (m *Memory) Get(_ context.Context, key string) (process.Context, bool) {
 	m.mx.RLock()
 	defer m.mx.RUnlock()
 	value, ok := m.storage[key]
+	if expiresAt, expiring := m.expiresAt[key]; expiring && time.Now().After(expiresAt) {
+		return process.Context{}, false
+	}
 
 	return value, ok
 }
 
+// StoreReference to a key that is removed after the expiration
+func (m *Memory) StoreReference(_ context.Context, reference string, key string, expiration time.Duration) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	now := time.Now()
+	for ref, stored := range m.references {
+		if now.After(stored.expiresAt) {
+			delete(m.references, ref)
+		}
+	}
+
+	m.references[reference] = memoryReference{key: key, expiresAt: now.Add(expiration)}
+
+	return nil
+}
+
+// GetReference returns the key of the reference
+func (m *Memory) GetReference(_ context.Context, reference string) (string, bool, error) {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+	stored, ok := m.references[reference]
+	if !ok || time.Now().After(stored.expiresAt) {
+		return "", false, nil
+	}
+
+	return stored.key, true, nil
+}
+
+// KeysDueBefore returns the keys of all contexts stored with a deadline before the given time
+func (m *Memory) KeysDueBefore(_ context.Context, before time.Time) ([]string, error) {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+	var keys []string
+	for key, deadline := range m.deadlines {
+		if deadline.Before(before) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, nil
+}
+
 // Delete a stored context, nop if it doesn't exist
 func (m *Memory) Delete(_ context.Context, key string) error {
 	m.mx.Lock()
 	defer m.mx.Unlock()
 	delete(m.storage, key)
+	delete(m.expiresAt, key)
+	delete(m.deadlines, key)
 
 	return nil
 }
@@ -0,0 +1,85 @@
+package contextstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+	"flamingo.me/flamingo-commerce/v3/checkout/infrastructure/contextstore"
+)
+
+func TestMemory_StoreWithExpiration(t *testing.T) {
+	store := new(contextstore.Memory).Inject()
+	ctx := context.Background()
+
+	require.NoError(t, store.StoreWithExpiration(ctx, "expiring", process.Context{UUID: "expiring"}, 20*time.Millisecond))
+	require.NoError(t, store.StoreWithExpiration(ctx, "long", process.Context{UUID: "long"}, time.Hour))
+
+	got, found := store.Get(ctx, "expiring")
+	assert.True(t, found)
+	assert.Equal(t, "expiring", got.UUID)
+
+	time.Sleep(30 * time.Millisecond)
+
+	_, found = store.Get(ctx, "expiring")
+	assert.False(t, found, "expired contexts are not found")
+
+	got, found = store.Get(ctx, "long")
+	assert.True(t, found)
+	assert.Equal(t, "long", got.UUID)
+
+	require.NoError(t, store.StoreWithExpiration(ctx, "overwritten", process.Context{UUID: "overwritten"}, 20*time.Millisecond))
+	require.NoError(t, store.Store(ctx, "overwritten", process.Context{UUID: "overwritten"}))
+
+	time.Sleep(30 * time.Millisecond)
+
+	_, found = store.Get(ctx, "overwritten")
+	assert.True(t, found, "storing without expiration removes the expiration")
+}
+
+func TestMemory_KeysDueBefore(t *testing.T) {
+	store := new(contextstore.Memory).Inject()
+	ctx := context.Background()
+	now := time.Now()
+
+	require.NoError(t, store.StoreWithDeadline(ctx, "session-a", process.Context{UUID: "a"}, now.Add(-time.Minute)))
+	require.NoError(t, store.StoreWithDeadline(ctx, "session-b", process.Context{UUID: "b"}, now.Add(-time.Minute)))
+	require.NoError(t, store.StoreWithDeadline(ctx, "session-c", process.Context{UUID: "c"}, now.Add(time.Hour)))
+	require.NoError(t, store.StoreWithDeadline(ctx, "session-d", process.Context{UUID: "d"}, now.Add(-time.Minute)))
+	require.NoError(t, store.Store(ctx, "session-d", process.Context{UUID: "d"}))
+	require.NoError(t, store.Delete(ctx, "session-b"))
+
+	keys, err := store.KeysDueBefore(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"session-a"}, keys, "only contexts stored with a passed deadline are listed")
+}
+
+func TestMemory_References(t *testing.T) {
+	store := new(contextstore.Memory).Inject()
+	ctx := context.Background()
+
+	_, found, err := store.GetReference(ctx, "process_1")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, store.StoreReference(ctx, "process_1", "session-a", time.Hour))
+	require.NoError(t, store.StoreReference(ctx, "process_2", "session-b", 20*time.Millisecond))
+
+	key, found, err := store.GetReference(ctx, "process_1")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "session-a", key)
+
+	time.Sleep(30 * time.Millisecond)
+
+	_, found, err = store.GetReference(ctx, "process_2")
+	require.NoError(t, err)
+	assert.False(t, found, "expired references are not found")
+
+	_, found = store.Get(ctx, "process_1")
+	assert.False(t, found, "references are no contexts")
+}
@@ -26,12 +26,22 @@ type (
 )
 
 var (
-	_ process.ContextStore = new(Redis)
-	_ healthcheck.Status   = &Redis{}
+	_ process.ContextStore         = new(Redis)
+	_ process.ExpiringContextStore = new(Redis)
+	_ process.DeadlineContextStore = new(Redis)
+	_ process.ReferenceStore       = new(Redis)
+	_ healthcheck.Status           = &Redis{}
 	// ErrNoRedisConnection is returned if the underlying connection is erroneous
 	ErrNoRedisConnection = errors.New("no redis connection, see healthcheck")
 )
 
+const (
+	// deadlinesSet is the redis sorted set with the keys of the contexts stored with a deadline, scored by the deadline in milliseconds
+	deadlinesSet = "checkout_placeorder_contextstore_deadlines"
+	// referencePrefix separates the references from the contexts
+	referencePrefix = "checkout_placeorder_reference_"
+)
+
 func init() {
 	gob.Register(process.Context{})
 }
@@ -86,6 +96,73 @@ func (r *Redis) Store(ctx context.Context, key string, placeOrderContext process
 		key,
 		buffer,
 	)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do("ZREM", deadlinesSet, key)
+
+	return err
+}
+
+// StoreWithDeadline stores a given context and indexes its key by the deadline
+func (r *Redis) StoreWithDeadline(ctx context.Context, key string, placeOrderContext process.Context, deadline time.Time) error {
+	_, span := trace.StartSpan(ctx, "placeorder/contextstore/StoreWithDeadline")
+	defer span.End()
+	conn := r.pool.Get()
+	defer conn.Close()
+	if conn.Err() != nil {
+		r.logger.Error("placeorder/contextstore/StoreWithDeadline:", conn.Err())
+		return ErrNoRedisConnection
+	}
+
+	buffer := new(bytes.Buffer)
+	err := gob.NewEncoder(buffer).Encode(placeOrderContext)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do(
+		"SET",
+		key,
+		buffer,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do("ZADD", deadlinesSet, deadline.UnixNano()/int64(time.Millisecond), key)
+
+	return err
+}
+
+// StoreWithExpiration stores a given context that is removed by redis after the expiration
+func (r *Redis) StoreWithExpiration(ctx context.Context, key string, placeOrderContext process.Context, expiration time.Duration) error {
+	_, span := trace.StartSpan(ctx, "placeorder/contextstore/StoreWithExpiration")
+	defer span.End()
+	conn := r.pool.Get()
+	defer conn.Close()
+	if conn.Err() != nil {
+		r.logger.Error("placeorder/contextstore/StoreWithExpiration:", conn.Err())
+		return ErrNoRedisConnection
+	}
+
+	buffer := new(bytes.Buffer)
+	err := gob.NewEncoder(buffer).Encode(placeOrderContext)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do(
+		"SET",
+		key,
+		buffer,
+		"PX",
+		expiration.Milliseconds(),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do("ZREM", deadlinesSet, key)
 
 	return err
 }
@@ -128,10 +205,67 @@ func (r *Redis) Delete(ctx context.Context, key string) error {
 	}
 
 	_, err := conn.Do("DEL", key)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.Do("ZREM", deadlinesSet, key)
 
 	return err
 }
 
+// KeysDueBefore returns the keys of all contexts stored with a deadline before the given time
+func (r *Redis) KeysDueBefore(ctx context.Context, before time.Time) ([]string, error) {
+	_, span := trace.StartSpan(ctx, "placeorder/contextstore/KeysDueBefore")
+	defer span.End()
+	conn := r.pool.Get()
+	defer conn.Close()
+	if conn.Err() != nil {
+		r.logger.Error("placeorder/contextstore/KeysDueBefore:", conn.Err())
+		return nil, ErrNoRedisConnection
+	}
+
+	return redis.Strings(conn.Do("ZRANGEBYSCORE", deadlinesSet, "-inf", fmt.Sprintf("(%d", before.UnixNano()/int64(time.Millisecond))))
+}
+
+// StoreReference to a key that is removed by redis after the expiration
+func (r *Redis) StoreReference(ctx context.Context, reference string, key string, expiration time.Duration) error {
+	_, span := trace.StartSpan(ctx, "placeorder/contextstore/StoreReference")
+	defer span.End()
+	conn := r.pool.Get()
+	defer conn.Close()
+	if conn.Err() != nil {
+		r.logger.Error("placeorder/contextstore/StoreReference:", conn.Err())
+		return ErrNoRedisConnection
+	}
+
+	_, err := conn.Do("SET", referencePrefix+reference, key, "PX", expiration.Milliseconds())
+
+	return err
+}
+
+// GetReference returns the key of the reference
+func (r *Redis) GetReference(ctx context.Context, reference string) (string, bool, error) {
+	_, span := trace.StartSpan(ctx, "placeorder/contextstore/GetReference")
+	defer span.End()
+	conn := r.pool.Get()
+	defer conn.Close()
+	if conn.Err() != nil {
+		r.logger.Error("placeorder/contextstore/GetReference:", conn.Err())
+		return "", false, ErrNoRedisConnection
+	}
+
+	key, err := redis.String(conn.Do("GET", referencePrefix+reference))
+	if err == redis.ErrNil {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+
+	return key, true, nil
+}
+
 // Status handles the health check of redis
 func (r *Redis) Status() (alive bool, details string) {
 	conn := r.pool.Get()
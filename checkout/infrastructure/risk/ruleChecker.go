@@ -0,0 +1,178 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	riskDomain "flamingo.me/flamingo-commerce/v3/checkout/domain/risk"
+)
+
+type (
+	// RuleChecker scores orders with simple rules: the velocity of orders per email and IP, mismatching
+	// billing and shipping countries and the order value. The velocity is counted in memory per instance,
+	// only orders that are not rejected count and each place order process counts once.
+	RuleChecker struct {
+		reviewScore          float64
+		rejectScore          float64
+		velocityWindow       time.Duration
+		maxOrdersPerEmail    int
+		maxOrdersPerIP       int
+		velocityScore        float64
+		addressMismatchScore float64
+		reviewAbove          float64
+		rejectAbove          float64
+
+		mu       sync.Mutex
+		attempts map[string][]velocityAttempt
+		now      func() time.Time
+	}
+
+	// velocityAttempt is an order of a place order process within the velocity window
+	velocityAttempt struct {
+		processUUID string
+		at          time.Time
+	}
+)
+
+var _ riskDomain.Checker = new(RuleChecker)
+
+// Inject dependencies
+func (r *RuleChecker) Inject(
+	config *struct {
+		ReviewScore          float64 `inject:"config:commerce.checkout.placeorder.risk.rules.reviewScore,optional"`
+		RejectScore          float64 `inject:"config:commerce.checkout.placeorder.risk.rules.rejectScore,optional"`
+		VelocityWindow       float64 `inject:"config:commerce.checkout.placeorder.risk.rules.velocity.window,optional"`
+		MaxOrdersPerEmail    float64 `inject:"config:commerce.checkout.placeorder.risk.rules.velocity.maxOrdersPerEmail,optional"`
+		MaxOrdersPerIP       float64 `inject:"config:commerce.checkout.placeorder.risk.rules.velocity.maxOrdersPerIP,optional"`
+		VelocityScore        float64 `inject:"config:commerce.checkout.placeorder.risk.rules.velocity.score,optional"`
+		AddressMismatchScore float64 `inject:"config:commerce.checkout.placeorder.risk.rules.addressMismatch.score,optional"`
+		ReviewAbove          float64 `inject:"config:commerce.checkout.placeorder.risk.rules.orderValue.reviewAbove,optional"`
+		RejectAbove          float64 `inject:"config:commerce.checkout.placeorder.risk.rules.orderValue.rejectAbove,optional"`
+	},
+) *RuleChecker {
+	if config != nil {
+		r.reviewScore = config.ReviewScore
+		r.rejectScore = config.RejectScore
+		r.velocityWindow = time.Duration(config.VelocityWindow * float64(time.Second))
+		r.maxOrdersPerEmail = int(config.MaxOrdersPerEmail)
+		r.maxOrdersPerIP = int(config.MaxOrdersPerIP)
+		r.velocityScore = config.VelocityScore
+		r.addressMismatchScore = config.AddressMismatchScore
+		r.reviewAbove = config.ReviewAbove
+		r.rejectAbove = config.RejectAbove
+	}
+
+	r.attempts = make(map[string][]velocityAttempt)
+	r.now = time.Now
+
+	return r
+}
+
+// Check scores the order, the decision depends on the configured review and reject scores
+func (r *RuleChecker) Check(_ context.Context, input riskDomain.Input) (riskDomain.Assessment, error) {
+	// counting and recording the velocity is done under one lock, so parallel orders see each other
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	assessment := riskDomain.Assessment{Decision: riskDomain.DecisionAccept}
+	add := func(score float64, reason string) {
+		if score <= 0 {
+			return
+		}
+		assessment.Score += score
+		assessment.Reasons = append(assessment.Reasons, reason)
+	}
+
+	var velocityKeys []string
+	if input.Customer.Email != "" {
+		key := "email_" + strings.ToLower(input.Customer.Email)
+		velocityKeys = append(velocityKeys, key)
+		if count := r.countOrders(key, input.ProcessUUID); r.maxOrdersPerEmail > 0 && count > r.maxOrdersPerEmail {
+			add(r.velocityScore, fmt.Sprintf("%d orders of the email within %s", count, r.velocityWindow))
+		}
+	}
+
+	if input.IP != "" {
+		key := "ip_" + input.IP
+		velocityKeys = append(velocityKeys, key)
+		if count := r.countOrders(key, input.ProcessUUID); r.maxOrdersPerIP > 0 && count > r.maxOrdersPerIP {
+			add(r.velocityScore, fmt.Sprintf("%d orders of the IP within %s", count, r.velocityWindow))
+		}
+	}
+
+	if input.BillingAddress != nil && input.BillingAddress.CountryCode != "" {
+		for _, address := range input.ShippingAddresses {
+			if address.CountryCode != "" && !strings.EqualFold(address.CountryCode, input.BillingAddress.CountryCode) {
+				add(r.addressMismatchScore, fmt.Sprintf("billing country %s differs from shipping country %s", input.BillingAddress.CountryCode, address.CountryCode))
+				break
+			}
+		}
+	}
+
+	grandTotal := input.Cart.GrandTotal()
+	if r.rejectAbove > 0 && grandTotal.FloatAmount() > r.rejectAbove {
+		add(r.rejectScore, fmt.Sprintf("order value %.2f %s exceeds %.2f", grandTotal.FloatAmount(), grandTotal.Currency(), r.rejectAbove))
+	} else if r.reviewAbove > 0 && grandTotal.FloatAmount() > r.reviewAbove {
+		add(r.reviewScore, fmt.Sprintf("order value %.2f %s exceeds %.2f", grandTotal.FloatAmount(), grandTotal.Currency(), r.reviewAbove))
+	}
+
+	switch {
+	case r.rejectScore > 0 && assessment.Score >= r.rejectScore:
+		assessment.Decision = riskDomain.DecisionReject
+	case r.reviewScore > 0 && assessment.Score >= r.reviewScore:
+		assessment.Decision = riskDomain.DecisionReview
+	}
+
+	if !assessment.IsRejected() {
+		r.recordOrder(input.ProcessUUID, velocityKeys)
+	}
+
+	return assessment, nil
+}
+
+// countOrders returns the number of orders of the key within the velocity window including the order of the process,
+// a former assessment of the same process (e.g. a retry) is not counted again. The caller has to hold the lock.
+func (r *RuleChecker) countOrders(key string, processUUID string) int {
+	now := r.now()
+	count := 1
+	for _, attempt := range r.attempts[key] {
+		if now.Sub(attempt.at) < r.velocityWindow && !attempt.isOf(processUUID) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// recordOrder records the order of the process for the keys, the attempts outside of the velocity window and keys
+// without attempts are removed. The caller has to hold the lock.
+func (r *RuleChecker) recordOrder(processUUID string, keys []string) {
+	now := r.now()
+	for key, attempts := range r.attempts {
+		kept := attempts[:0]
+		for _, attempt := range attempts {
+			if now.Sub(attempt.at) < r.velocityWindow && !attempt.isOf(processUUID) {
+				kept = append(kept, attempt)
+			}
+		}
+
+		if len(kept) == 0 {
+			delete(r.attempts, key)
+			continue
+		}
+
+		r.attempts[key] = kept
+	}
+
+	for _, key := range keys {
+		r.attempts[key] = append(r.attempts[key], velocityAttempt{processUUID: processUUID, at: now})
+	}
+}
+
+// isOf checks if the attempt belongs to the process, attempts without process are always distinct
+func (a velocityAttempt) isOf(processUUID string) bool {
+	return processUUID != "" && a.processUUID == processUUID
+}
@@ -0,0 +1,149 @@
+package risk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	riskDomain "flamingo.me/flamingo-commerce/v3/checkout/domain/risk"
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+func provideRuleChecker() *RuleChecker {
+	return new(RuleChecker).Inject(&struct {
+		ReviewScore          float64 `inject:"config:commerce.checkout.placeorder.risk.rules.reviewScore,optional"`
+		RejectScore          float64 `inject:"config:commerce.checkout.placeorder.risk.rules.rejectScore,optional"`
+		VelocityWindow       float64 `inject:"config:commerce.checkout.placeorder.risk.rules.velocity.window,optional"`
+		MaxOrdersPerEmail    float64 `inject:"config:commerce.checkout.placeorder.risk.rules.velocity.maxOrdersPerEmail,optional"`
+		MaxOrdersPerIP       float64 `inject:"config:commerce.checkout.placeorder.risk.rules.velocity.maxOrdersPerIP,optional"`
+		VelocityScore        float64 `inject:"config:commerce.checkout.placeorder.risk.rules.velocity.score,optional"`
+		AddressMismatchScore float64 `inject:"config:commerce.checkout.placeorder.risk.rules.addressMismatch.score,optional"`
+		ReviewAbove          float64 `inject:"config:commerce.checkout.placeorder.risk.rules.orderValue.reviewAbove,optional"`
+		RejectAbove          float64 `inject:"config:commerce.checkout.placeorder.risk.rules.orderValue.rejectAbove,optional"`
+	}{
+		ReviewScore:          50,
+		RejectScore:          100,
+		VelocityWindow:       3600,
+		MaxOrdersPerEmail:    2,
+		MaxOrdersPerIP:       3,
+		VelocityScore:        50,
+		AddressMismatchScore: 30,
+		ReviewAbove:          1000,
+		RejectAbove:          5000,
+	})
+}
+
+func cartWithTotal(total float64) cart.Cart {
+	return cart.Cart{Totalitems: []cart.Totalitem{{Code: "total", Price: domain.NewFromFloat(total, "EUR")}}}
+}
+
+func TestRuleChecker_Check(t *testing.T) {
+	t.Run("accept", func(t *testing.T) {
+		assessment, err := provideRuleChecker().Check(context.Background(), riskDomain.Input{
+			Cart:              cartWithTotal(100),
+			BillingAddress:    &cart.Address{CountryCode: "DE"},
+			ShippingAddresses: []cart.Address{{CountryCode: "de"}},
+			Customer:          riskDomain.Customer{Email: "customer@example.com"},
+			IP:                "127.0.0.1",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, riskDomain.Assessment{Decision: riskDomain.DecisionAccept}, assessment)
+	})
+
+	t.Run("address mismatch", func(t *testing.T) {
+		assessment, err := provideRuleChecker().Check(context.Background(), riskDomain.Input{
+			Cart:              cartWithTotal(100),
+			BillingAddress:    &cart.Address{CountryCode: "DE"},
+			ShippingAddresses: []cart.Address{{CountryCode: "DE"}, {CountryCode: "FR"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, riskDomain.DecisionAccept, assessment.Decision)
+		assert.Equal(t, 30.0, assessment.Score)
+		assert.Len(t, assessment.Reasons, 1)
+	})
+
+	t.Run("order value thresholds", func(t *testing.T) {
+		checker := provideRuleChecker()
+
+		assessment, err := checker.Check(context.Background(), riskDomain.Input{Cart: cartWithTotal(1500)})
+		require.NoError(t, err)
+		assert.Equal(t, riskDomain.DecisionReview, assessment.Decision)
+		assert.Equal(t, 50.0, assessment.Score)
+
+		assessment, err = checker.Check(context.Background(), riskDomain.Input{Cart: cartWithTotal(6000)})
+		require.NoError(t, err)
+		assert.Equal(t, riskDomain.DecisionReject, assessment.Decision)
+		assert.Equal(t, 100.0, assessment.Score)
+	})
+
+	t.Run("velocity per email and IP", func(t *testing.T) {
+		checker := provideRuleChecker()
+		now := time.Now()
+		checker.now = func() time.Time { return now }
+
+		input := riskDomain.Input{
+			Cart:     cartWithTotal(100),
+			Customer: riskDomain.Customer{Email: "Customer@example.com"},
+			IP:       "127.0.0.1",
+		}
+
+		for i := 0; i < 2; i++ {
+			assessment, err := checker.Check(context.Background(), input)
+			require.NoError(t, err)
+			assert.Equal(t, riskDomain.DecisionAccept, assessment.Decision)
+		}
+
+		input.Customer.Email = "customer@example.com"
+		assessment, err := checker.Check(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, riskDomain.DecisionReview, assessment.Decision, "third order of the email")
+
+		assessment, err = checker.Check(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, riskDomain.DecisionReject, assessment.Decision, "fourth order of the email and the IP")
+
+		now = now.Add(2 * time.Hour)
+		assessment, err = checker.Check(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, riskDomain.DecisionAccept, assessment.Decision, "attempts outside of the window are ignored")
+	})
+
+	t.Run("velocity counts each process once and no rejected orders", func(t *testing.T) {
+		checker := provideRuleChecker()
+		now := time.Now()
+		checker.now = func() time.Time { return now }
+
+		input := riskDomain.Input{
+			ProcessUUID: "process-1",
+			Cart:        cartWithTotal(100),
+			Customer:    riskDomain.Customer{Email: "customer@example.com"},
+		}
+
+		for i := 0; i < 3; i++ {
+			assessment, err := checker.Check(context.Background(), input)
+			require.NoError(t, err)
+			assert.Equal(t, riskDomain.DecisionAccept, assessment.Decision, "retries of the process count once")
+		}
+
+		input.ProcessUUID = "process-2"
+		input.Cart = cartWithTotal(6000)
+		assessment, err := checker.Check(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, riskDomain.DecisionReject, assessment.Decision)
+
+		input.ProcessUUID = "process-3"
+		input.Cart = cartWithTotal(100)
+		assessment, err = checker.Check(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, riskDomain.DecisionAccept, assessment.Decision, "rejected orders don't count")
+
+		now = now.Add(2 * time.Hour)
+		_, err = checker.Check(context.Background(), riskDomain.Input{ProcessUUID: "process-4", Cart: cartWithTotal(100), IP: "127.0.0.1"})
+		require.NoError(t, err)
+		assert.Len(t, checker.attempts, 1, "keys without attempts in the window are removed")
+	})
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/application/placeorder"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+)
+
+// PlaceOrderGraphCommand exports the place order states and transitions as DOT or Mermaid graph
+func PlaceOrderGraphCommand(exporter *placeorder.GraphExporter, contextStore process.ContextStore) *cobra.Command {
+	var format, key string
+
+	command := &cobra.Command{
+		Use:   "placeordergraph",
+		Short: "Export the place order states and transitions as DOT or Mermaid graph",
+		Long: `Export the place order states and transitions as DOT or Mermaid graph.
+
+With --key the path of the process context stored with this key (the session id)
+is highlighted, this requires a shared context store like redis.`,
+		RunE: func(command *cobra.Command, _ []string) error {
+			var pctx *process.Context
+			if key != "" {
+				stored, found := contextStore.Get(context.Background(), key)
+				if !found {
+					return fmt.Errorf("no place order context stored with key %q", key)
+				}
+				pctx = &stored
+			}
+
+			graph, err := exporter.Export(format, pctx)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprint(command.OutOrStdout(), graph)
+
+			return err
+		},
+	}
+
+	command.Flags().StringVarP(&format, "format", "f", placeorder.GraphFormatDOT, "graph format: dot or mermaid")
+	command.Flags().StringVarP(&key, "key", "k", "", "key of the process context in the context store to highlight its path")
+
+	return command
+}
@@ -2,8 +2,11 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
 	placeorderDomain "flamingo.me/flamingo-commerce/v3/cart/domain/placeorder"
@@ -48,9 +51,18 @@ type (
 		PaymentInfos        []application.PlaceOrderPaymentInfo
 		PlacedOrderInfos    []placeorderDomain.PlacedOrderInfo
 		Email               string
+		ReviewRequired      bool
+		RiskScore           float64
 		PlacedDecoratedCart *decorator.DecoratedCart
 	}
 
+	// placeOrderEventsResult streams the place order contexts as server sent events
+	placeOrderEventsResult struct {
+		contexts  <-chan process.Context
+		mapper    func(context.Context, *process.Context) placeOrderContext
+		heartbeat time.Duration
+	}
+
 	// errorResponse format
 	errorResponse struct {
 		Code    string
@@ -82,8 +94,18 @@ func (c *APIController) Inject(
 // @Failure 500 {object} errorResponse
 // @Failure 400 {object} errorResponse
 // @Param returnURL query string true "the returnURL that should be used after an external payment flow"
+// @Param Idempotency-Key header string false "optional key to safely retry the request, a replay returns the original process with status 200 and the header Idempotent-Replayed"
 // @Router /api/v1/checkout/placeorder [put]
 func (c *APIController) StartPlaceOrderAction(ctx context.Context, r *web.Request) web.Result {
+	idempotencyKey := r.Request().Header.Get("Idempotency-Key")
+	if pctx, replayed := c.placeorderHandler.ReplayPlaceOrder(ctx, idempotencyKey); replayed {
+		response := c.responder.Data(startPlaceOrderResult{
+			UUID: pctx.UUID,
+		})
+		response.Header.Set("Idempotent-Replayed", "true")
+		return response
+	}
+
 	session := web.SessionFromContext(ctx)
 	cart, err := c.cartService.GetCartReceiverService().ViewCart(ctx, session)
 	if err != nil {
@@ -105,8 +127,13 @@ func (c *APIController) StartPlaceOrderAction(ctx context.Context, r *web.Reques
 		return response
 	}
 
-	startPlaceOrderCommand := placeorder.StartPlaceOrderCommand{Cart: *cart, ReturnURL: returnURL}
+	startPlaceOrderCommand := placeorder.StartPlaceOrderCommand{Cart: *cart, ReturnURL: returnURL, IdempotencyKey: idempotencyKey}
 	pctx, err := c.placeorderHandler.StartPlaceOrder(ctx, startPlaceOrderCommand)
+	if err == placeorder.ErrInvalidIdempotencyKey {
+		response := c.responder.Data(errorResponse{Code: "400", Message: err.Error()})
+		response.Status(http.StatusBadRequest)
+		return response
+	}
 	if err == placeorder.ErrAnotherPlaceOrderProcessRunning {
 		dtopctx, err := c.placeorderHandler.RefreshPlaceOrder(ctx, placeorder.RefreshPlaceOrderCommand{})
 		if err != nil {
@@ -191,6 +218,8 @@ func (c *APIController) getPlaceOrderContext(ctx context.Context, pctx *process.
 			PaymentInfos:        pctx.PlaceOrderInfo.PaymentInfos,
 			PlacedOrderInfos:    pctx.PlaceOrderInfo.PlacedOrders,
 			Email:               pctx.PlaceOrderInfo.ContactEmail,
+			ReviewRequired:      pctx.PlaceOrderInfo.ReviewRequired,
+			RiskScore:           pctx.PlaceOrderInfo.RiskScore,
 			PlacedDecoratedCart: decoratedCart,
 		}
 	}
@@ -208,6 +237,76 @@ func (c *APIController) getPlaceOrderContext(ctx context.Context, pctx *process.
 	}
 }
 
+// PlaceOrderEventsAction streams the current place order context and its state changes as server sent events
+// @Summary Streams the current place order context followed by every state change as server sent events
+// @Description Every event is named placeorder and carries the place order context as JSON data, the stream is kept open until the client disconnects
+// @Tags v1 Checkout ajax API
+// @Produce text/event-stream
+// @Success 200 {object} placeOrderContext
+// @Failure 500 {object} errorResponse
+// @Router /api/v1/checkout/placeorder/events [get]
+func (c *APIController) PlaceOrderEventsAction(ctx context.Context, r *web.Request) web.Result {
+	contexts, err := c.placeorderHandler.SubscribePlaceOrderChanges(ctx)
+	if err != nil {
+		response := c.responder.Data(errorResponse{Code: "500", Message: err.Error()})
+		response.Status(http.StatusInternalServerError)
+		return response
+	}
+
+	return &placeOrderEventsResult{
+		contexts:  contexts,
+		mapper:    c.getPlaceOrderContext,
+		heartbeat: 15 * time.Second,
+	}
+}
+
+// Apply writes the events until the client disconnects, a comment is sent as heartbeat to keep proxies from
+// closing the idle connection
+func (r *placeOrderEventsResult) Apply(ctx context.Context, rw http.ResponseWriter) error {
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("Connection", "keep-alive")
+	rw.Header().Set("X-Accel-Buffering", "no")
+	rw.WriteHeader(http.StatusOK)
+
+	flusher, _ := rw.(http.Flusher)
+	flush := func() {
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+	flush()
+
+	heartbeat := time.NewTicker(r.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(rw, ": heartbeat\n\n"); err != nil {
+				return err
+			}
+			flush()
+		case pctx, ok := <-r.contexts:
+			if !ok {
+				return nil
+			}
+
+			data, err := json.Marshal(r.mapper(ctx, &pctx))
+			if err != nil {
+				return err
+			}
+
+			if _, err := fmt.Fprintf(rw, "id: %s-%s\nevent: placeorder\ndata: %s\n\n", pctx.UUID, pctx.CurrentStateName, data); err != nil {
+				return err
+			}
+			flush()
+		}
+	}
+}
+
 // RefreshPlaceOrderAction returns the current place order context and proceeds the process in a non blocking way
 // @Summary Returns the current place order context and proceeds the process in a non blocking way
 // @Tags v1 Checkout ajax API
@@ -0,0 +1,181 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/approval"
+)
+
+type (
+	// ApprovalAPIController lets approvers decide about the orders that wait for approval
+	ApprovalAPIController struct {
+		responder          *web.Responder
+		approvalService    *approval.Service
+		webIdentityService *auth.WebIdentityService
+		logger             flamingo.Logger
+	}
+
+	// approvalRequest result
+	approvalRequest struct {
+		ID          string
+		ProcessUUID string
+		Cart        *cart.Cart
+		Reason      string
+		Status      string
+		RequestedAt string
+		DecidedBy   string
+		DecidedAt   string
+		Comment     string
+	} // @name checkoutApprovalRequest
+)
+
+// Inject dependencies
+func (c *ApprovalAPIController) Inject(
+	responder *web.Responder,
+	approvalService *approval.Service,
+	webIdentityService *auth.WebIdentityService,
+	logger flamingo.Logger,
+) *ApprovalAPIController {
+	c.responder = responder
+	c.approvalService = approvalService
+	c.webIdentityService = webIdentityService
+	c.logger = logger.WithField(flamingo.LogKeyModule, "checkout").WithField(flamingo.LogKeyCategory, "approvalapicontroller")
+
+	return c
+}
+
+// GetAction returns the approval request with the snapshot of the cart
+// @Summary Returns the approval request with the snapshot of the cart
+// @Tags v1 Checkout ajax API
+// @Produce json
+// @Success 200 {object} approvalRequest
+// @Failure 401 {object} errorResponse
+// @Failure 403 {object} errorResponse
+// @Failure 404 {object} errorResponse
+// @Param approvalID path string true "the id of the approval request"
+// @Router /api/v1/checkout/approval/{approvalID} [get]
+func (c *ApprovalAPIController) GetAction(ctx context.Context, r *web.Request) web.Result {
+	approver, errResponse := c.approver(ctx, r)
+	if errResponse != nil {
+		return errResponse
+	}
+
+	request, err := c.approvalService.Get(ctx, r.Params["approvalID"])
+	if err != nil {
+		return c.errorResponse(err)
+	}
+
+	if !request.MayBeDecidedBy(approver) {
+		return c.errorResponse(approval.ErrNotAllowed)
+	}
+
+	return c.responder.Data(mapApprovalRequest(request))
+}
+
+// ApproveAction approves the order, the place order process continues on its next refresh
+// @Summary Approves the order, the place order process continues on its next refresh
+// @Tags v1 Checkout ajax API
+// @Produce json
+// @Success 200 {object} approvalRequest
+// @Failure 401 {object} errorResponse
+// @Failure 403 {object} errorResponse
+// @Failure 404 {object} errorResponse
+// @Failure 409 {object} errorResponse
+// @Param approvalID path string true "the id of the approval request"
+// @Param comment formData string false "optional comment of the approver"
+// @Router /api/v1/checkout/approval/{approvalID}/approve [post]
+func (c *ApprovalAPIController) ApproveAction(ctx context.Context, r *web.Request) web.Result {
+	return c.decide(ctx, r, c.approvalService.Approve)
+}
+
+// RejectAction rejects the order, the place order process fails on its next refresh
+// @Summary Rejects the order, the place order process fails on its next refresh
+// @Tags v1 Checkout ajax API
+// @Produce json
+// @Success 200 {object} approvalRequest
+// @Failure 401 {object} errorResponse
+// @Failure 403 {object} errorResponse
+// @Failure 404 {object} errorResponse
+// @Failure 409 {object} errorResponse
+// @Param approvalID path string true "the id of the approval request"
+// @Param comment formData string false "optional comment of the approver, shown as failed reason"
+// @Router /api/v1/checkout/approval/{approvalID}/reject [post]
+func (c *ApprovalAPIController) RejectAction(ctx context.Context, r *web.Request) web.Result {
+	return c.decide(ctx, r, c.approvalService.Reject)
+}
+
+func (c *ApprovalAPIController) decide(
+	ctx context.Context,
+	r *web.Request,
+	decide func(ctx context.Context, id string, approver string, comment string) (*approval.Request, error),
+) web.Result {
+	approver, errResponse := c.approver(ctx, r)
+	if errResponse != nil {
+		return errResponse
+	}
+
+	comment, _ := r.Form1("comment")
+	request, err := decide(ctx, r.Params["approvalID"], approver, comment)
+	if err != nil {
+		return c.errorResponse(err)
+	}
+
+	return c.responder.Data(mapApprovalRequest(request))
+}
+
+// approver returns the subject of the logged in approver
+func (c *ApprovalAPIController) approver(ctx context.Context, r *web.Request) (string, web.Result) {
+	identity := c.webIdentityService.Identify(ctx, r)
+	if identity == nil {
+		response := c.responder.Data(errorResponse{Code: "401", Message: "approver not logged in"})
+		response.Status(http.StatusUnauthorized)
+		return "", response
+	}
+
+	return identity.Subject(), nil
+}
+
+func (c *ApprovalAPIController) errorResponse(err error) web.Result {
+	status := http.StatusInternalServerError
+	switch err {
+	case approval.ErrNotFound:
+		status = http.StatusNotFound
+	case approval.ErrNotAllowed:
+		status = http.StatusForbidden
+	case approval.ErrAlreadyDecided:
+		status = http.StatusConflict
+	default:
+		c.logger.Error(err)
+	}
+
+	response := c.responder.Data(errorResponse{Code: strconv.Itoa(status), Message: err.Error()})
+	response.Status(uint(status))
+	return response
+}
+
+func mapApprovalRequest(request *approval.Request) approvalRequest {
+	result := approvalRequest{
+		ID:          request.ID,
+		ProcessUUID: request.ProcessUUID,
+		Cart:        &request.Cart,
+		Reason:      request.Reason,
+		Status:      request.Status,
+		RequestedAt: request.RequestedAt.Format(time.RFC3339),
+		DecidedBy:   request.DecidedBy,
+		Comment:     request.Comment,
+	}
+
+	if !request.DecidedAt.IsZero() {
+		result.DecidedAt = request.DecidedAt.Format(time.RFC3339)
+	}
+
+	return result
+}
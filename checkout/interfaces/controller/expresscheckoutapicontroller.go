@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/go-playground/form"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	cartForms "flamingo.me/flamingo-commerce/v3/cart/interfaces/controller/forms"
+	"flamingo.me/flamingo-commerce/v3/checkout/application/placeorder"
+)
+
+type (
+	// ExpressCheckoutAPIController starts the express checkout of a single product ("buy now")
+	ExpressCheckoutAPIController struct {
+		responder         *web.Responder
+		placeorderHandler *placeorder.Handler
+		formDecoder       *form.Decoder
+		logger            flamingo.Logger
+	}
+
+	// buyNowForm all data of the express checkout in one request
+	buyNowForm struct {
+		MarketplaceCode        string                 `form:"marketplaceCode"`
+		VariantMarketplaceCode string                 `form:"variantMarketplaceCode"`
+		Qty                    int                    `form:"qty"`
+		DeliveryCode           string                 `form:"deliveryCode"`
+		Workflow               string                 `form:"workflow"`
+		Delivery               cartForms.DeliveryForm `form:"delivery"`
+		BillingAddress         *cartForms.AddressForm `form:"billingAddress"`
+		PaymentGateway         string                 `form:"paymentGateway"`
+		PaymentMethod          string                 `form:"paymentMethod"`
+	}
+)
+
+// Inject dependencies
+func (c *ExpressCheckoutAPIController) Inject(
+	responder *web.Responder,
+	placeorderHandler *placeorder.Handler,
+	formDecoder *form.Decoder,
+	logger flamingo.Logger,
+) *ExpressCheckoutAPIController {
+	c.responder = responder
+	c.placeorderHandler = placeorderHandler
+	c.formDecoder = formDecoder
+	c.logger = logger.WithField(flamingo.LogKeyModule, "checkout").WithField(flamingo.LogKeyCategory, "expresscheckoutapicontroller")
+
+	return c
+}
+
+// BuyNowAction starts the express checkout of a single product with a secondary cart, the cart of the session stays untouched
+// @Summary Starts the place order process for a single product with a secondary cart, the cart of the session stays untouched. The process is refreshed with the place order endpoints, only the process works on the express cart.
+// @Tags v1 Checkout ajax API
+// @Produce json
+// @Success 201 {object} startPlaceOrderResult "201 if new process was started"
+// @Success 200 {object} startPlaceOrderResult "200 if the request was replayed"
+// @Failure 400 {object} errorResponse
+// @Failure 409 {object} errorResponse "409 if another place order process is running"
+// @Failure 500 {object} errorResponse
+// @Param returnURL query string true "the returnURL that should be used after an external payment flow"
+// @Param Idempotency-Key header string false "optional key to safely retry the request, a replay returns the original process with status 200 and the header Idempotent-Replayed"
+// @Param marketplaceCode formData string true "the product to buy"
+// @Param variantMarketplaceCode formData string false "the variant of a configurable product"
+// @Param qty formData integer true "the qty to buy"
+// @Param deliveryCode formData string false "code of the delivery, the default delivery code if empty"
+// @Param workflow formData string false "workflow of the delivery, e.g. delivery or pickup"
+// @Param delivery.deliveryAddress.firstname formData string false "the delivery address, all fields of the delivery form are supported with the prefix delivery."
+// @Param delivery.shippingMethod formData string false "the shipping method"
+// @Param delivery.locationCode formData string false "the pickup location"
+// @Param billingAddress.email formData string false "the billing address, all fields of the address form are supported with the prefix billingAddress."
+// @Param paymentGateway formData string false "the payment gateway, the payment selection is skipped if empty"
+// @Param paymentMethod formData string false "the payment method of the gateway"
+// @Router /api/v1/checkout/buynow [put]
+func (c *ExpressCheckoutAPIController) BuyNowAction(ctx context.Context, r *web.Request) web.Result {
+	idempotencyKey := r.Request().Header.Get("Idempotency-Key")
+	if pctx, replayed := c.placeorderHandler.ReplayPlaceOrder(ctx, idempotencyKey); replayed {
+		response := c.responder.Data(startPlaceOrderResult{
+			UUID: pctx.UUID,
+		})
+		response.Header.Set("Idempotent-Replayed", "true")
+		return response
+	}
+
+	returnURLRaw, err := r.Query1("returnURL")
+	if err != nil {
+		return c.errorResponse(http.StatusBadRequest, "returnURL missing")
+	}
+	returnURL, err := url.Parse(returnURLRaw)
+	if err != nil {
+		return c.errorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	err = r.Request().ParseForm()
+	if err != nil {
+		return c.errorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	var buyNow buyNowForm
+	err = c.formDecoder.Decode(&buyNow, r.Request().Form)
+	if err != nil {
+		return c.errorResponse(http.StatusBadRequest, err.Error())
+	}
+
+	command := buyNow.mapToCommand()
+	command.ReturnURL = returnURL
+	command.IdempotencyKey = idempotencyKey
+
+	pctx, err := c.placeorderHandler.BuyNow(ctx, command)
+	switch err {
+	case nil:
+	case placeorder.ErrInvalidBuyNowCommand, placeorder.ErrInvalidIdempotencyKey:
+		return c.errorResponse(http.StatusBadRequest, err.Error())
+	case placeorder.ErrAnotherPlaceOrderProcessRunning:
+		return c.errorResponse(http.StatusConflict, err.Error())
+	default:
+		c.logger.WithContext(ctx).Error(err)
+		return c.errorResponse(http.StatusInternalServerError, err.Error())
+	}
+
+	response := c.responder.Data(startPlaceOrderResult{
+		UUID: pctx.UUID,
+	})
+	response.Status(http.StatusCreated)
+	return response
+}
+
+func (c *ExpressCheckoutAPIController) errorResponse(status int, message string) web.Result {
+	response := c.responder.Data(errorResponse{Code: http.StatusText(status), Message: message})
+	response.Status(uint(status))
+	return response
+}
+
+// mapToCommand maps the form to the BuyNowCommand, the delivery address is only taken over for the delivery workflow
+func (f buyNowForm) mapToCommand() placeorder.BuyNowCommand {
+	deliveryInfo := f.Delivery.MapToDeliveryInfo(cart.DeliveryInfo{Code: f.DeliveryCode, Workflow: f.Workflow})
+	if f.Workflow != cart.DeliveryWorkflowDelivery {
+		deliveryInfo.DeliveryLocation.Address = nil
+	}
+
+	var billingAddress *cart.Address
+	if f.BillingAddress != nil {
+		address := f.BillingAddress.MapToDomainAddress()
+		billingAddress = &address
+	}
+
+	return placeorder.BuyNowCommand{
+		MarketplaceCode:        f.MarketplaceCode,
+		VariantMarketplaceCode: f.VariantMarketplaceCode,
+		Qty:                    f.Qty,
+		DeliveryInfo:           deliveryInfo,
+		BillingAddress:         billingAddress,
+		PaymentGateway:         f.PaymentGateway,
+		PaymentMethod:          f.PaymentMethod,
+	}
+}
@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/go-playground/form"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+)
+
+func TestBuyNowForm_mapToCommand(t *testing.T) {
+	decode := func(t *testing.T, values url.Values) buyNowForm {
+		t.Helper()
+		var buyNow buyNowForm
+		require.NoError(t, form.NewDecoder().Decode(&buyNow, values))
+		return buyNow
+	}
+
+	t.Run("delivery", func(t *testing.T) {
+		command := decode(t, url.Values{
+			"marketplaceCode":                    {"fake_configurable"},
+			"variantMarketplaceCode":             {"fake_variant"},
+			"qty":                                {"2"},
+			"deliveryCode":                       {"delivery"},
+			"workflow":                           {cart.DeliveryWorkflowDelivery},
+			"delivery.deliveryAddress.firstname": {"Max"},
+			"delivery.shippingMethod":            {"standard"},
+			"billingAddress.email":               {"max@example.com"},
+			"paymentGateway":                     {"fake_payment_gateway"},
+			"paymentMethod":                      {"payment_completed"},
+		}).mapToCommand()
+
+		assert.Equal(t, "fake_configurable", command.MarketplaceCode)
+		assert.Equal(t, "fake_variant", command.VariantMarketplaceCode)
+		assert.Equal(t, 2, command.Qty)
+		assert.Equal(t, "delivery", command.DeliveryInfo.Code)
+		assert.Equal(t, cart.DeliveryWorkflowDelivery, command.DeliveryInfo.Workflow)
+		assert.Equal(t, "standard", command.DeliveryInfo.Method)
+		require.NotNil(t, command.DeliveryInfo.DeliveryLocation.Address)
+		assert.Equal(t, "Max", command.DeliveryInfo.DeliveryLocation.Address.Firstname)
+		require.NotNil(t, command.BillingAddress)
+		assert.Equal(t, "max@example.com", command.BillingAddress.Email)
+		assert.Equal(t, "fake_payment_gateway", command.PaymentGateway)
+		assert.Equal(t, "payment_completed", command.PaymentMethod)
+	})
+
+	t.Run("pickup without address", func(t *testing.T) {
+		command := decode(t, url.Values{
+			"marketplaceCode":                    {"fake_simple"},
+			"qty":                                {"1"},
+			"workflow":                           {cart.DeliveryWorkflowPickup},
+			"delivery.deliveryAddress.firstname": {"Max"},
+			"delivery.locationCode":              {"store"},
+		}).mapToCommand()
+
+		assert.Equal(t, "", command.DeliveryInfo.Code, "the default delivery code is set by the express checkout")
+		assert.Equal(t, "store", command.DeliveryInfo.DeliveryLocation.Code)
+		assert.Nil(t, command.DeliveryInfo.DeliveryLocation.Address)
+		assert.Nil(t, command.BillingAddress)
+	})
+}
@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/application/placeorder"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/notification"
+)
+
+type (
+	// PaymentNotificationController receives the server-to-server notifications of payment providers
+	PaymentNotificationController struct {
+		responder         *web.Responder
+		placeorderHandler *placeorder.Handler
+		logger            flamingo.Logger
+	}
+)
+
+// Inject dependencies
+func (c *PaymentNotificationController) Inject(
+	responder *web.Responder,
+	placeorderHandler *placeorder.Handler,
+	logger flamingo.Logger,
+) *PaymentNotificationController {
+	c.responder = responder
+	c.placeorderHandler = placeorderHandler
+	c.logger = logger.WithField(flamingo.LogKeyModule, "checkout").WithField(flamingo.LogKeyCategory, "paymentnotificationcontroller")
+
+	return c
+}
+
+// NotifyAction verifies the payment notification and advances the place order process of the payment
+// @Summary Receives the server-to-server notification of a payment provider and advances the place order process of the payment
+// @Tags v1 Checkout ajax API
+// @Produce json
+// @Success 200 {boolean} boolean
+// @Failure 400 {object} errorResponse
+// @Failure 404 {object} errorResponse
+// @Failure 500 {object} errorResponse
+// @Param gateway path string true "the code of the payment gateway"
+// @Router /api/v1/checkout/placeorder/notification/{gateway} [post]
+func (c *PaymentNotificationController) NotifyAction(ctx context.Context, r *web.Request) web.Result {
+	_, err := c.placeorderHandler.HandlePaymentNotification(ctx, placeorder.PaymentNotificationCommand{
+		Gateway: r.Params["gateway"],
+		Request: r.Request(),
+	})
+	if err != nil {
+		return c.errorResponse(err)
+	}
+
+	return c.responder.Data(true)
+}
+
+func (c *PaymentNotificationController) errorResponse(err error) web.Result {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, notification.ErrInvalid):
+		status = http.StatusBadRequest
+	case errors.Is(err, notification.ErrUnknownGateway), errors.Is(err, placeorder.ErrNoPlaceOrderProcess):
+		status = http.StatusNotFound
+	default:
+		c.logger.Error(err)
+	}
+
+	response := c.responder.Data(errorResponse{Code: strconv.Itoa(status), Message: err.Error()})
+	response.Status(uint(status))
+	return response
+}
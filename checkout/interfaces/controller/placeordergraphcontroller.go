@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"flamingo.me/flamingo/v3/framework/web"
+
+	"flamingo.me/flamingo-commerce/v3/checkout/application/placeorder"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+)
+
+type (
+	// PlaceOrderGraphController exports the place order graph for debugging
+	PlaceOrderGraphController struct {
+		responder    *web.Responder
+		exporter     *placeorder.GraphExporter
+		contextStore process.ContextStore
+	}
+)
+
+// Inject dependencies
+func (c *PlaceOrderGraphController) Inject(
+	responder *web.Responder,
+	exporter *placeorder.GraphExporter,
+	contextStore process.ContextStore,
+) *PlaceOrderGraphController {
+	c.responder = responder
+	c.exporter = exporter
+	c.contextStore = contextStore
+
+	return c
+}
+
+// GraphAction exports the place order states and transitions as DOT or Mermaid graph,
+// the path of the process context of the current session or of the session given with the key parameter is highlighted
+func (c *PlaceOrderGraphController) GraphAction(ctx context.Context, r *web.Request) web.Result {
+	format, err := r.Query1("format")
+	if err != nil {
+		format = placeorder.GraphFormatDOT
+	}
+
+	key, err := r.Query1("key")
+	if err != nil {
+		key = r.Session().ID()
+	}
+
+	var pctx *process.Context
+	if stored, found := c.contextStore.Get(ctx, key); found {
+		pctx = &stored
+	}
+
+	graph, err := c.exporter.Export(format, pctx)
+	if err != nil {
+		response := c.responder.HTTP(http.StatusBadRequest, strings.NewReader(err.Error()))
+		response.Header.Set("Content-Type", "text/plain; charset=utf-8")
+
+		return response
+	}
+
+	response := c.responder.HTTP(http.StatusOK, strings.NewReader(graph))
+	response.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if format == placeorder.GraphFormatDOT {
+		response.Header.Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+	}
+
+	return response
+}
@@ -39,6 +39,11 @@ type (
 	WaitForCustomer struct {
 		Name string
 	}
+	// WaitForApproval state
+	WaitForApproval struct {
+		Name       string
+		ApprovalID string
+	}
 	// ShowIframe state
 	ShowIframe struct {
 		Name string
@@ -72,6 +77,7 @@ var (
 	_ State = new(Success)
 	_ State = new(Wait)
 	_ State = new(WaitForCustomer)
+	_ State = new(WaitForApproval)
 	_ State = new(ShowIframe)
 	_ State = new(ShowHTML)
 	_ State = new(Redirect)
@@ -99,6 +105,14 @@ func (s *WaitForCustomer) MapFrom(pctx process.Context) {
 	s.Name = pctx.CurrentStateName
 }
 
+// MapFrom the internal process state to the graphQL state fields
+func (s *WaitForApproval) MapFrom(pctx process.Context) {
+	s.Name = pctx.CurrentStateName
+	if stateData, ok := pctx.CurrentStateData.(states.WaitForApprovalData); ok {
+		s.ApprovalID = stateData.ApprovalID
+	}
+}
+
 // MapFrom the internal process state to the graphQL state fields
 func (s *ShowIframe) MapFrom(pctx process.Context) {
 	s.Name = pctx.CurrentStateName
@@ -2,6 +2,7 @@ package dto
 
 import (
 	"flamingo.me/flamingo-commerce/v3/cart/domain/placeorder"
+	"flamingo.me/flamingo-commerce/v3/cart/interfaces/controller/forms"
 	"flamingo.me/flamingo-commerce/v3/cart/interfaces/graphql/dto"
 	"flamingo.me/flamingo-commerce/v3/checkout/application"
 )
@@ -12,6 +13,24 @@ type (
 		UUID string
 	}
 
+	// LoyaltyWishedToPayInput the points of a loyalty type the customer wants to pay with
+	LoyaltyWishedToPayInput struct {
+		Type     string
+		Amount   float64
+		Currency string
+	}
+
+	// BuyNowInput all data of an express checkout of a single product
+	BuyNowInput struct {
+		MarketplaceCode        string
+		VariantMarketplaceCode string
+		Qty                    int
+		Delivery               *forms.DeliveryForm
+		BillingAddress         *forms.AddressForm
+		PaymentGateway         string
+		PaymentMethod          string
+	}
+
 	// PlaceOrderContext infos
 	PlaceOrderContext struct {
 		Cart       *dto.DecoratedCart
@@ -25,6 +44,8 @@ type (
 		PaymentInfos        []application.PlaceOrderPaymentInfo
 		PlacedOrderInfos    []placeorder.PlacedOrderInfo
 		Email               string
+		ReviewRequired      bool
+		RiskScore           float64
 		PlacedDecoratedCart *dto.DecoratedCart
 	}
 )
@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x97\x4d\x6f\xdb\x38\x13\xc7\xef\xfe\x14\x63\xe4\xd2\x02\xe9\x17\xd0\xad\xf1\xf3\x74\x37\x40\x8b\x78\x93\xb6\x7b\x28\x0a\x63\x22\x8e\x6d\x22\x14\xc7\x3b\x1c\xc5\x15\x16\xfb\xdd\x17\xa4\x68\x45\xb6\x6c\xaf\xf3\xd2\x76\xd7\x87\xa2\x11\xc9\x99\xdf\xbc\x70\xa4\xbf\x36\x2b\x82\x09\x57\x15\x49\x49\xb3\xc9\x92\xca\x3b\xae\x75\x76\xa3\x28\x3a\x75\x58\xd2\x95\x18\x92\xd9\x35\x85\xda\x29\xfc\x39\x02\x00\xa8\x6b\x6b\x0a\xb8\x51\xb1\x7e\x31\x1e\xfd\x35\x3a\xdb\x63\xe0\xe1\xec\x84\xbd\xd2\x37\x05\xa1\x95\x50\x20\xaf\x01\x74\x49\x20\xad\x45\x9e\xa7\xbf\xca\x5a\x84\xbc\xc2\x2b\xa9\xbd\xb7\x7e\xf1\x1a\x56\xd1\x00\x70\xb4\x00\x55\xad\xa8\x96\xfd\xe8\x00\xed\xd0\x59\x0b\x7a\x06\x1f\x97\x04\x13\x14\x05\x5d\xa2\x82\x0d\xb0\x60\xeb\x17\xa0\x0c\xb7\xd4\xba\x30\x69\x67\x89\xa2\xc5\x83\xe5\xff\x51\xc9\x82\x4a\x26\x9e\xed\x99\x6a\x4f\x64\x2a\xeb\xa1\xc4\xb0\x61\xb4\x01\xd0\x09\xa1\x69\xfa\x76\xd3\xda\xa5\x9f\x73\x28\x0e\x71\x9b\xab\x6e\x4f\xf6\x74\xa3\xa8\x04\x86\x56\xe4\x4d\xa4\x65\x9f\x72\x14\xd2\x63\x9e\xc3\x0a\x9b\x2a\x26\x0b\xbd\xd9\x4a\xd3\x9b\xbc\xa5\xc2\x06\x4a\xf6\x8a\xd6\x03\x1a\x63\x63\xea\xd0\x81\xed\x5c\xa4\x6d\x07\x81\x12\x4f\x62\x98\xa5\x7f\xc7\x19\xeb\x2d\xd4\xde\xfe\x51\x13\x58\x03\x73\x96\xc4\xb4\x12\x2e\x29\x84\xbd\x6d\x31\x3a\xdc\x18\xbd\x98\xe1\x4d\x0b\x06\x78\xcb\xb5\xb6\x46\x7b\x59\x8e\xeb\xda\xac\x6c\x89\xce\x35\x10\x96\xbc\xf6\x31\x1f\x08\xa1\x2e\x89\x42\x80\x15\x2e\xe8\x68\x5f\xf4\x7d\xb5\x6d\x91\xf3\x97\xcb\x92\x7f\x5f\x8e\x65\x63\xfa\x70\x62\xfc\xb5\xb5\xb1\x63\xba\xd8\xb1\x81\x32\x70\x9f\x4f\x52\x85\xd6\x75\x6e\xf3\xaf\x97\xb5\x14\xcb\xd1\xda\xf4\x68\x72\x44\x0b\x54\x5a\x63\x53\x0c\xec\xf5\xc2\x9d\x0a\xdf\x5b\x43\x52\x6c\x2d\x56\xa4\x4b\x36\xc5\x90\x24\xfe\x1f\x2b\xae\xbd\xf6\x16\x3b\xaa\xa9\xd8\x32\x37\x86\x5a\x75\x54\xec\x8f\x65\x64\xbd\x92\xcc\x63\x8b\x9e\xd8\x6c\x39\x20\x8f\x15\x15\x83\xac\x9c\x68\x63\xf6\x3b\x5a\x05\x5b\xad\x1c\x55\x69\xde\xfc\x68\xdf\xef\x58\x26\x75\x50\xae\xe2\x5c\xf8\x39\x18\x37\x75\x19\x2f\xe6\xcf\x72\xff\x0e\xad\x23\xf3\x52\xde\xe3\x13\x21\x0c\xec\x4f\x1e\x5a\x99\xe0\x3a\x9d\x7a\x42\xfa\x96\xbc\xbe\x9c\x0b\x56\xf4\x92\x31\x7c\xba\x7e\xff\x8c\x8a\x2e\x79\xfd\xeb\xc7\x0f\xef\x5f\x12\x28\xda\x7b\x3a\xd1\x35\x19\x2b\x54\xbe\xd8\x55\x7b\x76\x8a\xa6\x1c\xf4\xbb\x43\xc5\x07\x53\x8c\xad\xa1\x24\xa1\x38\xfe\xe2\xc8\xbd\xc8\x52\xcd\xba\x33\xe3\xaf\x31\xb0\x47\x4f\xc6\xad\x8e\xce\xa8\x9b\x4b\xd1\xb2\xa5\x89\xfb\x84\x6b\xda\x9a\x9c\xfd\x5f\x84\x9f\x32\xaf\x4e\x03\x7b\x3a\x57\x7e\xdd\xfd\x5b\xf1\x26\xe8\x4b\x72\x64\x2e\x9a\x67\xcc\xfc\x1f\x94\xc3\xff\x04\x6b\xfc\x76\xfa\x8c\xce\x9a\xf4\xcd\xff\x1d\xcb\x1e\x1f\xdd\x77\x8e\x5a\x89\xd3\x7f\xc1\xc4\x6f\xb8\xcf\x3b\xeb\x8f\x19\x4a\xdb\xd7\x3e\x33\xdc\x51\xb3\x3d\x4b\xee\xd1\xd5\x54\xc0\x97\xfc\x2c\x0d\x07\xfa\xa6\xe4\x0d\x24\x3f\xbf\xd5\x24\x4d\x27\x68\x2e\x93\x7a\x12\x02\x04\x2c\xd5\xde\xd3\x96\x02\xe8\x7f\x8e\x0f\xf9\xde\xa6\x03\x0f\x94\x05\x5c\x30\x3b\x42\x3f\x3e\x70\x60\xd2\xaa\xb2\x2c\xaa\x8e\xbf\x7b\xf3\xa6\xf1\x2e\xfe\x87\xac\xdd\xba\x08\xae\xbc\x6b\x60\xc5\x21\xd8\x5b\x47\x60\xe7\x9d\x68\x29\x97\xd6\x13\x78\xd6\x4d\x64\x9c\x34\x16\xc2\xdc\x46\xf9\x92\xb6\x9d\x03\xc7\xf0\xd7\x36\x44\x05\xa9\xb5\xf8\xb0\xa5\x1f\xb3\x7c\xfc\x87\x44\xec\xa8\xdb\x57\xad\xa5\x4f\xe2\xba\xca\xbc\xde\x17\xec\x7e\x51\xbc\x91\x47\xed\xdd\x0a\x51\x5b\x0e\x68\x86\x25\x3a\xdf\x93\x02\x1b\x52\xf4\x29\xdc\x43\x15\x49\x4e\x0e\x96\xf0\x0c\x26\x8e\x50\xda\x9c\x38\x0c\x0a\x41\x59\xc8\x3c\x82\xe0\xa8\xf7\x68\xfc\x88\xf3\x5f\x48\x8f\xbb\x6e\xbd\x44\xdd\x4a\x3e\xd4\x42\xa1\xd5\xe5\x0f\xea\x76\xd3\x05\x89\x91\x4c\x38\x07\xcf\x1e\x6e\x1d\x97\x77\x9b\x1b\x3b\xc4\xba\xa6\xb9\x50\x58\xf6\xc1\x4e\xea\xd4\x1d\xe8\x8a\x83\x82\x50\x19\x2b\x37\x84\xbe\x6d\x60\x8d\x56\x63\x39\x37\xe2\x77\x1b\x59\x79\x43\x7d\xde\x5e\xd1\x39\x0b\x3d\x1a\xfd\x22\xef\x3f\xf9\xb2\xfd\x1d\x00\x00\xff\xff\x0e\x2a\x60\x2d\xc1\x11\x00\x00")
+var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xd5\x59\xcd\x6e\xdb\x38\x10\xbe\xe7\x29\x68\xf4\x92\x02\xde\x3e\x80\x6e\x89\xbb\xed\x06\xdb\x6c\xbd\x4e\xda\x1e\x8a\xc2\xa0\xc5\xb1\xcd\x35\x45\xaa\x24\x15\x57\x58\xf4\xdd\x77\x86\xa4\x64\xc9\x3f\xa9\xeb\x24\x6d\x37\x87\x00\x92\xc8\x99\x6f\xfe\xc9\xcf\xbe\x2e\x81\x8d\x4c\x51\x80\xcd\x61\x3a\x5a\x42\xbe\x32\x95\x9f\xde\x78\x6e\xfd\x58\xf1\x1c\xde\x5a\x01\x76\x3a\x01\x57\x29\xcf\xfe\x3d\x63\xf8\x57\x55\x52\x64\xec\xc6\x5b\xa9\x17\x83\xb3\xaf\x67\xcf\xf6\x08\xd8\xec\x1d\x19\xed\xe1\x8b\x67\x16\x4a\x0b\x0e\xb4\x77\xcc\x2f\x01\x1f\x83\x44\x33\x0f\x4f\x79\x65\x2d\x7e\x62\xe7\xb6\xd2\x1a\xc5\x3e\x67\x25\x09\x60\x86\x24\xb0\xa2\xf2\xdc\x4b\xa3\xcf\xfc\x7e\xb4\xbb\xca\x22\xd0\x67\xec\x16\x65\x8f\xd0\x14\x54\xc2\x3d\x93\x8e\x2d\x0c\x4a\x67\xde\xb0\x19\x44\x15\x22\xac\xcc\x71\x4d\xb6\x91\xfc\x12\x72\x63\xb9\x07\x41\x7b\x3b\xa2\xe2\x8e\x84\x4a\x6a\xdc\xe6\x1a\x8c\x28\x9b\x2b\x0b\x5c\xd4\x5d\xb9\xe1\xdb\x95\x9e\x1b\x97\x1d\xc2\x2d\xde\xb6\x6b\x92\x26\x74\xbe\x07\x26\xa0\x04\x2d\x08\xad\xd1\xc1\x47\x2e\xbc\x46\x87\x95\xbc\x2e\xc8\x59\x5c\x8b\x9e\x9b\x7e\x4b\x4b\x0a\x5e\xb3\x1c\x1d\xc1\x11\x21\x17\x42\x92\xeb\xb8\x42\xbc\x8d\x8a\xb0\x2c\xbb\xd7\x91\x01\xc3\x34\xfc\x1f\x24\x58\x17\xac\xd2\xf2\x73\x05\x4c\x0a\x36\x37\x36\x60\x2a\xad\xc9\xc1\xb9\xbd\x69\x71\x76\x38\x31\x3a\x36\x23\xea\x00\x8c\xf1\x19\x7e\x8e\x42\x3b\x5e\xa6\xef\x18\x75\x99\x73\xa5\x6a\xe6\x96\x66\xad\xc9\x1f\x9c\xb9\x2a\x07\xd4\x8c\xce\x58\xc0\xbd\x79\xd1\xd5\x15\xd3\x22\xf9\x2f\x85\x25\xfd\x7d\xbc\xcf\x1b\xe3\xcd\x8e\xc1\xa7\x28\x63\x4b\x74\xb6\x25\x03\xf3\x66\x5b\x7d\xda\x09\x05\x97\xaa\x55\x9b\xfe\x1a\xaf\x45\x4f\x5b\xb8\x93\xb0\x9e\xc0\xe7\x4a\x5a\x74\x04\xa6\x96\x03\xcc\xde\x58\x2a\x56\xba\x15\xe3\xce\xa1\xf1\x21\x0b\x6c\x5c\x86\x0e\xc4\xc0\xeb\x0a\x03\x1d\xb7\x37\xa5\xd5\xf3\x66\x50\xd0\x17\x9f\xa0\x5c\x1a\xa3\x80\xeb\x08\x81\x74\xdc\x60\x09\x40\x17\xe7\x2b\x65\xb8\x0f\x81\x0d\xee\x66\x47\x3a\x2c\x39\x7d\x81\x99\xb4\xe6\x75\xb6\xd7\xe4\x14\x91\xb1\x35\x77\x12\x77\x67\xbd\x8f\x05\xf8\xa5\x11\xd9\x7e\x67\xf1\xc2\x54\xda\x77\x3e\xb6\xa8\xc6\x56\xe6\x29\x77\xbd\xf4\xaa\x67\x4a\x37\x49\x25\x76\x0c\x3b\xa7\x2a\x3a\xb2\x1e\x92\x41\x9a\x17\xd0\x4b\xf7\x6f\x36\xa7\x8e\x8c\xe9\x07\x2e\x31\xa2\x45\xa9\xa0\x08\x2d\xf1\x47\xeb\x7e\x65\xec\xa8\x72\xde\x14\xd4\xba\x7e\x2a\x8c\x8b\x12\x9b\xc8\x1d\xf5\xa7\xc7\x81\x11\xb2\x22\xc9\xbc\x7a\x79\x3a\xbc\x9b\x2a\xa7\xd6\xf6\xb3\xbc\xf3\x0a\xbb\x04\xd5\xfe\xe3\x39\x05\x87\x93\x33\x3a\xfb\x4e\x04\x93\xb0\xeb\x04\xf7\x61\xab\xbe\x9a\x5b\x44\xf1\x98\x36\xbc\x9b\xbc\x79\x40\x44\x11\xd2\x1f\xb7\xd7\x6f\x1e\x13\x10\xc9\x3b\x1d\xd1\x04\x04\xb6\xe0\xdc\xff\x32\x2e\x1a\x1b\xe7\x9f\x1c\x14\xbd\x18\x73\x4a\x0d\xec\xbc\x38\x39\x3f\x7e\x5b\x3a\xf6\x89\x62\xda\xee\xc1\x49\xfa\xf5\x84\xc6\xdd\xcb\xe8\x04\xb5\x29\x8a\x88\x2d\x0c\x84\x13\xca\x34\x8a\x9c\xfe\x6e\xad\x39\xa5\x9d\x1e\x07\xec\x74\x5c\x69\x1a\xff\xaa\xf0\x46\x5c\xe7\x80\x8f\x97\xf5\x03\x46\xd2\x0f\xf2\xe1\xff\x02\x6b\x33\x52\x27\xf0\x0f\xd6\xf1\x49\x53\xe4\x08\x88\x87\x06\x6d\xb8\x4f\x91\x16\x3a\x97\x3d\x6c\xfc\x25\x7b\x26\x78\x1c\x7d\x7a\x5b\x5c\x3c\xf0\xa6\x63\xee\x66\x11\x75\xa8\x64\xc6\xa7\x87\xd9\x71\x2b\x0b\xa0\x4b\xce\xd3\x99\x10\x2f\x76\x8f\xe2\x74\xba\xc2\xbc\xe7\x4a\x8a\x70\xf5\x7e\xc2\xde\x41\xaf\xee\x5a\x45\x91\x69\xe8\x9e\x52\xe8\x2a\xf5\x7e\xeb\xfb\x03\x6d\x7b\x09\x4a\xde\x81\xad\x7f\x19\xfb\x76\x01\x7d\xbf\x9d\xfd\x19\x99\xb0\xac\xa0\xee\x57\x26\x22\xa9\x60\x2b\xa3\xe1\x8b\x07\x2d\x58\xd0\xf3\x77\x85\x30\x5a\xfe\xe4\x2a\x90\x35\x16\xf0\x72\xc9\x73\x8f\x10\x7b\x84\x43\xf7\xf6\xbf\x8b\xef\x22\x6c\xd8\xa0\xcc\xfa\x77\xcc\xdd\x0d\xa3\x48\x02\x25\x0e\x27\x3b\x86\xe8\x19\xc4\x83\x40\x89\x45\xb5\xbb\xfa\x8d\xa9\xb9\xf2\xf5\x07\xe9\x96\x20\x6e\x0d\x76\xf0\xe9\x55\x58\xda\x65\x87\x54\x5c\x14\x8c\x1f\x32\x78\xb1\x78\x11\x09\xa9\x25\xb7\x0b\x88\x2e\x49\x17\xe9\x66\x65\x49\x17\xcb\x78\xaf\xc4\xaf\xd9\xde\xeb\x68\xa7\x89\x44\x6a\x2b\xaf\x7b\x65\x79\x08\xf2\x65\x55\xff\x65\xd6\x3d\x98\x05\xb7\x2b\xf0\xc1\xed\x23\x23\x60\x3b\x9a\x56\x72\xed\xaf\xf7\xaf\x09\x4b\x3e\x7b\x54\x7d\xa5\xfd\xa0\x63\xb4\x48\xe9\xd6\x72\x04\xd6\x88\x2a\xf7\xc3\xf0\x20\x60\xce\x89\x9a\x6b\x17\xe5\x28\x92\x48\x88\xca\x51\xfb\x9d\x33\x28\x4a\x5f\x07\x69\xcd\x92\x43\xe9\x7c\x21\x84\xc5\x04\x09\xe6\x84\x0d\x33\xa9\x14\x02\x4b\xef\xb7\xb7\xa5\xd7\x94\xc8\x9b\x2d\x89\x79\x4b\x9c\x97\xc3\xe1\x9b\x53\x7d\x04\x56\x64\x25\xcb\x32\x62\xd2\xa6\x61\x18\x02\xcb\x87\xda\x75\x97\x59\x78\xdd\xb0\x0f\x1d\xc7\xa4\x4f\xd7\x89\x5e\xd8\x0c\xde\x6e\x39\x5c\x27\xea\xb1\xcd\x99\xb7\x5a\x61\x0a\x18\xe7\xe4\x4c\x01\x69\x6e\x38\xb7\x7c\x29\x35\x20\x0e\xdf\x54\x8a\x09\x14\x21\x67\x73\x49\xec\x5b\x58\x36\x64\x86\xca\x69\x2d\x1d\x11\xa0\xbe\xb2\xda\xf5\xe8\xcf\xc4\x7e\xf6\x0a\x8b\x88\x37\x5c\x8b\x71\x58\x4b\xbf\x8c\x4c\x60\xb8\x55\x09\x8c\x83\xf1\x94\x5a\x7f\x42\xdd\x13\x97\xb6\x93\x4e\x4b\x23\x33\x6c\x24\xbe\x41\x3b\x0f\x5c\x50\xd4\xc3\x27\x52\xc5\x99\x26\xae\x48\xc3\x81\xa2\xdc\x62\x82\xcf\xa3\x9e\x77\x56\xb5\x89\x38\xdc\x82\xd2\x7c\x78\x9e\x1d\x4d\x2c\x37\xb9\x79\x13\xa2\x9b\x8c\x48\x11\x6f\x18\xe2\x96\xc5\xc5\x34\x74\xfd\x82\x34\x92\x3a\x76\x4b\x25\xa7\x73\x59\x55\x12\xcf\x4b\xaf\x66\x5c\xd1\xb1\x6d\x88\x8e\x68\x5b\x01\x53\xb2\x90\x8d\xaa\x28\xa0\xc4\x4d\xdd\x76\xd0\x49\x3f\x4b\xac\x5d\x08\x4d\xac\x70\x4a\xb2\x92\x4b\xb1\x89\x49\x48\xb9\x36\x07\x89\x9a\x8d\xbc\xd5\x01\xb7\xbe\x2b\xb1\xcd\x43\xea\x50\xe9\x6c\x79\xd3\xe4\xf6\xf9\xa2\x9f\xae\xe8\xe2\xa2\x97\xa5\x83\x9e\x29\x7b\x6f\x50\x87\x9a\xdf\xe0\xd3\xf3\x43\x44\xf7\x60\xc3\x3f\xdb\xc6\x33\xbb\xdd\x3e\x70\xbf\x9c\x39\x84\xa1\xda\xce\x11\xfd\x10\x73\x09\xbe\x10\xd1\xef\x42\xc4\x86\x9b\xd8\xa5\xf0\x10\x6f\x49\xf5\x84\x09\x58\x63\x4b\xd1\xde\x54\xf9\x32\xb1\xe5\xa9\xba\xba\x29\xbc\x36\x76\xe5\x1a\x06\xbc\x2f\x99\x2b\x15\xcb\x89\x19\x8c\x5c\xa8\x52\xb7\xad\x65\x05\x50\x06\x19\x89\x47\xa7\x88\xe7\x0d\xa7\x7f\xa8\xfd\x9e\x87\xee\x9c\x7d\xa3\x3d\x63\x0c\x9e\xb4\x16\xe2\x4d\xc3\x51\x0e\xef\xb4\x87\xdd\xa8\x0c\xf7\xf4\x24\xcc\x51\x6a\x47\xa1\xff\x1c\x1a\xb9\x41\xc9\xc1\x19\x8d\x28\xf0\xc1\xa6\x72\xe3\x0e\xfb\xaf\x37\x44\x49\x1f\x8f\xe0\x5e\xed\x24\xfc\x1e\xe5\xaf\xc1\xdf\xaf\x3a\x6a\xa1\x62\x03\xed\x2a\x1b\xfa\x02\xf7\x9d\x5f\x4b\x9a\xb6\x1c\x30\x82\x40\x90\x1a\xb3\x60\xa6\x4c\xbe\x6a\xc6\xc0\x2e\xac\x09\xcc\x51\xd4\xb2\x0b\xec\xa8\xa3\xc8\x16\xe8\xc2\x38\x62\xe7\x73\x8a\xdc\x2e\xe8\x19\xf6\x73\x2e\x43\x0b\x6e\x7e\x4c\xe9\x43\xc6\xb8\x27\xd4\xc3\x78\x06\xc3\x65\xf0\xdd\xd0\x2f\xd3\xfa\xe3\x4f\x53\x61\xec\xdd\x54\x33\x97\x5b\x59\xf6\x46\xdf\xb8\xc2\x5e\xd2\x1f\x58\xbb\x76\xcd\x8d\x52\x66\x8d\x71\x42\x03\x21\x1c\x1f\xe2\x7b\x3c\x50\xe9\x05\xf4\x7e\x94\xd8\xea\x2b\xfd\xd2\x3d\x60\xe0\x0e\xe6\x51\x90\x2b\x8e\xb6\xef\x3f\x62\x0c\x43\x37\xf1\x1c\x00\x00")
 
 func schemaGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -8,20 +8,25 @@ import (
 	"flamingo.me/flamingo/v3/framework/web"
 
 	cartApplication "flamingo.me/flamingo-commerce/v3/cart/application"
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
-	graphqlDto "flamingo.me/flamingo-commerce/v3/cart/interfaces/graphql/dto"
+	cartDto "flamingo.me/flamingo-commerce/v3/cart/interfaces/graphql/dto"
 	"flamingo.me/flamingo-commerce/v3/checkout/application/placeorder"
 	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
 	"flamingo.me/flamingo-commerce/v3/checkout/interfaces/graphql/dto"
+	paymentApplication "flamingo.me/flamingo-commerce/v3/payment/application"
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+	productDomain "flamingo.me/flamingo-commerce/v3/product/domain"
 )
 
 // CommerceCheckoutMutationResolver resolves graphql checkout mutations
 type CommerceCheckoutMutationResolver struct {
-	placeorderHandler    *placeorder.Handler
-	cartService          *cartApplication.CartService
-	stateMapper          *dto.StateMapper
-	logger               flamingo.Logger
-	decoratedCartFactory *decorator.DecoratedCartFactory
+	placeorderHandler     *placeorder.Handler
+	cartService           *cartApplication.CartService
+	stateMapper           *dto.StateMapper
+	logger                flamingo.Logger
+	decoratedCartFactory  *decorator.DecoratedCartFactory
+	loyaltyPaymentService *paymentApplication.LoyaltyPaymentService
 }
 
 // Inject dependencies
@@ -30,12 +35,14 @@ func (r *CommerceCheckoutMutationResolver) Inject(
 	cartService *cartApplication.CartService,
 	decoratedCartFactory *decorator.DecoratedCartFactory,
 	stateMapper *dto.StateMapper,
+	loyaltyPaymentService *paymentApplication.LoyaltyPaymentService,
 	logger flamingo.Logger,
 ) {
 	r.placeorderHandler = placeorderHandler
 	r.decoratedCartFactory = decoratedCartFactory
 	r.cartService = cartService
 	r.stateMapper = stateMapper
+	r.loyaltyPaymentService = loyaltyPaymentService
 	r.logger = logger.WithField(flamingo.LogKeyModule, "checkout").WithField(flamingo.LogKeyCategory, "graphql")
 }
 
@@ -54,29 +61,7 @@ func (r *CommerceCheckoutMutationResolver) refresh(
 		return nil, err
 	}
 
-	dc := graphqlDto.NewDecoratedCart(r.decoratedCartFactory.Create(ctx, poctx.Cart))
-
-	graphQLState, err := r.stateMapper.Map(*poctx)
-	if err != nil {
-		return nil, err
-	}
-
-	var orderInfos *dto.PlacedOrderInfos
-	if poctx.PlaceOrderInfo != nil {
-		orderInfos = &dto.PlacedOrderInfos{
-			PaymentInfos:        poctx.PlaceOrderInfo.PaymentInfos,
-			PlacedOrderInfos:    poctx.PlaceOrderInfo.PlacedOrders,
-			Email:               poctx.PlaceOrderInfo.ContactEmail,
-			PlacedDecoratedCart: dc,
-		}
-	}
-
-	return &dto.PlaceOrderContext{
-		Cart:       dc,
-		OrderInfos: orderInfos,
-		State:      graphQLState,
-		UUID:       poctx.UUID,
-	}, nil
+	return mapPlaceOrderContext(ctx, r.decoratedCartFactory, r.stateMapper, *poctx)
 }
 
 // CommerceCheckoutRefreshPlaceOrderBlocking refreshes the current place order blocking
@@ -84,8 +69,19 @@ func (r *CommerceCheckoutMutationResolver) CommerceCheckoutRefreshPlaceOrderBloc
 	return r.refresh(ctx, r.placeorderHandler.RefreshPlaceOrderBlocking)
 }
 
-// CommerceCheckoutStartPlaceOrder starts a new process (if not running)
-func (r *CommerceCheckoutMutationResolver) CommerceCheckoutStartPlaceOrder(ctx context.Context, returnURLRaw string) (*dto.StartPlaceOrderResult, error) {
+// CommerceCheckoutStartPlaceOrder starts a new process (if not running), a replay with the same idempotency key returns the original process
+func (r *CommerceCheckoutMutationResolver) CommerceCheckoutStartPlaceOrder(ctx context.Context, returnURLRaw string, idempotencyKey *string) (*dto.StartPlaceOrderResult, error) {
+	var key string
+	if idempotencyKey != nil {
+		key = *idempotencyKey
+	}
+
+	if pctx, replayed := r.placeorderHandler.ReplayPlaceOrder(ctx, key); replayed {
+		return &dto.StartPlaceOrderResult{
+			UUID: pctx.UUID,
+		}, nil
+	}
+
 	session := web.SessionFromContext(ctx)
 	cart, err := r.cartService.GetCartReceiverService().ViewCart(ctx, session)
 	if err != nil {
@@ -98,7 +94,7 @@ func (r *CommerceCheckoutMutationResolver) CommerceCheckoutStartPlaceOrder(ctx c
 			return nil, err
 		}
 	}
-	startPlaceOrderCommand := placeorder.StartPlaceOrderCommand{Cart: *cart, ReturnURL: returnURL}
+	startPlaceOrderCommand := placeorder.StartPlaceOrderCommand{Cart: *cart, ReturnURL: returnURL, IdempotencyKey: key}
 	pctx, err := r.placeorderHandler.StartPlaceOrder(ctx, startPlaceOrderCommand)
 	if err == placeorder.ErrAnotherPlaceOrderProcessRunning {
 		dtopctx, err := r.CommerceCheckoutRefreshPlaceOrder(ctx)
@@ -117,6 +113,94 @@ func (r *CommerceCheckoutMutationResolver) CommerceCheckoutStartPlaceOrder(ctx c
 	}, nil
 }
 
+// CommerceCheckoutBuyNow starts a new process for a single product with an express cart, the cart of the session stays untouched
+func (r *CommerceCheckoutMutationResolver) CommerceCheckoutBuyNow(ctx context.Context, input dto.BuyNowInput, returnURLRaw string, idempotencyKey *string) (*dto.StartPlaceOrderResult, error) {
+	command := mapBuyNowCommand(input)
+	if idempotencyKey != nil {
+		command.IdempotencyKey = *idempotencyKey
+	}
+
+	if returnURLRaw != "" {
+		returnURL, err := url.Parse(returnURLRaw)
+		if err != nil {
+			return nil, err
+		}
+		command.ReturnURL = returnURL
+	}
+
+	pctx, err := r.placeorderHandler.BuyNow(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.StartPlaceOrderResult{
+		UUID: pctx.UUID,
+	}, nil
+}
+
+// mapBuyNowCommand maps the input to the BuyNowCommand, the delivery code of the delivery input is the code of the delivery
+func mapBuyNowCommand(input dto.BuyNowInput) placeorder.BuyNowCommand {
+	var deliveryInfo cartDomain.DeliveryInfo
+	if input.Delivery != nil {
+		deliveryInfo = input.Delivery.MapToDeliveryInfo(cartDomain.DeliveryInfo{
+			Code:     input.Delivery.LocationCode,
+			Workflow: cartDomain.DeliveryWorkflowDelivery,
+		})
+		deliveryInfo.DeliveryLocation.Code = ""
+	}
+
+	var billingAddress *cartDomain.Address
+	if input.BillingAddress != nil {
+		address := input.BillingAddress.MapToDomainAddress()
+		billingAddress = &address
+	}
+
+	return placeorder.BuyNowCommand{
+		MarketplaceCode:        input.MarketplaceCode,
+		VariantMarketplaceCode: input.VariantMarketplaceCode,
+		Qty:                    input.Qty,
+		DeliveryInfo:           deliveryInfo,
+		BillingAddress:         billingAddress,
+		PaymentGateway:         input.PaymentGateway,
+		PaymentMethod:          input.PaymentMethod,
+	}
+}
+
+// CommerceCheckoutUpdateLoyaltyPaymentSelection selects the payment of the cart with the loyalty points of the customer
+func (r *CommerceCheckoutMutationResolver) CommerceCheckoutUpdateLoyaltyPaymentSelection(ctx context.Context, gateway string, method string, wishedToPay []*dto.LoyaltyWishedToPayInput) (*cartDto.DecoratedCart, error) {
+	session := web.SessionFromContext(ctx)
+	decoratedCart, err := r.cartService.GetCartReceiverService().ViewDecoratedCart(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	var wish *productDomain.WishedToPay
+	if len(wishedToPay) > 0 {
+		wished := productDomain.NewWishedToPay()
+		for _, input := range wishedToPay {
+			wished = wished.Add(input.Type, priceDomain.NewFromFloat(input.Amount, input.Currency))
+		}
+		wish = &wished
+	}
+
+	paymentSelection, err := r.loyaltyPaymentService.PaymentSelection(ctx, gateway, map[string]string{priceDomain.ChargeTypeMain: method}, *decoratedCart, wish)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.cartService.UpdatePaymentSelection(ctx, session, paymentSelection)
+	if err != nil {
+		return nil, err
+	}
+
+	decoratedCart, err = r.cartService.GetCartReceiverService().ViewDecoratedCart(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	return cartDto.NewDecoratedCart(decoratedCart), nil
+}
+
 // CommerceCheckoutCancelPlaceOrder cancels a running place order
 func (r *CommerceCheckoutMutationResolver) CommerceCheckoutCancelPlaceOrder(ctx context.Context) (bool, error) {
 	err := r.placeorderHandler.CancelPlaceOrder(ctx, placeorder.CancelPlaceOrderCommand{})
@@ -0,0 +1,52 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cartDomain "flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/interfaces/controller/forms"
+	"flamingo.me/flamingo-commerce/v3/checkout/interfaces/graphql/dto"
+)
+
+func TestMapBuyNowCommand(t *testing.T) {
+	t.Run("delivery with addresses", func(t *testing.T) {
+		command := mapBuyNowCommand(dto.BuyNowInput{
+			MarketplaceCode:        "fake_configurable",
+			VariantMarketplaceCode: "fake_variant",
+			Qty:                    2,
+			Delivery: &forms.DeliveryForm{
+				DeliveryAddress: forms.AddressForm{Firstname: "Max"},
+				ShippingMethod:  "standard",
+				LocationCode:    "delivery",
+			},
+			BillingAddress: &forms.AddressForm{Email: "max@example.com"},
+			PaymentGateway: "fake_payment_gateway",
+			PaymentMethod:  "payment_completed",
+		})
+
+		assert.Equal(t, "fake_configurable", command.MarketplaceCode)
+		assert.Equal(t, "fake_variant", command.VariantMarketplaceCode)
+		assert.Equal(t, 2, command.Qty)
+		assert.Equal(t, "delivery", command.DeliveryInfo.Code, "the location code of the input is the delivery code")
+		assert.Equal(t, "", command.DeliveryInfo.DeliveryLocation.Code)
+		assert.Equal(t, cartDomain.DeliveryWorkflowDelivery, command.DeliveryInfo.Workflow)
+		assert.Equal(t, "standard", command.DeliveryInfo.Method)
+		require.NotNil(t, command.DeliveryInfo.DeliveryLocation.Address)
+		assert.Equal(t, "Max", command.DeliveryInfo.DeliveryLocation.Address.Firstname)
+		require.NotNil(t, command.BillingAddress)
+		assert.Equal(t, "max@example.com", command.BillingAddress.Email)
+		assert.Equal(t, "fake_payment_gateway", command.PaymentGateway)
+		assert.Equal(t, "payment_completed", command.PaymentMethod)
+	})
+
+	t.Run("only the product", func(t *testing.T) {
+		command := mapBuyNowCommand(dto.BuyNowInput{MarketplaceCode: "fake_simple", Qty: 1})
+
+		assert.Equal(t, cartDomain.DeliveryInfo{}, command.DeliveryInfo)
+		assert.Nil(t, command.BillingAddress)
+		assert.Equal(t, "", command.PaymentGateway)
+	})
+}
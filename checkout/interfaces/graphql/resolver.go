@@ -6,6 +6,7 @@ import (
 	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
 	graphqlDto "flamingo.me/flamingo-commerce/v3/cart/interfaces/graphql/dto"
 	"flamingo.me/flamingo-commerce/v3/checkout/application/placeorder"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
 	"flamingo.me/flamingo-commerce/v3/checkout/interfaces/graphql/dto"
 )
 
@@ -39,9 +40,19 @@ func (r *CommerceCheckoutQueryResolver) CommerceCheckoutCurrentContext(ctx conte
 		return nil, err
 	}
 
-	dc := graphqlDto.NewDecoratedCart(r.decoratedCartFactory.Create(ctx, pctx.Cart))
+	return mapPlaceOrderContext(ctx, r.decoratedCartFactory, r.stateMapper, *pctx)
+}
+
+// mapPlaceOrderContext maps the process context to its graphql dto
+func mapPlaceOrderContext(
+	ctx context.Context,
+	decoratedCartFactory *decorator.DecoratedCartFactory,
+	stateMapper *dto.StateMapper,
+	pctx process.Context,
+) (*dto.PlaceOrderContext, error) {
+	dc := graphqlDto.NewDecoratedCart(decoratedCartFactory.Create(ctx, pctx.Cart))
 
-	graphQLState, err := r.stateMapper.Map(*pctx)
+	graphQLState, err := stateMapper.Map(pctx)
 	if err != nil {
 		return nil, err
 	}
@@ -52,6 +63,8 @@ func (r *CommerceCheckoutQueryResolver) CommerceCheckoutCurrentContext(ctx conte
 			PaymentInfos:        pctx.PlaceOrderInfo.PaymentInfos,
 			PlacedOrderInfos:    pctx.PlaceOrderInfo.PlacedOrders,
 			Email:               pctx.PlaceOrderInfo.ContactEmail,
+			ReviewRequired:      pctx.PlaceOrderInfo.ReviewRequired,
+			RiskScore:           pctx.PlaceOrderInfo.RiskScore,
 			PlacedDecoratedCart: dc,
 		}
 	}
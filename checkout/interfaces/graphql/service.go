@@ -23,11 +23,14 @@ func (*Service) Schema() []byte {
 func (*Service) Types(types *graphql.Types) {
 	types.Map("Commerce_Checkout_PlaceOrderContext", dto.PlaceOrderContext{})
 	types.Map("Commerce_Checkout_StartPlaceOrder_Result", dto.StartPlaceOrderResult{})
+	types.Map("Commerce_Checkout_BuyNow_Input", dto.BuyNowInput{})
+	types.Map("Commerce_Checkout_LoyaltyWishedToPay_Input", dto.LoyaltyWishedToPayInput{})
 	types.Map("Commerce_Checkout_PlacedOrderInfos", dto.PlacedOrderInfos{})
 	types.Map("Commerce_Checkout_PlaceOrderPaymentInfo", application.PlaceOrderPaymentInfo{})
 	types.Map("Commerce_Checkout_PlaceOrderState_State", new(dto.State))
 	types.Map("Commerce_Checkout_PlaceOrderState_State_Wait", dto.Wait{})
 	types.Map("Commerce_Checkout_PlaceOrderState_State_WaitForCustomer", dto.WaitForCustomer{})
+	types.Map("Commerce_Checkout_PlaceOrderState_State_WaitForApproval", dto.WaitForApproval{})
 	types.Map("Commerce_Checkout_PlaceOrderState_State_Success", dto.Success{})
 	types.Map("Commerce_Checkout_PlaceOrderState_State_Failed", dto.Failed{})
 	types.Map("Commerce_Checkout_PlaceOrderState_State_ShowIframe", dto.ShowIframe{})
@@ -39,14 +42,21 @@ func (*Service) Types(types *graphql.Types) {
 	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason_Error", process.ErrorOccurredReason{})
 	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason_PaymentError", process.PaymentErrorOccurredReason{})
 	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason_CartValidationError", process.CartValidationErrorReason{})
+	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason_DeliveryValidationError", process.DeliveryValidationErrorReason{})
 	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason_CanceledByCustomer", process.CanceledByCustomerReason{})
 	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason_PaymentCanceledByCustomer", process.PaymentCanceledByCustomerReason{})
+	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason_ApprovalRejected", process.ApprovalRejectedReason{})
+	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason_Timeout", process.TimeoutReason{})
+	types.Map("Commerce_Checkout_PlaceOrderState_State_FailedReason_RiskRejected", process.RiskRejectedReason{})
 
 	types.Resolve("Query", "Commerce_Checkout_ActivePlaceOrder", CommerceCheckoutQueryResolver{}, "CommerceCheckoutActivePlaceOrder")
 	types.Resolve("Query", "Commerce_Checkout_CurrentContext", CommerceCheckoutQueryResolver{}, "CommerceCheckoutCurrentContext")
 	types.Resolve("Mutation", "Commerce_Checkout_StartPlaceOrder", CommerceCheckoutMutationResolver{}, "CommerceCheckoutStartPlaceOrder")
+	types.Resolve("Mutation", "Commerce_Checkout_BuyNow", CommerceCheckoutMutationResolver{}, "CommerceCheckoutBuyNow")
+	types.Resolve("Mutation", "Commerce_Checkout_UpdateLoyaltyPaymentSelection", CommerceCheckoutMutationResolver{}, "CommerceCheckoutUpdateLoyaltyPaymentSelection")
 	types.Resolve("Mutation", "Commerce_Checkout_CancelPlaceOrder", CommerceCheckoutMutationResolver{}, "CommerceCheckoutCancelPlaceOrder")
 	types.Resolve("Mutation", "Commerce_Checkout_ClearPlaceOrder", CommerceCheckoutMutationResolver{}, "CommerceCheckoutClearPlaceOrder")
 	types.Resolve("Mutation", "Commerce_Checkout_RefreshPlaceOrder", CommerceCheckoutMutationResolver{}, "CommerceCheckoutRefreshPlaceOrder")
 	types.Resolve("Mutation", "Commerce_Checkout_RefreshPlaceOrderBlocking", CommerceCheckoutMutationResolver{}, "CommerceCheckoutRefreshPlaceOrderBlocking")
+	types.Resolve("Subscription", "Commerce_Checkout_PlaceOrderContextChanged", CommerceCheckoutSubscriptionResolver{}, "CommerceCheckoutPlaceOrderContextChanged")
 }
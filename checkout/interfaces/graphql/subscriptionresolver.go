@@ -0,0 +1,61 @@
+package graphql
+
+import (
+	"context"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
+	"flamingo.me/flamingo-commerce/v3/checkout/application/placeorder"
+	"flamingo.me/flamingo-commerce/v3/checkout/interfaces/graphql/dto"
+)
+
+// CommerceCheckoutSubscriptionResolver resolves graphql checkout subscriptions
+type CommerceCheckoutSubscriptionResolver struct {
+	placeOrderHandler    *placeorder.Handler
+	decoratedCartFactory *decorator.DecoratedCartFactory
+	stateMapper          *dto.StateMapper
+	logger               flamingo.Logger
+}
+
+// Inject dependencies
+func (r *CommerceCheckoutSubscriptionResolver) Inject(
+	placeOrderHandler *placeorder.Handler,
+	decoratedCartFactory *decorator.DecoratedCartFactory,
+	stateMapper *dto.StateMapper,
+	logger flamingo.Logger,
+) {
+	r.placeOrderHandler = placeOrderHandler
+	r.decoratedCartFactory = decoratedCartFactory
+	r.stateMapper = stateMapper
+	r.logger = logger.WithField(flamingo.LogKeyModule, "checkout").WithField(flamingo.LogKeyCategory, "graphql")
+}
+
+// CommerceCheckoutPlaceOrderContextChanged pushes the current context followed by every state change of the place order
+func (r *CommerceCheckoutSubscriptionResolver) CommerceCheckoutPlaceOrderContextChanged(ctx context.Context) (<-chan *dto.PlaceOrderContext, error) {
+	contexts, err := r.placeOrderHandler.SubscribePlaceOrderChanges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	placeOrderContexts := make(chan *dto.PlaceOrderContext, 1)
+	go func() {
+		defer close(placeOrderContexts)
+
+		for pctx := range contexts {
+			placeOrderContext, err := mapPlaceOrderContext(ctx, r.decoratedCartFactory, r.stateMapper, pctx)
+			if err != nil {
+				r.logger.WithContext(ctx).Error(err)
+				continue
+			}
+
+			select {
+			case placeOrderContexts <- placeOrderContext:
+			case <-ctx.Done():
+				// drain the contexts until the handler closes them
+			}
+		}
+	}()
+
+	return placeOrderContexts, nil
+}
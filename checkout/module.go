@@ -7,6 +7,7 @@ import (
 	"flamingo.me/flamingo/v3/framework/web"
 	flamingographql "flamingo.me/graphql"
 	"github.com/go-playground/form"
+	"github.com/spf13/cobra"
 
 	"flamingo.me/flamingo-commerce/v3/checkout/infrastructure/contextstore"
 	"flamingo.me/flamingo-commerce/v3/checkout/interfaces/graphql/dto"
@@ -15,10 +16,15 @@ import (
 	"flamingo.me/flamingo-commerce/v3/cart"
 	"flamingo.me/flamingo-commerce/v3/checkout/application/placeorder"
 	"flamingo.me/flamingo-commerce/v3/checkout/domain"
+	approvalDomain "flamingo.me/flamingo-commerce/v3/checkout/domain/approval"
 	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
 	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/states"
+	riskDomain "flamingo.me/flamingo-commerce/v3/checkout/domain/risk"
 	"flamingo.me/flamingo-commerce/v3/checkout/infrastructure"
+	"flamingo.me/flamingo-commerce/v3/checkout/infrastructure/approval"
 	"flamingo.me/flamingo-commerce/v3/checkout/infrastructure/locker"
+	"flamingo.me/flamingo-commerce/v3/checkout/infrastructure/risk"
+	"flamingo.me/flamingo-commerce/v3/checkout/interfaces/cmd"
 	"flamingo.me/flamingo-commerce/v3/checkout/interfaces/controller"
 	"flamingo.me/flamingo-commerce/v3/checkout/interfaces/graphql"
 )
@@ -29,6 +35,13 @@ type (
 		UseFakeSourcingService bool   `inject:"config:commerce.checkout.useFakeSourcingService,optional"`
 		PlaceOrderLockType     string `inject:"config:commerce.checkout.placeorder.lock.type"`
 		PlaceOrderContextStore string `inject:"config:commerce.checkout.placeorder.contextstore.type"`
+		ApprovalEnabled        bool   `inject:"config:commerce.checkout.placeorder.approval.enabled,optional"`
+		ApprovalPolicy         string `inject:"config:commerce.checkout.placeorder.approval.policy,optional"`
+		ApprovalStore          string `inject:"config:commerce.checkout.placeorder.approval.store,optional"`
+		RiskEnabled            bool   `inject:"config:commerce.checkout.placeorder.risk.enabled,optional"`
+		RiskChecker            string `inject:"config:commerce.checkout.placeorder.risk.checker,optional"`
+		DeliveryValidation     bool   `inject:"config:commerce.checkout.placeorder.deliveryValidation.enabled,optional"`
+		GraphEndpoint          bool   `inject:"config:commerce.checkout.placeorder.debug.graphEndpoint,optional"`
 	}
 )
 
@@ -52,9 +65,9 @@ func (m *Module) Configure(injector *dingo.Injector) {
 		injector.Bind(new(process.ContextStore)).To(new(contextstore.Redis))
 		injector.BindMap(new(healthcheck.Status), "placeorder.contextstore.redis").To(new(contextstore.Redis))
 	} else {
-		injector.Bind(new(process.ContextStore)).To(new(contextstore.Memory)).In(dingo.Singleton)
+		injector.Bind(new(contextstore.Memory)).In(dingo.Singleton)
+		injector.Bind(new(process.ContextStore)).To(new(contextstore.Memory))
 	}
-
 	injector.Bind(new(process.PaymentValidatorFunc)).ToInstance(placeorder.PaymentValidator)
 
 	injector.Bind(new(process.State)).AnnotatedWith("startState").To(states.New{})
@@ -76,6 +89,18 @@ func (m *Module) Configure(injector *dingo.Injector) {
 	injector.BindMap(new(process.State), new(states.Redirect).Name()).To(states.Redirect{})
 	injector.BindMap(new(process.State), new(states.PostRedirect).Name()).To(states.PostRedirect{})
 
+	injector.Bind(new(process.Transitions)).In(dingo.Singleton)
+	flamingo.BindEventSubscriber(injector).To(placeorder.TransitionsValidator{})
+	injector.BindMulti(new(cobra.Command)).ToProvider(cmd.PlaceOrderGraphCommand)
+
+	injector.Bind(new(placeorder.Janitor)).In(dingo.Singleton)
+	flamingo.BindEventSubscriber(injector).To(new(placeorder.Janitor))
+
+	injector.Bind(new(placeorder.StateChangeBroker)).In(dingo.Singleton)
+	flamingo.BindEventSubscriber(injector).To(new(placeorder.StateChangeBroker))
+
+	web.BindRoutes(injector, new(expressCheckoutAPIRoutes))
+
 	// bind internal states to graphQL states
 	injector.BindMap(new(dto.State), new(states.New).Name()).To(dto.Wait{})
 	injector.BindMap(new(dto.State), new(states.PrepareCart).Name()).To(dto.Wait{})
@@ -94,12 +119,74 @@ func (m *Module) Configure(injector *dingo.Injector) {
 	injector.BindMap(new(dto.State), new(states.Redirect).Name()).To(dto.Redirect{})
 	injector.BindMap(new(dto.State), new(states.PostRedirect).Name()).To(dto.PostRedirect{})
 
+	if m.ApprovalEnabled {
+		m.configureApproval(injector)
+	}
+
+	if m.RiskEnabled {
+		m.configureRisk(injector)
+	}
+
+	if m.DeliveryValidation {
+		m.configureDeliveryValidation(injector)
+	}
+
 	web.BindRoutes(injector, new(routes))
 	web.BindRoutes(injector, new(apiRoutes))
+	web.BindRoutes(injector, new(paymentNotificationRoutes))
+
+	if m.GraphEndpoint {
+		web.BindRoutes(injector, new(graphRoutes))
+	}
 
 	injector.BindMulti(new(flamingographql.Service)).To(graphql.Service{})
 }
 
+// configureApproval binds the WaitForApproval state, its ports and the approval api
+func (m *Module) configureApproval(injector *dingo.Injector) {
+	if m.ApprovalPolicy == "budget" {
+		injector.Bind(new(approvalDomain.Policy)).To(approval.BudgetPolicy{})
+	}
+
+	if m.ApprovalStore == "memory" {
+		injector.Bind(new(approvalDomain.Store)).To(approval.Memory{}).In(dingo.Singleton)
+	}
+
+	injector.BindMap(new(process.State), new(states.WaitForApproval).Name()).To(states.WaitForApproval{})
+	injector.BindMulti(new(process.Insertion)).ToInstance(process.Insertion{
+		State: new(states.WaitForApproval).Name(),
+		After: new(states.ValidateCart).Name(),
+	})
+	injector.BindMap(new(dto.State), new(states.WaitForApproval).Name()).To(dto.WaitForApproval{})
+
+	web.BindRoutes(injector, new(approvalAPIRoutes))
+}
+
+// configureRisk binds the RiskAssessment state and the rule based risk checker
+func (m *Module) configureRisk(injector *dingo.Injector) {
+	if m.RiskChecker == "rules" {
+		injector.Bind(new(risk.RuleChecker)).In(dingo.Singleton)
+		injector.BindMulti(new(riskDomain.Checker)).To(new(risk.RuleChecker))
+	}
+
+	injector.BindMap(new(process.State), new(states.RiskAssessment).Name()).To(states.RiskAssessment{})
+	injector.BindMulti(new(process.Insertion)).ToInstance(process.Insertion{
+		State: new(states.RiskAssessment).Name(),
+		After: new(states.ValidatePaymentSelection).Name(),
+	})
+	injector.BindMap(new(dto.State), new(states.RiskAssessment).Name()).To(dto.Wait{})
+}
+
+// configureDeliveryValidation binds the ValidateDeliveries state
+func (m *Module) configureDeliveryValidation(injector *dingo.Injector) {
+	injector.BindMap(new(process.State), new(states.ValidateDeliveries).Name()).To(states.ValidateDeliveries{})
+	injector.BindMulti(new(process.Insertion)).ToInstance(process.Insertion{
+		State: new(states.ValidateDeliveries).Name(),
+		After: new(states.ValidateCart).Name(),
+	})
+	injector.BindMap(new(dto.State), new(states.ValidateDeliveries).Name()).To(dto.Wait{})
+}
+
 // CueConfig definition
 func (m *Module) CueConfig() string {
 	return `
@@ -133,6 +220,66 @@ commerce: checkout: {
 				redis: Redis
 			}
 		}
+		idempotencyKeyExpirationSeconds: number | *86400
+		timeouts: {
+			states: [string]: number
+			janitorInterval: number | *60
+		}
+		notifications: {
+			referenceExpiration: number | *604800
+		}
+		liveUpdates: {
+			refreshInterval: number | *5
+		}
+		retry: [string]: {
+			maxRetries: number | *3
+			backoff:    number | *1
+			maxBackoff: number | *30
+		}
+		approval: {
+			enabled:   bool | *false
+			policy:    *"budget" | "custom"
+			store:     *"memory" | "custom"
+			budget:    number | *0
+			approvers: [...string] | *[]
+		}
+		risk: {
+			enabled: bool | *false
+			checker: *"rules" | "custom"
+			trustedProxies: [...string] | *[]
+			rules: {
+				reviewScore: number | *50
+				rejectScore: number | *100
+				velocity: {
+					window:            number | *3600
+					maxOrdersPerEmail: number | *5
+					maxOrdersPerIP:    number | *10
+					score:             number | *50
+				}
+				addressMismatch: {
+					score: number | *25
+				}
+				orderValue: {
+					reviewAbove: number | *0
+					rejectAbove: number | *0
+				}
+			}
+		}
+		deliveryValidation: {
+			enabled: bool | *false
+		}
+		transitions: {
+			strictValidation: bool | *false
+			insert: [...{
+				state:   string
+				before?: string
+				after?:  string
+			}] | *[]
+			add: [string]: [...string]
+		}
+		debug: {
+			graphEndpoint: bool | *false
+		}
 	}
 }`
 }
@@ -216,4 +363,65 @@ func (r *apiRoutes) Routes(registry *web.RouterRegistry) {
 
 	registry.MustRoute("/api/v1/checkout/placeorder/refreshblocking", "checkout.api.placeorder.refreshblocking")
 	registry.HandlePost("checkout.api.placeorder.refreshblocking", r.apiController.RefreshPlaceOrderBlockingAction)
+
+	registry.MustRoute("/api/v1/checkout/placeorder/events", "checkout.api.placeorder.events")
+	registry.HandleGet("checkout.api.placeorder.events", r.apiController.PlaceOrderEventsAction)
+}
+
+type paymentNotificationRoutes struct {
+	paymentNotificationController *controller.PaymentNotificationController
+}
+
+func (r *paymentNotificationRoutes) Inject(paymentNotificationController *controller.PaymentNotificationController) {
+	r.paymentNotificationController = paymentNotificationController
+}
+
+func (r *paymentNotificationRoutes) Routes(registry *web.RouterRegistry) {
+	registry.MustRoute("/api/v1/checkout/placeorder/notification/:gateway", "checkout.api.placeorder.notification")
+	registry.HandlePost("checkout.api.placeorder.notification", r.paymentNotificationController.NotifyAction)
+}
+
+type graphRoutes struct {
+	graphController *controller.PlaceOrderGraphController
+}
+
+func (r *graphRoutes) Inject(graphController *controller.PlaceOrderGraphController) {
+	r.graphController = graphController
+}
+
+func (r *graphRoutes) Routes(registry *web.RouterRegistry) {
+	registry.MustRoute("/debug/checkout/placeorder/graph", "checkout.debug.placeorder.graph")
+	registry.HandleGet("checkout.debug.placeorder.graph", r.graphController.GraphAction)
+}
+
+type approvalAPIRoutes struct {
+	approvalAPIController *controller.ApprovalAPIController
+}
+
+func (r *approvalAPIRoutes) Inject(approvalAPIController *controller.ApprovalAPIController) {
+	r.approvalAPIController = approvalAPIController
+}
+
+func (r *approvalAPIRoutes) Routes(registry *web.RouterRegistry) {
+	registry.MustRoute("/api/v1/checkout/approval/:approvalID", "checkout.api.approval")
+	registry.HandleGet("checkout.api.approval", r.approvalAPIController.GetAction)
+
+	registry.MustRoute("/api/v1/checkout/approval/:approvalID/approve", "checkout.api.approval.approve")
+	registry.HandlePost("checkout.api.approval.approve", r.approvalAPIController.ApproveAction)
+
+	registry.MustRoute("/api/v1/checkout/approval/:approvalID/reject", "checkout.api.approval.reject")
+	registry.HandlePost("checkout.api.approval.reject", r.approvalAPIController.RejectAction)
+}
+
+type expressCheckoutAPIRoutes struct {
+	expressCheckoutAPIController *controller.ExpressCheckoutAPIController
+}
+
+func (r *expressCheckoutAPIRoutes) Inject(expressCheckoutAPIController *controller.ExpressCheckoutAPIController) {
+	r.expressCheckoutAPIController = expressCheckoutAPIController
+}
+
+func (r *expressCheckoutAPIRoutes) Routes(registry *web.RouterRegistry) {
+	registry.MustRoute("/api/v1/checkout/buynow", "checkout.api.buynow")
+	registry.HandlePut("checkout.api.buynow", r.expressCheckoutAPIController.BuyNowAction)
 }
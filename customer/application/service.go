@@ -13,11 +13,15 @@ import (
 var (
 	// ErrNoIdentity user is considered to be not logged in
 	ErrNoIdentity = errors.New("no identity")
+
+	// ErrNoAddressBook no CustomerAddressBookService is bound, addresses can not be saved
+	ErrNoAddressBook = errors.New("no customer address book service available")
 )
 
 // Service for customer management
 type Service struct {
-	customerIdentityService domain.CustomerIdentityService
+	customerIdentityService    domain.CustomerIdentityService
+	customerAddressBookService domain.CustomerAddressBookService
 
 	webIdentityService *auth.WebIdentityService
 }
@@ -26,9 +30,15 @@ type Service struct {
 func (s *Service) Inject(
 	webIdentityService *auth.WebIdentityService,
 	customerIdentityService domain.CustomerIdentityService,
+	optionals *struct {
+		CustomerAddressBookService domain.CustomerAddressBookService `inject:",optional"`
+	},
 ) *Service {
 	s.webIdentityService = webIdentityService
 	s.customerIdentityService = customerIdentityService
+	if optionals != nil {
+		s.customerAddressBookService = optionals.CustomerAddressBookService
+	}
 
 	return s
 }
@@ -54,3 +64,29 @@ func (s *Service) GetUserID(ctx context.Context, request *web.Request) (string,
 
 	return "", ErrNoIdentity
 }
+
+// GetAddressForIdentity returns the address with the given ID from the address book of the authenticated user
+func (s *Service) GetAddressForIdentity(ctx context.Context, request *web.Request, addressID string) (*domain.Address, error) {
+	customer, err := s.GetForIdentity(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.GetAddressByID(customer, addressID)
+}
+
+// AddAddressForIdentity stores the address in the address book of the authenticated user
+//
+// Returns ErrNoAddressBook if no CustomerAddressBookService is available.
+func (s *Service) AddAddressForIdentity(ctx context.Context, request *web.Request, address domain.Address) (*domain.Address, error) {
+	if s.customerAddressBookService == nil {
+		return nil, ErrNoAddressBook
+	}
+
+	identity := s.webIdentityService.Identify(ctx, request)
+	if identity == nil {
+		return nil, ErrNoIdentity
+	}
+
+	return s.customerAddressBookService.AddAddress(ctx, identity, address)
+}
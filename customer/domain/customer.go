@@ -34,6 +34,8 @@ type (
 
 	// Address data of a customer
 	Address struct {
+		// ID references the address in the address book of the customer
+		ID                     string
 		RegionCode             string
 		CountryCode            string
 		Company                string
@@ -52,11 +54,20 @@ type (
 	CustomerIdentityService interface {
 		GetByIdentity(ctx context.Context, identity auth.Identity) (Customer, error)
 	}
+
+	// CustomerAddressBookService to store addresses in the address book of the customer identified by Identity
+	CustomerAddressBookService interface {
+		// AddAddress stores a new address and returns it with its address book ID
+		AddAddress(ctx context.Context, identity auth.Identity, address Address) (*Address, error)
+	}
 )
 
 var (
 	// ErrCustomerNotFoundError - semantic error returned if no customer was found
 	ErrCustomerNotFoundError = errors.New("Customer not found")
+
+	// ErrAddressNotFound - semantic error returned if the address book of a customer does not contain the address
+	ErrAddressNotFound = errors.New("address not found")
 )
 
 const (
@@ -69,3 +80,19 @@ const (
 	// GenderUnknown unknown
 	GenderUnknown = ""
 )
+
+// GetAddressByID returns the address with the given ID from the address book of the customer
+func GetAddressByID(customer Customer, id string) (*Address, error) {
+	if customer == nil || id == "" {
+		return nil, ErrAddressNotFound
+	}
+
+	for _, address := range customer.GetAddresses() {
+		if address.ID == id {
+			address := address
+			return &address, nil
+		}
+	}
+
+	return nil, ErrAddressNotFound
+}
@@ -0,0 +1,42 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/customer/domain"
+)
+
+type testCustomer struct {
+	addresses []domain.Address
+}
+
+func (t *testCustomer) GetID() string                              { return "test" }
+func (t *testCustomer) GetPersonalData() domain.PersonData         { return domain.PersonData{} }
+func (t *testCustomer) GetAddresses() []domain.Address             { return t.addresses }
+func (t *testCustomer) GetDefaultShippingAddress() *domain.Address { return nil }
+func (t *testCustomer) GetDefaultBillingAddress() *domain.Address  { return nil }
+
+func TestGetAddressByID(t *testing.T) {
+	customer := &testCustomer{
+		addresses: []domain.Address{
+			{ID: "home", City: "Munich"},
+			{ID: "office", City: "Berlin"},
+		},
+	}
+
+	address, err := domain.GetAddressByID(customer, "office")
+	assert.NoError(t, err)
+	assert.Equal(t, "Berlin", address.City)
+
+	address, err = domain.GetAddressByID(customer, "unknown")
+	assert.Equal(t, domain.ErrAddressNotFound, err)
+	assert.Nil(t, address)
+
+	_, err = domain.GetAddressByID(customer, "")
+	assert.Equal(t, domain.ErrAddressNotFound, err)
+
+	_, err = domain.GetAddressByID(nil, "home")
+	assert.Equal(t, domain.ErrAddressNotFound, err)
+}
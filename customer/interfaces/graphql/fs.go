@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x6f\xdb\x30\x0c\xbd\xfb\x57\xb0\xb9\xec\x32\xf4\x07\xf8\xb6\xa6\x3b\x04\x28\x8a\xad\xd9\x6d\x18\x02\xcd\xa2\x6d\x62\x32\x65\x50\x34\x5a\x63\xc8\x7f\x1f\x22\x6b\x4b\xe5\x8f\x21\xb9\xc4\xe6\x7b\x7c\xd4\x23\x69\xe9\xd8\x23\xec\x7d\xd7\xa1\x54\x78\xda\x0f\x41\x7d\x87\x72\x3a\xaa\xd1\x21\x9c\x5e\x30\x0c\x4e\xe1\x77\x01\x00\x40\xe1\xc9\x37\x0d\xda\x03\x97\xf0\xe0\xbd\x43\xc3\x77\x11\x18\x02\xca\xe1\xb1\x84\xa3\x0a\x71\x73\x57\x9c\x8b\x62\x43\x36\xd7\xb3\xd7\x94\xcb\x7b\x8f\x12\x3c\x1b\xf7\x68\xd4\x94\x2b\xc9\x5f\x22\x7e\x41\x27\xbe\xb1\x56\x30\x04\x0c\x25\x7c\x5f\xb2\x3f\x4d\xe8\xdd\x8f\xc8\xb5\x58\x9b\xc1\xe9\xb1\xa5\xbe\x27\x6e\x12\xb8\x56\xe5\x6f\xde\xfb\xb4\x07\x72\xee\xc6\xac\x6d\xef\xd7\xe3\x27\xff\x0d\xb2\x45\x29\x2f\x8f\x59\x1f\x6a\x92\xa0\xcf\xa6\xc3\x32\x8f\x3b\xf3\x2f\x9c\xc5\x3b\xb2\xd6\xe1\x84\x64\x71\x43\xfc\xb9\x33\xe4\x66\x3a\xbd\x60\x4d\x6f\x53\xdd\x0c\xf8\x49\xa2\xad\x35\x63\x84\xbe\x51\x87\x53\x98\x8d\xd2\x65\x2e\xa4\xe3\x2d\x33\x4e\xad\x48\x26\x05\x1b\xf2\xbc\xf7\x16\x53\x41\x58\xd6\xad\xfc\xc0\x2a\xe3\x82\x94\x73\xba\xde\xf0\x98\x8b\xcc\x38\x41\x05\x51\x17\x94\x15\xce\xb3\xcc\x59\xef\x39\xc6\x5a\x9a\x2c\x27\x2f\x4f\xc4\x71\xcb\x12\x69\x5a\x29\x45\x87\x7d\xeb\x79\x66\x2c\x6f\xb5\x0f\xba\xf4\x3e\x73\x16\xfb\xba\xfc\x2d\x56\x82\xd3\xec\x37\x38\x97\xf5\x58\xa1\x64\x1c\x4c\xeb\xb0\x55\xeb\x5c\x14\xf8\xa6\xc8\x16\xe2\x70\xbf\x0e\x28\x63\x1a\xe4\x6e\xb7\x8b\xff\x2f\xa8\x83\x70\x00\x6d\x11\x5c\xbc\x0f\x80\x18\x42\xbc\x2f\xa0\xf6\x12\x81\x6a\x10\x41\x56\x08\x18\x02\x79\xce\x04\xb6\x2e\x9b\xb5\xcf\x2a\xbb\x86\x6e\x38\x46\x95\x12\xb7\x0e\x02\x5e\xc0\x30\xa0\x88\x17\xa0\x1a\x48\x81\x02\xb0\xd7\xab\xc6\x7d\x94\x3f\xd4\x30\xfa\x01\xac\xe7\x0f\x0a\xaf\x86\x15\xd4\x43\x6b\xd8\x3a\x8c\xba\x51\xe1\x23\x54\x2d\x56\xbf\xe0\x95\xb4\xdd\x3c\xfc\x34\xbb\xfb\xff\xb7\x60\xcd\x7b\x32\x7d\x2e\xfe\x04\x00\x00\xff\xff\x19\xb5\x6f\x30\xa3\x05\x00\x00")
+var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8d\x54\x4d\x6f\xdb\x30\x0c\xbd\xe7\x57\x30\xb9\xec\x32\xf4\x07\xe8\xb6\xa6\x3b\x04\x28\x8a\x2d\xd9\x6d\x18\x02\xd5\xa2\x6d\xa1\xb2\x64\x50\x34\x5a\x63\xd8\x7f\x9f\x2c\xcb\x4d\x95\xd8\x69\x7c\x30\x64\xf2\xe9\xf1\xeb\x99\xdc\xb7\x08\x5b\xd7\x34\x48\x05\x1e\xb7\x9d\x67\x17\x8e\xc7\x03\x4b\xee\xfc\x71\x8f\xbe\x33\x0c\x7f\x57\x10\x1e\xed\x1f\x5d\x55\xa1\xda\x59\x01\xf7\xce\x19\x94\x76\x1d\x1d\x9d\x47\xda\x3d\x08\x38\x30\x69\x5b\xad\x57\xff\x56\x2b\x9e\xa7\xcd\xf9\xd4\xe9\xca\xf0\xdd\x22\x79\x67\xa5\x79\x90\x2c\xc5\xcc\xe5\x1f\xd1\x3f\x78\x47\xbc\x54\x8a\xd0\x7b\xf4\x02\x7e\x5f\xa2\xbf\x8d\xde\xf5\x9f\x88\x55\x58\xca\x10\xf9\x50\xeb\xb6\x0d\x01\x93\x73\x2e\xca\x74\xef\xe3\xb5\x7b\x6d\xcc\x8d\xb7\x96\x6b\x3f\xa5\x9f\xea\xaf\xd0\x2a\x24\x31\x1c\xb3\x3e\x94\x9a\x3c\x3f\xc9\x06\x45\x6e\x37\xf2\xdd\x9c\xd9\x1b\xad\x94\xc1\xd1\x93\xd9\xa5\xb6\xdf\xc3\xcb\x9c\xf1\xb4\x84\xa5\x7e\x1b\xe3\x66\x8e\x67\x4d\x5c\x2b\xd9\x47\xd7\x2f\xdd\xe0\x68\xb6\x92\xf5\x30\x17\xcd\xfd\x2d\x33\x4e\xad\x48\x45\x6e\xf6\x58\x22\xa1\x2d\x10\x5c\x09\x5c\xe3\x34\x36\xd0\x36\xfb\x7c\x76\xee\x65\x82\x14\x89\x6b\xf3\xae\x93\xb9\xe7\x63\xea\x84\x55\xc8\x71\xeb\x14\x8a\x45\x4c\xe1\x3a\xcb\xd4\x5f\x80\x72\x4c\xd3\x4a\xdb\x8b\x6b\xb1\x3c\x13\x22\x0b\xf8\x1c\xf3\x44\xe2\x0a\x26\x54\xae\xc7\xce\xa6\x96\x3d\x6a\x1b\xc5\x9c\x40\xa3\x72\x19\x0d\xb6\xb5\xb3\x67\x85\xe5\x13\x75\x9e\x2f\x6b\x3f\xab\x2c\x8e\xef\x7a\x17\xa3\xf2\x6c\x92\xd8\x02\x66\x50\xe1\x0c\x24\xc3\x60\x52\xdd\x52\xac\x20\x1e\x7c\xe3\x20\x7f\x88\x1a\xfa\xd9\x21\xf5\x93\x5e\x36\xe3\xd0\xf7\xc8\x1d\x59\x1f\xe5\x60\xe2\xda\x19\x04\xe3\xe3\x5a\x82\xd2\x51\xd2\x09\x05\x69\x31\x84\x1d\xe0\x43\x23\x33\x82\xa5\x9d\x26\x3e\xdb\x76\x37\xa4\x31\xe9\x73\x29\x11\x08\x66\x69\x01\x89\xc2\x41\x97\xa0\x39\x2c\x4f\xb0\x8e\x4f\x1c\x77\x91\x7e\x57\x42\xef\x3a\x50\xce\x7e\x61\x78\x95\x81\x81\x1d\xd4\xd2\x86\xff\x39\xf2\x46\x86\xaf\x50\xd4\x58\xbc\xc0\xab\xe6\x7a\x31\xf9\x71\x76\x77\xd7\x5b\x20\x16\x57\x72\x18\xc9\x7f\xf7\x44\xf8\x16\x0a\x06\x00\x00")
 
 func schemaGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -0,0 +1,114 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	"flamingo.me/flamingo-commerce/v3/notification/domain"
+)
+
+type (
+	// MailSender delivers mails in the background, failed deliveries are retried with an exponential backoff
+	MailSender struct {
+		mailer     domain.Mailer
+		logger     flamingo.Logger
+		maxRetries int
+		backoff    time.Duration
+		maxBackoff time.Duration
+		pending    sync.WaitGroup
+		stop       chan struct{}
+		stopOnce   sync.Once
+	}
+)
+
+// Inject dependencies
+func (s *MailSender) Inject(
+	mailer domain.Mailer,
+	logger flamingo.Logger,
+	config *struct {
+		MaxRetries float64 `inject:"config:commerce.notification.retry.maxRetries,optional"`
+		Backoff    float64 `inject:"config:commerce.notification.retry.backoff,optional"`
+		MaxBackoff float64 `inject:"config:commerce.notification.retry.maxBackoff,optional"`
+	},
+) *MailSender {
+	s.mailer = mailer
+	s.logger = logger.WithField(flamingo.LogKeyModule, "notification").WithField(flamingo.LogKeyCategory, "mailsender")
+	s.backoff = time.Second
+	s.maxBackoff = time.Minute
+
+	if config != nil {
+		s.maxRetries = int(config.MaxRetries)
+		if config.Backoff > 0 {
+			s.backoff = time.Duration(config.Backoff * float64(time.Second))
+		}
+		if config.MaxBackoff > 0 {
+			s.maxBackoff = time.Duration(config.MaxBackoff * float64(time.Second))
+		}
+	}
+
+	s.stop = make(chan struct{})
+
+	return s
+}
+
+// Send the mail in the background, the caller is never blocked by the mailer
+func (s *MailSender) Send(mail domain.Mail) {
+	s.pending.Add(1)
+	go s.deliver(mail)
+}
+
+// Notify stops the retries on shutdown and waits for running deliveries
+func (s *MailSender) Notify(_ context.Context, event flamingo.Event) {
+	if _, ok := event.(*flamingo.ServerShutdownEvent); ok {
+		s.Shutdown()
+	}
+}
+
+// Shutdown stops waiting retries and waits for running deliveries, mails still to be retried are lost
+func (s *MailSender) Shutdown() {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+
+	s.Wait()
+}
+
+// Wait until all mails are delivered or gave up
+func (s *MailSender) Wait() {
+	s.pending.Wait()
+}
+
+func (s *MailSender) deliver(mail domain.Mail) {
+	defer s.pending.Done()
+
+	backoff := s.backoff
+	for retry := 0; ; retry++ {
+		err := s.mailer.Send(context.Background(), mail)
+		if err == nil {
+			return
+		}
+
+		if retry >= s.maxRetries {
+			s.logger.Error(fmt.Sprintf("sending mail %q to %v failed after %d retries: %v", mail.Subject, mail.To, retry, err))
+			return
+		}
+
+		s.logger.Warn(fmt.Sprintf("sending mail %q to %v failed, retry in %s: %v", mail.Subject, mail.To, backoff, err))
+
+		select {
+		case <-time.After(backoff):
+		case <-s.stop:
+			s.logger.Error(fmt.Sprintf("sending mail %q to %v aborted on shutdown: %v", mail.Subject, mail.To, err))
+			return
+		}
+
+		backoff *= 2
+		if backoff > s.maxBackoff {
+			backoff = s.maxBackoff
+		}
+	}
+}
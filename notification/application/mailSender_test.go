@@ -0,0 +1,65 @@
+package application_test
+
+import (
+	"errors"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/notification/application"
+	"flamingo.me/flamingo-commerce/v3/notification/domain"
+)
+
+func provideMailSender(m domain.Mailer, maxRetries float64) *application.MailSender {
+	return new(application.MailSender).Inject(m, flamingo.NullLogger{}, &struct {
+		MaxRetries float64 `inject:"config:commerce.notification.retry.maxRetries,optional"`
+		Backoff    float64 `inject:"config:commerce.notification.retry.backoff,optional"`
+		MaxBackoff float64 `inject:"config:commerce.notification.retry.maxBackoff,optional"`
+	}{
+		MaxRetries: maxRetries,
+		Backoff:    0.001,
+		MaxBackoff: 0.002,
+	})
+}
+
+func TestMailSender_Send(t *testing.T) {
+	mail := domain.Mail{To: []string{"customer@example.com"}, Subject: "subject"}
+
+	t.Run("delivered", func(t *testing.T) {
+		m := &mailer{}
+		sender := provideMailSender(m, 3)
+
+		sender.Send(mail)
+		sender.Wait()
+
+		assert.Equal(t, []domain.Mail{mail}, m.mails)
+	})
+
+	t.Run("failed deliveries are retried", func(t *testing.T) {
+		m := &mailer{err: errors.New("connection refused")}
+		sender := provideMailSender(m, 3)
+
+		sender.Send(mail)
+		sender.Wait()
+
+		assert.Len(t, m.mails, 4, "first attempt and 3 retries")
+	})
+
+	t.Run("shutdown aborts the retries", func(t *testing.T) {
+		m := &mailer{err: errors.New("connection refused")}
+		sender := new(application.MailSender).Inject(m, flamingo.NullLogger{}, &struct {
+			MaxRetries float64 `inject:"config:commerce.notification.retry.maxRetries,optional"`
+			Backoff    float64 `inject:"config:commerce.notification.retry.backoff,optional"`
+			MaxBackoff float64 `inject:"config:commerce.notification.retry.maxBackoff,optional"`
+		}{
+			MaxRetries: 3,
+			Backoff:    3600,
+		})
+
+		sender.Send(mail)
+		sender.Shutdown()
+
+		assert.Len(t, m.mails, 1)
+	})
+}
@@ -0,0 +1,124 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/events"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/placeorder"
+	checkoutApplication "flamingo.me/flamingo-commerce/v3/checkout/application"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/states"
+	"flamingo.me/flamingo-commerce/v3/notification/domain"
+)
+
+const (
+	// TriggerPlaceOrderSuccess sends the confirmation on the SuccessEvent of the place order process, after the payment is validated
+	TriggerPlaceOrderSuccess = "placeOrderSuccess"
+	// TriggerOrderPlaced sends the confirmation on the OrderPlacedEvent of the cart, e.g. for checkouts without place order process
+	TriggerOrderPlaced = "orderPlaced"
+)
+
+type (
+	// OrderConfirmationService sends the order confirmation mail to the customer. It listens to either the OrderPlacedEvent
+	// of the cart or the SuccessEvent of the place order process, so each order is confirmed once by the instance handling the event.
+	OrderConfirmationService struct {
+		sender               *MailSender
+		renderer             *TemplateRenderer
+		decoratedCartFactory *decorator.DecoratedCartFactory
+		localeResolver       domain.LocaleResolver
+		logger               flamingo.Logger
+		from                 string
+		trigger              string
+	}
+)
+
+// Inject dependencies
+func (o *OrderConfirmationService) Inject(
+	sender *MailSender,
+	renderer *TemplateRenderer,
+	decoratedCartFactory *decorator.DecoratedCartFactory,
+	logger flamingo.Logger,
+	config *struct {
+		From    string `inject:"config:commerce.notification.orderConfirmation.from"`
+		Trigger string `inject:"config:commerce.notification.orderConfirmation.trigger,optional"`
+	},
+	optionals *struct {
+		LocaleResolver domain.LocaleResolver `inject:",optional"`
+	},
+) *OrderConfirmationService {
+	o.sender = sender
+	o.renderer = renderer
+	o.decoratedCartFactory = decoratedCartFactory
+	o.logger = logger.WithField(flamingo.LogKeyModule, "notification").WithField(flamingo.LogKeyCategory, "orderconfirmation")
+	o.trigger = TriggerPlaceOrderSuccess
+
+	if config != nil {
+		o.from = config.From
+		if config.Trigger != "" {
+			o.trigger = config.Trigger
+		}
+	}
+
+	if optionals != nil {
+		o.localeResolver = optionals.LocaleResolver
+	}
+
+	if o.from == "" {
+		panic(errors.New("invalid config commerce.notification.orderConfirmation.from: the sender address is required"))
+	}
+
+	return o
+}
+
+// Notify sends the confirmation for placed orders
+func (o *OrderConfirmationService) Notify(ctx context.Context, event flamingo.Event) {
+	switch event := event.(type) {
+	case *events.OrderPlacedEvent:
+		if o.trigger == TriggerOrderPlaced && event.Cart != nil {
+			o.SendConfirmation(ctx, *event.Cart, event.PlacedOrderInfos, nil)
+		}
+	case *states.SuccessEvent:
+		if o.trigger == TriggerPlaceOrderSuccess && event.Context.PlaceOrderInfo != nil {
+			info := event.Context.PlaceOrderInfo
+			o.SendConfirmation(ctx, event.Context.Cart, info.PlacedOrders, info.PaymentInfos)
+		}
+	}
+}
+
+// SendConfirmation renders the order confirmation in the locale of the order and sends it in the background to the contact mail of the cart
+func (o *OrderConfirmationService) SendConfirmation(ctx context.Context, cart cart.Cart, placedOrders placeorder.PlacedOrderInfos, paymentInfos []checkoutApplication.PlaceOrderPaymentInfo) {
+	email := cart.GetContactMail()
+	if email == "" {
+		o.logger.WithContext(ctx).Warn("no order confirmation sent, the cart has no contact mail: ", cart.ID)
+		return
+	}
+
+	var locale string
+	if o.localeResolver != nil {
+		locale = o.localeResolver.Locale(ctx, cart)
+	}
+
+	subject, text, html, err := o.renderer.Render(OrderConfirmationData{
+		Locale:           locale,
+		Email:            email,
+		DecoratedCart:    o.decoratedCartFactory.Create(ctx, cart),
+		PlacedOrderInfos: placedOrders,
+		PaymentInfos:     paymentInfos,
+	})
+	if err != nil {
+		o.logger.WithContext(ctx).Error("rendering the order confirmation failed: ", err)
+		return
+	}
+
+	o.sender.Send(domain.Mail{
+		From:    o.from,
+		To:      []string{email},
+		Subject: subject,
+		Text:    text,
+		HTML:    html,
+	})
+}
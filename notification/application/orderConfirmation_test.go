@@ -0,0 +1,150 @@
+package application_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/events"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/placeorder"
+	checkoutApplication "flamingo.me/flamingo-commerce/v3/checkout/application"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/states"
+	"flamingo.me/flamingo-commerce/v3/notification/application"
+	"flamingo.me/flamingo-commerce/v3/notification/domain"
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+	productDomain "flamingo.me/flamingo-commerce/v3/product/domain"
+)
+
+type (
+	mailer struct {
+		mu    sync.Mutex
+		mails []domain.Mail
+		err   error
+	}
+
+	productService struct{}
+)
+
+func (m *mailer) Send(_ context.Context, mail domain.Mail) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.mails = append(m.mails, mail)
+	return m.err
+}
+
+func (productService) Get(_ context.Context, marketplaceCode string) (productDomain.BasicProduct, error) {
+	return productDomain.SimpleProduct{Identifier: marketplaceCode}, nil
+}
+
+type localeResolver struct{}
+
+func (localeResolver) Locale(_ context.Context, c cart.Cart) string {
+	return c.AdditionalData.CustomAttributes["locale"]
+}
+
+func provideOrderConfirmationService(m domain.Mailer, trigger string) (*application.OrderConfirmationService, *application.MailSender) {
+	sender := new(application.MailSender).Inject(m, flamingo.NullLogger{}, nil)
+
+	decoratedCartFactory := new(decorator.DecoratedCartFactory)
+	decoratedCartFactory.Inject(productService{}, flamingo.NullLogger{}, nil)
+
+	service := new(application.OrderConfirmationService).Inject(
+		sender,
+		new(application.TemplateRenderer).Inject(nil, nil, nil),
+		decoratedCartFactory,
+		flamingo.NullLogger{},
+		&struct {
+			From    string `inject:"config:commerce.notification.orderConfirmation.from"`
+			Trigger string `inject:"config:commerce.notification.orderConfirmation.trigger,optional"`
+		}{
+			From:    "shop@example.com",
+			Trigger: trigger,
+		},
+		&struct {
+			LocaleResolver domain.LocaleResolver `inject:",optional"`
+		}{
+			LocaleResolver: localeResolver{},
+		},
+	)
+
+	return service, sender
+}
+
+func placedCart() *cart.Cart {
+	return &cart.Cart{
+		ID:             "cart",
+		BillingAddress: &cart.Address{Email: "customer@example.com"},
+		Deliveries: []cart.Delivery{{
+			Cartitems: []cart.Item{{MarketplaceCode: "product", ProductName: "Product", Qty: 2, RowPriceGross: priceDomain.NewFromFloat(20, "EUR")}},
+		}},
+		Totalitems: []cart.Totalitem{{Code: "total", Price: priceDomain.NewFromFloat(20, "EUR")}},
+	}
+}
+
+func TestOrderConfirmationService_Notify(t *testing.T) {
+	placedOrders := placeorder.PlacedOrderInfos{{OrderNumber: "1001"}}
+	successEvent := &states.SuccessEvent{Context: process.Context{
+		Cart: *placedCart(),
+		PlaceOrderInfo: &checkoutApplication.PlaceOrderInfo{
+			PlacedOrders: placedOrders,
+			PaymentInfos: []checkoutApplication.PlaceOrderPaymentInfo{{Title: "Invoice", Amount: priceDomain.NewFromFloat(20, "EUR")}},
+		},
+	}}
+
+	t.Run("order placed event", func(t *testing.T) {
+		m := &mailer{}
+		service, sender := provideOrderConfirmationService(m, application.TriggerOrderPlaced)
+
+		service.Notify(context.Background(), &events.OrderPlacedEvent{Cart: placedCart(), PlacedOrderInfos: placedOrders})
+		service.Notify(context.Background(), successEvent)
+		sender.Wait()
+
+		require.Len(t, m.mails, 1)
+		mail := m.mails[0]
+		assert.Equal(t, "shop@example.com", mail.From)
+		assert.Equal(t, []string{"customer@example.com"}, mail.To)
+		assert.Equal(t, "Your order 1001", mail.Subject)
+		assert.True(t, strings.Contains(mail.Text, "2 x Product: 20.00 EUR"), mail.Text)
+		assert.True(t, strings.Contains(mail.HTML, "<li>1001</li>"), mail.HTML)
+	})
+
+	t.Run("place order success by default", func(t *testing.T) {
+		m := &mailer{}
+		service, sender := provideOrderConfirmationService(m, "")
+
+		service.Notify(context.Background(), &events.OrderPlacedEvent{Cart: placedCart(), PlacedOrderInfos: placedOrders})
+		sender.Wait()
+		assert.Len(t, m.mails, 0)
+
+		service.Notify(context.Background(), successEvent)
+		sender.Wait()
+
+		require.Len(t, m.mails, 1)
+		assert.True(t, strings.Contains(m.mails[0].Text, "Payment: Invoice 20.00 EUR"), m.mails[0].Text)
+	})
+
+	t.Run("no contact mail", func(t *testing.T) {
+		m := &mailer{}
+		service, sender := provideOrderConfirmationService(m, application.TriggerOrderPlaced)
+
+		service.Notify(context.Background(), &events.OrderPlacedEvent{Cart: &cart.Cart{}, PlacedOrderInfos: placedOrders})
+		sender.Wait()
+
+		assert.Len(t, m.mails, 0)
+	})
+
+	t.Run("sender address is required", func(t *testing.T) {
+		assert.Panics(t, func() {
+			new(application.OrderConfirmationService).Inject(nil, nil, nil, flamingo.NullLogger{}, nil, nil)
+		})
+	})
+}
@@ -0,0 +1,219 @@
+package application
+
+import (
+	"bytes"
+	"fmt"
+	htmlTemplate "html/template"
+	"io/ioutil"
+	textTemplate "text/template"
+
+	"flamingo.me/flamingo/v3/core/locale/application"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/placeorder"
+	checkoutApplication "flamingo.me/flamingo-commerce/v3/checkout/application"
+	priceApplication "flamingo.me/flamingo-commerce/v3/price/application"
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+type (
+	// TemplateRenderer renders the localized order confirmation mail, the labels are translated with the
+	// flamingo locale module and can be used with {{__ "key" "default label"}} in the templates
+	TemplateRenderer struct {
+		labelService *application.LabelService
+		priceService *priceApplication.Service
+		locale       string
+		subject      *textTemplate.Template
+		text         *textTemplate.Template
+		html         *htmlTemplate.Template
+	}
+
+	// OrderConfirmationData is passed to the order confirmation templates
+	OrderConfirmationData struct {
+		// Locale of the labels, the configured locale is used if empty
+		Locale           string
+		Email            string
+		DecoratedCart    *decorator.DecoratedCart
+		PlacedOrderInfos placeorder.PlacedOrderInfos
+		// PaymentInfos are only known for orders placed by the place order process
+		PaymentInfos []checkoutApplication.PlaceOrderPaymentInfo
+	}
+)
+
+const (
+	defaultSubjectTemplate = `{{__ "notification.orderconfirmation.subject" "Your order"}}{{range .PlacedOrderInfos}} {{.OrderNumber}}{{end}}`
+
+	defaultTextTemplate = `{{__ "notification.orderconfirmation.greeting" "Thank you for your order!"}}
+
+{{__ "notification.orderconfirmation.ordernumbers" "Order numbers"}}:
+{{range .PlacedOrderInfos}}* {{.OrderNumber}}
+{{end}}
+{{range .DecoratedCart.DecoratedDeliveries}}{{range .DecoratedItems}}{{.Item.Qty}} x {{.Item.ProductName}}: {{formatPrice .Item.RowPriceGross}}
+{{end}}{{end}}
+{{__ "notification.orderconfirmation.grandtotal" "Grand total"}}: {{formatPrice .DecoratedCart.Cart.GrandTotal}}
+{{range .PaymentInfos}}{{__ "notification.orderconfirmation.payment" "Payment"}}: {{.Title}} {{formatPrice .Amount}}
+{{end}}`
+
+	defaultHTMLTemplate = `<html>
+<body>
+<p>{{__ "notification.orderconfirmation.greeting" "Thank you for your order!"}}</p>
+<p>{{__ "notification.orderconfirmation.ordernumbers" "Order numbers"}}:</p>
+<ul>{{range .PlacedOrderInfos}}<li>{{.OrderNumber}}</li>{{end}}</ul>
+<table>
+{{range .DecoratedCart.DecoratedDeliveries}}{{range .DecoratedItems}}<tr><td>{{.Item.Qty}} x</td><td>{{.Item.ProductName}}</td><td>{{formatPrice .Item.RowPriceGross}}</td></tr>
+{{end}}{{end}}<tr><td></td><td>{{__ "notification.orderconfirmation.grandtotal" "Grand total"}}</td><td>{{formatPrice .DecoratedCart.Cart.GrandTotal}}</td></tr>
+</table>
+{{range .PaymentInfos}}<p>{{__ "notification.orderconfirmation.payment" "Payment"}}: {{.Title}} {{formatPrice .Amount}}</p>
+{{end}}</body>
+</html>`
+)
+
+// Inject dependencies, invalid templates panic on startup
+func (r *TemplateRenderer) Inject(
+	labelService *application.LabelService,
+	priceService *priceApplication.Service,
+	config *struct {
+		Locale          string `inject:"config:commerce.notification.orderConfirmation.locale,optional"`
+		SubjectTemplate string `inject:"config:commerce.notification.orderConfirmation.templates.subject,optional"`
+		TextTemplate    string `inject:"config:commerce.notification.orderConfirmation.templates.text,optional"`
+		HTMLTemplate    string `inject:"config:commerce.notification.orderConfirmation.templates.html,optional"`
+	},
+) *TemplateRenderer {
+	r.labelService = labelService
+	r.priceService = priceService
+
+	var subjectFile, textFile, htmlFile string
+	if config != nil {
+		r.locale = config.Locale
+		subjectFile = config.SubjectTemplate
+		textFile = config.TextTemplate
+		htmlFile = config.HTMLTemplate
+	}
+
+	if err := r.parseTemplates(subjectFile, textFile, htmlFile); err != nil {
+		panic(fmt.Errorf("invalid config commerce.notification.orderConfirmation.templates: %w", err))
+	}
+
+	return r
+}
+
+// parseTemplates reads the configured template files, the default templates are used for empty file names
+func (r *TemplateRenderer) parseTemplates(subjectFile string, textFile string, htmlFile string) error {
+	funcs := map[string]interface{}{
+		"__":          r.translator(r.locale),
+		"formatPrice": r.formatPrice,
+	}
+
+	subject, err := loadTemplate(subjectFile, defaultSubjectTemplate)
+	if err != nil {
+		return err
+	}
+	if r.subject, err = textTemplate.New("subject").Funcs(funcs).Parse(subject); err != nil {
+		return err
+	}
+
+	text, err := loadTemplate(textFile, defaultTextTemplate)
+	if err != nil {
+		return err
+	}
+	if r.text, err = textTemplate.New("text").Funcs(funcs).Parse(text); err != nil {
+		return err
+	}
+
+	html, err := loadTemplate(htmlFile, defaultHTMLTemplate)
+	if err != nil {
+		return err
+	}
+	if r.html, err = htmlTemplate.New("html").Funcs(funcs).Parse(html); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Render the subject, text and HTML body of the order confirmation in the locale of the data
+func (r *TemplateRenderer) Render(data OrderConfirmationData) (subject string, text string, html string, err error) {
+	locale := data.Locale
+	if locale == "" {
+		locale = r.locale
+	}
+	// the parsed templates are never executed, so they can be cloned with the translation of each locale
+	funcs := map[string]interface{}{"__": r.translator(locale)}
+
+	subjectClone, err := r.subject.Clone()
+	if err != nil {
+		return "", "", "", fmt.Errorf("subject template: %w", err)
+	}
+	textClone, err := r.text.Clone()
+	if err != nil {
+		return "", "", "", fmt.Errorf("text template: %w", err)
+	}
+	htmlClone, err := r.html.Clone()
+	if err != nil {
+		return "", "", "", fmt.Errorf("html template: %w", err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := subjectClone.Funcs(funcs).Execute(buf, data); err != nil {
+		return "", "", "", fmt.Errorf("subject template: %w", err)
+	}
+	subject = buf.String()
+
+	buf.Reset()
+	if err := textClone.Funcs(funcs).Execute(buf, data); err != nil {
+		return "", "", "", fmt.Errorf("text template: %w", err)
+	}
+	text = buf.String()
+
+	buf.Reset()
+	if err := htmlClone.Funcs(funcs).Execute(buf, data); err != nil {
+		return "", "", "", fmt.Errorf("html template: %w", err)
+	}
+	html = buf.String()
+
+	return subject, text, html, nil
+}
+
+// translator returns the template function that translates labels in the locale
+func (r *TemplateRenderer) translator(locale string) func(key string, defaultLabel ...string) string {
+	return func(key string, defaultLabel ...string) string {
+		if r.labelService == nil {
+			if len(defaultLabel) > 0 {
+				return defaultLabel[0]
+			}
+			return key
+		}
+
+		label := r.labelService.NewLabel(key)
+		if len(defaultLabel) > 0 {
+			label.SetDefaultLabel(defaultLabel[0])
+		}
+		if locale != "" {
+			label.SetLocale(locale)
+		}
+
+		return label.String()
+	}
+}
+
+func (r *TemplateRenderer) formatPrice(price priceDomain.Price) string {
+	if r.priceService == nil {
+		return fmt.Sprintf("%.2f %s", price.GetPayable().FloatAmount(), price.Currency())
+	}
+
+	return r.priceService.FormatPrice(price)
+}
+
+// loadTemplate reads the template file, the default template is used if no file is configured
+func loadTemplate(file string, defaultTemplate string) (string, error) {
+	if file == "" {
+		return defaultTemplate, nil
+	}
+
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+	"errors"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+)
+
+type (
+	// Mailer is the secondary port that delivers mails
+	Mailer interface {
+		// Send the mail, errors are retried by the application layer
+		Send(ctx context.Context, mail Mail) error
+	}
+
+	// LocaleResolver is an optional secondary port that determines the locale of the mails of an order, e.g. by the site
+	// or the language of the customer
+	LocaleResolver interface {
+		// Locale of the mails of the cart, empty for the configured locale
+		Locale(ctx context.Context, cart cart.Cart) string
+	}
+
+	// Mail with a text and an optional HTML body
+	Mail struct {
+		From    string
+		To      []string
+		Subject string
+		Text    string
+		HTML    string
+	}
+)
+
+var (
+	// ErrNoRecipient is returned for mails without recipients
+	ErrNoRecipient = errors.New("mail has no recipient")
+)
+
+// Validate checks that the mail can be sent
+func (m Mail) Validate() error {
+	if len(m.To) == 0 {
+		return ErrNoRecipient
+	}
+
+	return nil
+}
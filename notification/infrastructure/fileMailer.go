@@ -0,0 +1,93 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	"flamingo.me/flamingo-commerce/v3/notification/domain"
+)
+
+type (
+	// FileMailer writes each mail as .eml file into a directory, only suited for local development
+	FileMailer struct {
+		directory string
+		logger    flamingo.Logger
+	}
+
+	// LogMailer logs the mails instead of sending them, only suited for local development
+	LogMailer struct {
+		logger flamingo.Logger
+	}
+)
+
+var (
+	_ domain.Mailer = new(FileMailer)
+	_ domain.Mailer = new(LogMailer)
+)
+
+// Inject dependencies
+func (f *FileMailer) Inject(
+	logger flamingo.Logger,
+	config *struct {
+		Directory string `inject:"config:commerce.notification.mailer.file.directory"`
+	},
+) *FileMailer {
+	f.logger = logger.WithField(flamingo.LogKeyModule, "notification").WithField(flamingo.LogKeyCategory, "mailer")
+	if config != nil {
+		f.directory = config.Directory
+	}
+
+	return f
+}
+
+// Send writes the mail into the directory
+func (f *FileMailer) Send(ctx context.Context, mail domain.Mail) error {
+	if err := mail.Validate(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	message, err := buildMessage(mail, now)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(f.directory, 0755); err != nil {
+		return err
+	}
+
+	filename := filepath.Join(f.directory, fmt.Sprintf("%s_%s.eml", now.Format("20060102150405"), uuid.New().String()))
+	if err := ioutil.WriteFile(filename, message, 0644); err != nil {
+		return err
+	}
+
+	f.logger.WithContext(ctx).Info(fmt.Sprintf("mail %q to %v written to %s", mail.Subject, mail.To, filename))
+
+	return nil
+}
+
+// Inject dependencies
+func (l *LogMailer) Inject(logger flamingo.Logger) *LogMailer {
+	l.logger = logger.WithField(flamingo.LogKeyModule, "notification").WithField(flamingo.LogKeyCategory, "mailer")
+
+	return l
+}
+
+// Send logs the mail
+func (l *LogMailer) Send(ctx context.Context, mail domain.Mail) error {
+	if err := mail.Validate(); err != nil {
+		return err
+	}
+
+	l.logger.WithContext(ctx).Info(fmt.Sprintf("mail from %s to %v\nSubject: %s\n\n%s", mail.From, mail.To, mail.Subject, mail.Text))
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package infrastructure_test
+
+import (
+	"context"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"flamingo.me/flamingo-commerce/v3/notification/domain"
+	"flamingo.me/flamingo-commerce/v3/notification/infrastructure"
+)
+
+func TestFileMailer_Send(t *testing.T) {
+	directory, err := ioutil.TempDir("", "mails")
+	require.NoError(t, err)
+	defer os.RemoveAll(directory)
+
+	mailer := new(infrastructure.FileMailer).Inject(flamingo.NullLogger{}, &struct {
+		Directory string `inject:"config:commerce.notification.mailer.file.directory"`
+	}{Directory: directory})
+
+	t.Run("no recipient", func(t *testing.T) {
+		assert.Equal(t, domain.ErrNoRecipient, mailer.Send(context.Background(), domain.Mail{Subject: "subject"}))
+	})
+
+	t.Run("text and html", func(t *testing.T) {
+		require.NoError(t, mailer.Send(context.Background(), domain.Mail{
+			From:    "shop@example.com",
+			To:      []string{"customer@example.com"},
+			Subject: "Your order 1001 – thank you",
+			Text:    "Thank you for your order!",
+			HTML:    "<p>Thank you for your order!</p>",
+		}))
+
+		files, err := filepath.Glob(filepath.Join(directory, "*.eml"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+
+		file, err := os.Open(files[0])
+		require.NoError(t, err)
+		defer file.Close()
+
+		message, err := mail.ReadMessage(file)
+		require.NoError(t, err)
+
+		subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+		require.NoError(t, err)
+		assert.Equal(t, "Your order 1001 – thank you", subject)
+		assert.Equal(t, "customer@example.com", message.Header.Get("To"))
+
+		mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/alternative", mediaType)
+
+		reader := multipart.NewReader(message.Body, params["boundary"])
+		var contentTypes []string
+		for {
+			part, err := reader.NextPart()
+			if err != nil {
+				break
+			}
+			body, _ := ioutil.ReadAll(part)
+			contentTypes = append(contentTypes, part.Header.Get("Content-Type"))
+			assert.True(t, strings.Contains(string(body), "Thank you for your order!"))
+		}
+		assert.Equal(t, []string{"text/plain; charset=utf-8", "text/html; charset=utf-8"}, contentTypes)
+	})
+}
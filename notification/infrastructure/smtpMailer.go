@@ -0,0 +1,63 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"time"
+
+	"flamingo.me/flamingo-commerce/v3/notification/domain"
+)
+
+type (
+	// SMTPMailer delivers mails via SMTP, STARTTLS is used if the server supports it
+	SMTPMailer struct {
+		address  string
+		host     string
+		username string
+		password string
+		sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+	}
+)
+
+var _ domain.Mailer = new(SMTPMailer)
+
+// Inject dependencies
+func (s *SMTPMailer) Inject(
+	config *struct {
+		Host     string  `inject:"config:commerce.notification.mailer.smtp.host"`
+		Port     float64 `inject:"config:commerce.notification.mailer.smtp.port"`
+		Username string  `inject:"config:commerce.notification.mailer.smtp.username,optional"`
+		Password string  `inject:"config:commerce.notification.mailer.smtp.password,optional"`
+	},
+) *SMTPMailer {
+	if config != nil {
+		s.host = config.Host
+		s.address = fmt.Sprintf("%s:%d", config.Host, int(config.Port))
+		s.username = config.Username
+		s.password = config.Password
+	}
+
+	s.sendMail = smtp.SendMail
+
+	return s
+}
+
+// Send the mail to the SMTP server
+func (s *SMTPMailer) Send(_ context.Context, mail domain.Mail) error {
+	if err := mail.Validate(); err != nil {
+		return err
+	}
+
+	message, err := buildMessage(mail, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if s.username != "" {
+		auth = smtp.PlainAuth("", s.username, s.password, s.host)
+	}
+
+	return s.sendMail(s.address, auth, mail.From, mail.To, message)
+}
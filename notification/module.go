@@ -0,0 +1,96 @@
+package notification
+
+import (
+	"flamingo.me/dingo"
+	"flamingo.me/flamingo/v3/core/locale"
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	"flamingo.me/flamingo-commerce/v3/checkout"
+	"flamingo.me/flamingo-commerce/v3/notification/application"
+	"flamingo.me/flamingo-commerce/v3/notification/domain"
+	"flamingo.me/flamingo-commerce/v3/notification/infrastructure"
+	"flamingo.me/flamingo-commerce/v3/price"
+)
+
+type (
+	// Module registers the notification module, it sends the order confirmation mail
+	Module struct {
+		mailerType string
+	}
+)
+
+// Inject dependencies
+func (m *Module) Inject(
+	config *struct {
+		MailerType string `inject:"config:commerce.notification.mailer.type,optional"`
+	},
+) {
+	if config != nil {
+		m.mailerType = config.MailerType
+	}
+}
+
+// Configure DI
+func (m *Module) Configure(injector *dingo.Injector) {
+	switch m.mailerType {
+	case "smtp":
+		injector.Bind((*domain.Mailer)(nil)).To(infrastructure.SMTPMailer{})
+	case "file":
+		injector.Bind((*domain.Mailer)(nil)).To(infrastructure.FileMailer{})
+	case "log":
+		injector.Bind((*domain.Mailer)(nil)).To(infrastructure.LogMailer{})
+	}
+
+	injector.Bind(new(application.MailSender)).In(dingo.Singleton)
+	flamingo.BindEventSubscriber(injector).To(new(application.MailSender))
+
+	injector.Bind(new(application.TemplateRenderer)).In(dingo.Singleton)
+	injector.Bind(new(application.OrderConfirmationService)).In(dingo.Singleton)
+	flamingo.BindEventSubscriber(injector).To(new(application.OrderConfirmationService))
+}
+
+// CueConfig defines the notification module configuration
+func (*Module) CueConfig() string {
+	return `
+commerce: {
+	notification: {
+		mailer: {
+			type: *"log" | "file" | "smtp" | "custom"
+			file: {
+				directory: string | *"mails"
+			}
+			smtp: {
+				host:     string | *"localhost"
+				port:     number | *25
+				username: string | *""
+				password: string | *""
+			}
+		}
+		retry: {
+			maxRetries: number | *5
+			backoff:    number | *2
+			maxBackoff: number | *300
+		}
+		orderConfirmation: {
+			from:    string & !=""
+			locale:  string | *""
+			trigger: *"placeOrderSuccess" | "orderPlaced"
+			templates: {
+				subject: string | *""
+				text:    string | *""
+				html:    string | *""
+			}
+		}
+	}
+}
+`
+}
+
+// Depends on other modules
+func (*Module) Depends() []dingo.Module {
+	return []dingo.Module{
+		new(locale.Module),
+		new(price.Module),
+		new(checkout.Module),
+	}
+}
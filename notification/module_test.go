@@ -0,0 +1,21 @@
+package notification_test
+
+import (
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/config"
+
+	"flamingo.me/flamingo-commerce/v3/notification"
+)
+
+func TestModule_Configure(t *testing.T) {
+	for _, mailerType := range []string{"log", "file", "smtp"} {
+		if err := config.TryModules(config.Map{
+			"commerce.notification.mailer.type":            mailerType,
+			"commerce.notification.orderConfirmation.from": "shop@example.com",
+			"core.auth.web.debugController":                false,
+		}, new(notification.Module)); err != nil {
+			t.Error(mailerType, err)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
+	"flamingo.me/flamingo-commerce/v3/payment/domain"
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+	productDomain "flamingo.me/flamingo-commerce/v3/product/domain"
+)
+
+type (
+	// LoyaltyPaymentService uses the loyalty point accounts of the customers as payment source
+	LoyaltyPaymentService struct {
+		logger         flamingo.Logger
+		accountService domain.LoyaltyAccountService
+	}
+)
+
+// Inject dependencies
+func (s *LoyaltyPaymentService) Inject(
+	logger flamingo.Logger,
+	optionals *struct {
+		LoyaltyAccountService domain.LoyaltyAccountService `inject:",optional"`
+	},
+) *LoyaltyPaymentService {
+	s.logger = logger.WithField(flamingo.LogKeyModule, "payment").WithField(flamingo.LogKeyCategory, "loyalty")
+	if optionals != nil {
+		s.accountService = optionals.LoyaltyAccountService
+	}
+
+	return s
+}
+
+// IsAvailable returns true if a LoyaltyAccountService is bound
+func (s *LoyaltyPaymentService) IsAvailable() bool {
+	return s.accountService != nil
+}
+
+// Balances returns the available points of the customer of the cart for the given loyalty types
+func (s *LoyaltyPaymentService) Balances(ctx context.Context, c cart.Cart, loyaltyTypes []string) (map[string]priceDomain.Price, error) {
+	balances := make(map[string]priceDomain.Price)
+	if !s.IsAvailable() || !c.BelongsToAuthenticatedUser {
+		return balances, nil
+	}
+
+	for _, loyaltyType := range loyaltyTypes {
+		balance, err := s.accountService.Balance(ctx, c.AuthenticatedUserID, loyaltyType)
+		if err != nil {
+			return nil, err
+		}
+
+		if balance.IsPositive() {
+			balances[loyaltyType] = balance
+		}
+	}
+
+	return balances, nil
+}
+
+// PaymentSelection returns a payment selection that pays the cart items with the loyalty points of the customer up to the balance,
+// wishedToPay limits the points to use per loyalty type, without wish as many points as possible are used.
+// If the customer has no points the default payment selection is returned.
+func (s *LoyaltyPaymentService) PaymentSelection(ctx context.Context, gateway string, chargeTypeToPaymentMethod map[string]string, decoratedCart decorator.DecoratedCart, wishedToPay *productDomain.WishedToPay) (cart.PaymentSelection, error) {
+	balances, err := s.Balances(ctx, decoratedCart.Cart, s.loyaltyTypes(decoratedCart))
+	if err != nil {
+		return nil, err
+	}
+
+	pricedItems := decoratedCart.Cart.GetAllPaymentRequiredItems()
+	remainingWishes := make(map[string]priceDomain.Price, len(balances))
+	for loyaltyType, balance := range balances {
+		remainingWishes[loyaltyType] = balance
+		if wishedToPay == nil {
+			continue
+		}
+
+		if wish := wishedToPay.GetByType(loyaltyType); wish != nil && wish.IsLessThen(balance) {
+			remainingWishes[loyaltyType] = *wish
+		}
+	}
+
+	loyaltyCharges := make(map[string]priceDomain.Charges)
+	for _, item := range decoratedCart.GetAllDecoratedItems() {
+		if item.Product == nil {
+			continue
+		}
+
+		itemWish := productDomain.NewWishedToPay()
+		for loyaltyType, wish := range remainingWishes {
+			itemWish = itemWish.Add(loyaltyType, wish)
+		}
+
+		valuedPriceToPay := pricedItems.CartItems()[item.Item.ID]
+		charges := item.Product.SaleableData().GetLoyaltyChargeSplit(&valuedPriceToPay, &itemWish, item.Item.Qty)
+		loyaltyCharges[item.Item.ID] = charges
+
+		for loyaltyType, wish := range remainingWishes {
+			if charge, found := charges.GetByType(loyaltyType); found {
+				remainingWishes[loyaltyType], _ = wish.Sub(charge.Price)
+			}
+		}
+	}
+
+	split, err := cart.PaymentSplitService{}.SplitWithLoyaltyPoints(chargeTypeToPaymentMethod, pricedItems, loyaltyCharges, balances)
+	if errors.Is(err, cart.ErrSplitNoLoyaltyBalance) {
+		return cart.NewDefaultPaymentSelection(gateway, chargeTypeToPaymentMethod, decoratedCart.Cart)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return cart.RemoveZeroCharges(cart.NewPaymentSelection(gateway, *split), chargeTypeToPaymentMethod), nil
+}
+
+// ReservePoints reserves the loyalty points of the payment selection of the cart, e.g. when the payment is created
+// All charges that are neither main nor gift card charges are treated as loyalty charges
+func (s *LoyaltyPaymentService) ReservePoints(ctx context.Context, c cart.Cart, reference string) ([]domain.LoyaltyReservation, error) {
+	if !s.IsAvailable() || c.PaymentSelection == nil {
+		return nil, nil
+	}
+
+	points := loyaltyPointsByType(c.PaymentSelection)
+	if len(points) == 0 {
+		return nil, nil
+	}
+
+	if !c.BelongsToAuthenticatedUser {
+		return nil, domain.ErrLoyaltyAccountRequired
+	}
+
+	reservations := make([]domain.LoyaltyReservation, 0, len(points))
+	for loyaltyType, amount := range points {
+		reservation, err := s.accountService.Reserve(ctx, c.AuthenticatedUserID, loyaltyType, amount, reference)
+		if err != nil {
+			_ = s.ReleasePoints(ctx, reservations)
+			return nil, err
+		}
+
+		reservations = append(reservations, reservation)
+	}
+
+	return reservations, nil
+}
+
+// CommitPoints debits the reserved points, e.g. after the order was placed successfully
+func (s *LoyaltyPaymentService) CommitPoints(ctx context.Context, reservations []domain.LoyaltyReservation) error {
+	var result error
+	for _, reservation := range reservations {
+		if err := s.accountService.Commit(ctx, reservation); err != nil {
+			s.logger.WithContext(ctx).Error("commit of loyalty reservation ", reservation.ID, " failed: ", err)
+			result = err
+		}
+	}
+
+	return result
+}
+
+// ReleasePoints frees the reserved points, e.g. on rollback of the place order
+func (s *LoyaltyPaymentService) ReleasePoints(ctx context.Context, reservations []domain.LoyaltyReservation) error {
+	var result error
+	for _, reservation := range reservations {
+		if err := s.accountService.Release(ctx, reservation); err != nil {
+			s.logger.WithContext(ctx).Error("release of loyalty reservation ", reservation.ID, " failed: ", err)
+			result = err
+		}
+	}
+
+	return result
+}
+
+// loyaltyTypes returns the loyalty price types of all products in the cart
+func (s *LoyaltyPaymentService) loyaltyTypes(decoratedCart decorator.DecoratedCart) []string {
+	var result []string
+	found := make(map[string]bool)
+	for _, item := range decoratedCart.GetAllDecoratedItems() {
+		if item.Product == nil {
+			continue
+		}
+
+		for _, loyaltyPrice := range item.Product.SaleableData().LoyaltyPrices {
+			if loyaltyPrice.Type == "" || found[loyaltyPrice.Type] {
+				continue
+			}
+			found[loyaltyPrice.Type] = true
+			result = append(result, loyaltyPrice.Type)
+		}
+	}
+
+	return result
+}
+
+// loyaltyPointsByType sums the points of the loyalty charges of the payment selection per type
+func loyaltyPointsByType(selection cart.PaymentSelection) map[string]priceDomain.Price {
+	points := make(map[string]priceDomain.Price)
+	for _, charge := range selection.CartSplit().ChargesByType().Items() {
+		if charge.Type == priceDomain.ChargeTypeMain || charge.Type == priceDomain.ChargeTypeGiftCard || !charge.Price.IsPositive() {
+			continue
+		}
+
+		sum, found := points[charge.Type]
+		if !found {
+			points[charge.Type] = charge.Price
+			continue
+		}
+		points[charge.Type], _ = sum.Add(charge.Price)
+	}
+
+	return points
+}
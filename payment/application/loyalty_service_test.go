@@ -0,0 +1,93 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
+	"flamingo.me/flamingo-commerce/v3/payment/application"
+	"flamingo.me/flamingo-commerce/v3/payment/domain"
+	"flamingo.me/flamingo-commerce/v3/payment/infrastructure/loyalty"
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+	productDomain "flamingo.me/flamingo-commerce/v3/product/domain"
+)
+
+func newLoyaltyPaymentService(accountService domain.LoyaltyAccountService) *application.LoyaltyPaymentService {
+	return new(application.LoyaltyPaymentService).Inject(
+		flamingo.NullLogger{},
+		&struct {
+			LoyaltyAccountService domain.LoyaltyAccountService `inject:",optional"`
+		}{
+			LoyaltyAccountService: accountService,
+		},
+	)
+}
+
+func loyaltyCart(authenticated bool) decorator.DecoratedCart {
+	product := productDomain.SimpleProduct{
+		Saleable: productDomain.Saleable{
+			ActivePrice: productDomain.PriceInfo{Default: priceDomain.NewFromFloat(20, "EUR")},
+			LoyaltyPrices: []productDomain.LoyaltyPriceInfo{
+				{Type: "miles", Default: priceDomain.NewFromFloat(200, "MILES")},
+			},
+		},
+	}
+	item := cart.Item{ID: "item", Qty: 1, RowPriceGross: priceDomain.NewFromFloat(20, "EUR")}
+
+	return decorator.DecoratedCart{
+		Cart: cart.Cart{
+			BelongsToAuthenticatedUser: authenticated,
+			AuthenticatedUserID:        "customer",
+			Deliveries:                 []cart.Delivery{{DeliveryInfo: cart.DeliveryInfo{Code: "delivery"}, Cartitems: []cart.Item{item}}},
+		},
+		DecoratedDeliveries: []decorator.DecoratedDelivery{
+			{DecoratedItems: []decorator.DecoratedCartItem{{Item: item, Product: product}}},
+		},
+	}
+}
+
+func TestLoyaltyPaymentService(t *testing.T) {
+	ctx := context.Background()
+	chargeTypeToPaymentMethod := map[string]string{priceDomain.ChargeTypeMain: "card", "miles": "miles"}
+
+	accounts := new(loyalty.Memory).Inject(nil)
+	accounts.SetBalance("customer", "miles", priceDomain.NewFromFloat(50, "MILES"))
+	service := newLoyaltyPaymentService(accounts)
+
+	decoratedCart := loyaltyCart(true)
+	selection, err := service.PaymentSelection(ctx, "gateway", chargeTypeToPaymentMethod, decoratedCart, nil)
+	assert.NoError(t, err)
+
+	charges := selection.CartSplit().ChargesByType()
+	miles, found := charges.GetByType("miles")
+	assert.True(t, found)
+	assert.Equal(t, 50.0, miles.Price.FloatAmount(), "points are used up to the balance")
+	assert.Equal(t, 5.0, miles.Value.FloatAmount())
+	assert.Equal(t, 15.0, charges.GetByTypeForced(priceDomain.ChargeTypeMain).Value.FloatAmount())
+
+	decoratedCart.Cart.PaymentSelection = selection
+	reservations, err := service.ReservePoints(ctx, decoratedCart.Cart, "order")
+	assert.NoError(t, err)
+	assert.Len(t, reservations, 1)
+	balance, _ := accounts.Balance(ctx, "customer", "miles")
+	assert.True(t, balance.IsZero())
+
+	assert.NoError(t, service.ReleasePoints(ctx, reservations))
+	balance, _ = accounts.Balance(ctx, "customer", "miles")
+	assert.Equal(t, 50.0, balance.FloatAmount())
+
+	t.Run("guest carts can't use points", func(t *testing.T) {
+		guestCart := loyaltyCart(false)
+		guestCart.Cart.PaymentSelection = selection
+		_, err := service.ReservePoints(ctx, guestCart.Cart, "order")
+		assert.Equal(t, domain.ErrLoyaltyAccountRequired, err)
+
+		selection, err := service.PaymentSelection(ctx, "gateway", chargeTypeToPaymentMethod, guestCart, nil)
+		assert.NoError(t, err)
+		assert.False(t, selection.CartSplit().ChargesByType().HasType("miles"))
+	})
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"context"
+	"errors"
+
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+type (
+	// LoyaltyAccountService is the secondary port to the loyalty point accounts of the customers
+	// Points are reserved during the place order and committed once the order is placed, or released if placing the order fails
+	LoyaltyAccountService interface {
+		// Balance returns the available points of the loyalty type, reserved points are not available
+		Balance(ctx context.Context, accountID string, loyaltyType string) (priceDomain.Price, error)
+		// Reserve blocks the points for the given reference (e.g. the place order id) and returns the reservation
+		Reserve(ctx context.Context, accountID string, loyaltyType string, points priceDomain.Price, reference string) (LoyaltyReservation, error)
+		// Commit debits the reserved points from the account
+		Commit(ctx context.Context, reservation LoyaltyReservation) error
+		// Release frees the reserved points
+		Release(ctx context.Context, reservation LoyaltyReservation) error
+	}
+
+	// LoyaltyReservation of points on a loyalty account
+	LoyaltyReservation struct {
+		ID          string
+		AccountID   string
+		LoyaltyType string
+		Points      priceDomain.Price
+		Reference   string
+	}
+)
+
+var (
+	// ErrInsufficientLoyaltyBalance is returned if more points should be reserved than available
+	ErrInsufficientLoyaltyBalance = errors.New("insufficient loyalty balance")
+	// ErrLoyaltyReservationNotFound is returned if a reservation is unknown or already committed / released
+	ErrLoyaltyReservationNotFound = errors.New("loyalty reservation not found")
+	// ErrLoyaltyAccountRequired is returned if points should be used for a cart without customer
+	ErrLoyaltyAccountRequired = errors.New("loyalty points can only be used by authenticated customers")
+)
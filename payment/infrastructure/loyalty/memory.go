@@ -0,0 +1,155 @@
+package loyalty
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"flamingo.me/flamingo/v3/framework/config"
+
+	"flamingo.me/flamingo-commerce/v3/payment/domain"
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+type (
+	// Memory is a LoyaltyAccountService that keeps the balances and reservations in memory, useful for development and tests
+	Memory struct {
+		mx           sync.RWMutex
+		balances     map[string]map[string]priceDomain.Price
+		reservations map[string]domain.LoyaltyReservation
+		lastID       int
+	}
+
+	// accountBalanceConfig is the configuration representation of the initial balance of a loyalty type
+	accountBalanceConfig struct {
+		Points   float64
+		Currency string
+	}
+)
+
+var _ domain.LoyaltyAccountService = new(Memory)
+
+// Inject dependencies
+func (m *Memory) Inject(
+	config *struct {
+		Accounts config.Map `inject:"config:commerce.payment.loyaltyAccount.memory.accounts,optional"`
+	},
+) *Memory {
+	m.balances = make(map[string]map[string]priceDomain.Price)
+	m.reservations = make(map[string]domain.LoyaltyReservation)
+
+	if config != nil && config.Accounts != nil {
+		var accounts map[string]map[string]accountBalanceConfig
+		config.Accounts.MapInto(&accounts)
+		for accountID, balances := range accounts {
+			for loyaltyType, balance := range balances {
+				m.SetBalance(accountID, loyaltyType, priceDomain.NewFromFloat(balance.Points, balance.Currency))
+			}
+		}
+	}
+
+	return m
+}
+
+// SetBalance sets the points of the loyalty type on the account
+func (m *Memory) SetBalance(accountID string, loyaltyType string, points priceDomain.Price) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if m.balances[accountID] == nil {
+		m.balances[accountID] = make(map[string]priceDomain.Price)
+	}
+	m.balances[accountID][loyaltyType] = points
+}
+
+// Balance returns the points of the loyalty type minus the reserved points
+func (m *Memory) Balance(_ context.Context, accountID string, loyaltyType string) (priceDomain.Price, error) {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	return m.available(accountID, loyaltyType)
+}
+
+// Reserve blocks the points if they are available
+func (m *Memory) Reserve(_ context.Context, accountID string, loyaltyType string, points priceDomain.Price, reference string) (domain.LoyaltyReservation, error) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	available, err := m.available(accountID, loyaltyType)
+	if err != nil {
+		return domain.LoyaltyReservation{}, err
+	}
+
+	if available.Currency() != points.Currency() || points.IsGreaterThen(available) {
+		return domain.LoyaltyReservation{}, domain.ErrInsufficientLoyaltyBalance
+	}
+
+	m.lastID++
+	reservation := domain.LoyaltyReservation{
+		ID:          strconv.Itoa(m.lastID),
+		AccountID:   accountID,
+		LoyaltyType: loyaltyType,
+		Points:      points,
+		Reference:   reference,
+	}
+	m.reservations[reservation.ID] = reservation
+
+	return reservation, nil
+}
+
+// Commit debits the reserved points
+func (m *Memory) Commit(_ context.Context, reservation domain.LoyaltyReservation) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	reserved, found := m.reservations[reservation.ID]
+	if !found {
+		return domain.ErrLoyaltyReservationNotFound
+	}
+
+	balance, err := m.balances[reserved.AccountID][reserved.LoyaltyType].Sub(reserved.Points)
+	if err != nil {
+		return err
+	}
+
+	m.balances[reserved.AccountID][reserved.LoyaltyType] = balance
+	delete(m.reservations, reserved.ID)
+
+	return nil
+}
+
+// Release frees the reserved points
+func (m *Memory) Release(_ context.Context, reservation domain.LoyaltyReservation) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	if _, found := m.reservations[reservation.ID]; !found {
+		return domain.ErrLoyaltyReservationNotFound
+	}
+
+	delete(m.reservations, reservation.ID)
+
+	return nil
+}
+
+// available returns the balance minus reserved points, the caller must hold the lock
+func (m *Memory) available(accountID string, loyaltyType string) (priceDomain.Price, error) {
+	balance, found := m.balances[accountID][loyaltyType]
+	if !found {
+		return priceDomain.NewZero(""), nil
+	}
+
+	for _, reservation := range m.reservations {
+		if reservation.AccountID != accountID || reservation.LoyaltyType != loyaltyType {
+			continue
+		}
+
+		var err error
+		balance, err = balance.Sub(reservation.Points)
+		if err != nil {
+			return balance, err
+		}
+	}
+
+	return balance, nil
+}
@@ -0,0 +1,53 @@
+package loyalty_test
+
+import (
+	"context"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/config"
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/payment/domain"
+	"flamingo.me/flamingo-commerce/v3/payment/infrastructure/loyalty"
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+	memory := new(loyalty.Memory).Inject(&struct {
+		Accounts config.Map `inject:"config:commerce.payment.loyaltyAccount.memory.accounts,optional"`
+	}{
+		Accounts: config.Map{
+			"customer": map[string]interface{}{
+				"miles": map[string]interface{}{"points": 1000.0, "currency": "MILES"},
+			},
+		},
+	})
+
+	balance, err := memory.Balance(ctx, "customer", "miles")
+	assert.NoError(t, err)
+	assert.Equal(t, 1000.0, balance.FloatAmount())
+
+	reservation, err := memory.Reserve(ctx, "customer", "miles", priceDomain.NewFromFloat(600, "MILES"), "order")
+	assert.NoError(t, err)
+	balance, _ = memory.Balance(ctx, "customer", "miles")
+	assert.Equal(t, 400.0, balance.FloatAmount(), "reserved points are not available")
+
+	_, err = memory.Reserve(ctx, "customer", "miles", priceDomain.NewFromFloat(500, "MILES"), "other")
+	assert.Equal(t, domain.ErrInsufficientLoyaltyBalance, err)
+
+	_, err = memory.Reserve(ctx, "unknown", "miles", priceDomain.NewFromFloat(1, "MILES"), "other")
+	assert.Equal(t, domain.ErrInsufficientLoyaltyBalance, err)
+
+	assert.NoError(t, memory.Commit(ctx, reservation))
+	balance, _ = memory.Balance(ctx, "customer", "miles")
+	assert.Equal(t, 400.0, balance.FloatAmount())
+	assert.Equal(t, domain.ErrLoyaltyReservationNotFound, memory.Commit(ctx, reservation), "reservations can only be committed once")
+
+	reservation, err = memory.Reserve(ctx, "customer", "miles", priceDomain.NewFromFloat(400, "MILES"), "order")
+	assert.NoError(t, err)
+	assert.NoError(t, memory.Release(ctx, reservation))
+	balance, _ = memory.Balance(ctx, "customer", "miles")
+	assert.Equal(t, 400.0, balance.FloatAmount(), "released points are available again")
+	assert.Equal(t, domain.ErrLoyaltyReservationNotFound, memory.Release(ctx, reservation))
+}
@@ -2,6 +2,8 @@ package payment
 
 import (
 	"flamingo.me/dingo"
+	"flamingo.me/flamingo-commerce/v3/payment/domain"
+	"flamingo.me/flamingo-commerce/v3/payment/infrastructure/loyalty"
 	"flamingo.me/flamingo-commerce/v3/payment/interfaces"
 	"flamingo.me/flamingo-commerce/v3/payment/interfaces/controller"
 	"flamingo.me/flamingo/v3/framework/web"
@@ -10,7 +12,8 @@ import (
 type (
 	// Module registers our payment module
 	Module struct {
-		EnableOfflinePayment bool `inject:"config:commerce.payment.enableOfflinePaymentGateway,optional"`
+		EnableOfflinePayment       bool `inject:"config:commerce.payment.enableOfflinePaymentGateway,optional"`
+		EnableMemoryLoyaltyAccount bool `inject:"config:commerce.payment.loyaltyAccount.memory.enabled,optional"`
 	}
 )
 
@@ -20,9 +23,34 @@ func (m *Module) Configure(injector *dingo.Injector) {
 		injector.BindMap((*interfaces.WebCartPaymentGateway)(nil), interfaces.OfflineWebCartPaymentGatewayCode).To(interfaces.OfflineWebCartPaymentGateway{})
 	}
 
+	if m.EnableMemoryLoyaltyAccount {
+		injector.Bind((*domain.LoyaltyAccountService)(nil)).To(new(loyalty.Memory)).In(dingo.Singleton)
+	}
+
 	web.BindRoutes(injector, new(routes))
 }
 
+// CueConfig definition
+func (m *Module) CueConfig() string {
+	return `
+commerce: payment: {
+	enableOfflinePaymentGateway: bool | *false
+	loyaltyAccount: {
+		memory: {
+			enabled: bool | *false
+			accounts: {
+				[string]: {
+					[string]: {
+						points: number
+						currency: string
+					}
+				}
+			}
+		}
+	}
+}`
+}
+
 type routes struct {
 	paymentAPIController *controller.PaymentAPIController
 }
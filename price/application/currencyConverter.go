@@ -0,0 +1,73 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+type (
+	// CurrencyConverter converts prices between currencies with the rates of the bound domain.ExchangeRateProvider
+	CurrencyConverter struct {
+		exchangeRateProvider domain.ExchangeRateProvider
+	}
+)
+
+var (
+	// ErrNoExchangeRateProvider is returned if a conversion is required but no exchange rate provider is configured
+	ErrNoExchangeRateProvider = errors.New("no exchange rate provider configured")
+)
+
+// Inject dependencies
+func (c *CurrencyConverter) Inject(
+	optionals *struct {
+		ExchangeRateProvider domain.ExchangeRateProvider `inject:",optional"`
+	},
+) *CurrencyConverter {
+	if optionals != nil {
+		c.exchangeRateProvider = optionals.ExchangeRateProvider
+	}
+
+	return c
+}
+
+// Convert returns the price in the given currency, prices that are already in this currency are returned unchanged
+func (c *CurrencyConverter) Convert(ctx context.Context, price domain.Price, currency string) (domain.Price, error) {
+	if price.Currency() == currency {
+		return price, nil
+	}
+
+	// zero prices without currency appear e.g. on empty carts and can be converted without a rate
+	if price.Currency() == "" && price.IsZero() {
+		return domain.NewZero(currency), nil
+	}
+
+	if c.exchangeRateProvider == nil {
+		return domain.NewZero(currency), ErrNoExchangeRateProvider
+	}
+
+	rate, err := c.exchangeRateProvider.GetRate(ctx, price.Currency(), currency)
+	if err != nil {
+		return domain.NewZero(currency), err
+	}
+
+	return price.ConvertTo(currency, rate).GetPayable(), nil
+}
+
+// ValueCharge returns the charge with its Value in the given base currency, e.g. the currency of the cart
+// A value that is already in the base currency stays untouched, otherwise the converted price is used as value
+func (c *CurrencyConverter) ValueCharge(ctx context.Context, charge domain.Charge, baseCurrency string) (domain.Charge, error) {
+	if charge.Value.Currency() == baseCurrency {
+		return charge, nil
+	}
+
+	value, err := c.Convert(ctx, charge.Price, baseCurrency)
+	if err != nil {
+		return charge, err
+	}
+
+	charge.Value = value
+
+	return charge, nil
+}
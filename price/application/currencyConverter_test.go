@@ -0,0 +1,77 @@
+package application_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/price/application"
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+type (
+	exchangeRateProviderMock struct {
+		rates domain.ExchangeRates
+	}
+)
+
+func (m *exchangeRateProviderMock) GetRate(_ context.Context, from string, to string) (*big.Float, error) {
+	return m.rates.Rate(from, to)
+}
+
+func newCurrencyConverter(provider domain.ExchangeRateProvider) *application.CurrencyConverter {
+	return new(application.CurrencyConverter).Inject(
+		&struct {
+			ExchangeRateProvider domain.ExchangeRateProvider `inject:",optional"`
+		}{
+			ExchangeRateProvider: provider,
+		},
+	)
+}
+
+func TestCurrencyConverter_Convert(t *testing.T) {
+	provider := &exchangeRateProviderMock{rates: domain.ExchangeRates{Base: "EUR", Rates: map[string]float64{"USD": 1.1}}}
+
+	t.Run("same currency", func(t *testing.T) {
+		converter := newCurrencyConverter(nil)
+		price, err := converter.Convert(context.Background(), domain.NewFromFloat(10, "EUR"), "EUR")
+		assert.NoError(t, err)
+		assert.Equal(t, 10.0, price.FloatAmount())
+	})
+
+	t.Run("no provider", func(t *testing.T) {
+		converter := newCurrencyConverter(nil)
+		_, err := converter.Convert(context.Background(), domain.NewFromFloat(10, "EUR"), "USD")
+		assert.Equal(t, application.ErrNoExchangeRateProvider, err)
+	})
+
+	t.Run("converted and rounded", func(t *testing.T) {
+		converter := newCurrencyConverter(provider)
+		price, err := converter.Convert(context.Background(), domain.NewFromFloat(9.99, "EUR"), "USD")
+		assert.NoError(t, err)
+		assert.Equal(t, "USD", price.Currency())
+		assert.Equal(t, 10.99, price.FloatAmount())
+	})
+
+	t.Run("unknown currency", func(t *testing.T) {
+		converter := newCurrencyConverter(provider)
+		_, err := converter.Convert(context.Background(), domain.NewFromFloat(10, "EUR"), "JPY")
+		assert.Error(t, err)
+	})
+}
+
+func TestCurrencyConverter_ValueCharge(t *testing.T) {
+	provider := &exchangeRateProviderMock{rates: domain.ExchangeRates{Base: "EUR", Rates: map[string]float64{"USD": 1.25}}}
+	converter := newCurrencyConverter(provider)
+
+	main, err := converter.ValueCharge(context.Background(), domain.Charge{Type: domain.ChargeTypeMain, Price: domain.NewFromFloat(12.5, "USD")}, "EUR")
+	assert.NoError(t, err)
+	assert.Equal(t, "EUR", main.Value.Currency())
+	assert.Equal(t, 10.0, main.Value.FloatAmount())
+
+	giftCard, err := converter.ValueCharge(context.Background(), domain.Charge{Type: domain.ChargeTypeGiftCard, Price: domain.NewFromFloat(5, "USD"), Value: domain.NewFromFloat(4, "EUR")}, "EUR")
+	assert.NoError(t, err)
+	assert.Equal(t, 4.0, giftCard.Value.FloatAmount(), "existing values in base currency are kept")
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+type (
+	// ExchangeRateProvider returns the rates that are used to convert prices between currencies
+	ExchangeRateProvider interface {
+		// GetRate returns the factor an amount in the "from" currency needs to be multiplied with to get the amount in the "to" currency
+		GetRate(ctx context.Context, from string, to string) (*big.Float, error)
+	}
+
+	// ExchangeRates contains the rates of several currencies relative to one base currency:
+	// 1 unit of the base currency is worth Rates[currency] units of the currency
+	ExchangeRates struct {
+		Base  string
+		Rates map[string]float64
+	}
+)
+
+var (
+	// ErrExchangeRateNotFound is returned if no exchange rate is known for a currency
+	ErrExchangeRateNotFound = errors.New("exchange rate not found")
+)
+
+// Rate returns the factor to convert an amount from one currency into another, cross rates are calculated via the base currency
+func (r ExchangeRates) Rate(from string, to string) (*big.Float, error) {
+	if from == to {
+		return big.NewFloat(1), nil
+	}
+
+	fromRate, err := r.rateOf(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toRate, err := r.rateOf(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Float).Quo(toRate, fromRate), nil
+}
+
+func (r ExchangeRates) rateOf(currency string) (*big.Float, error) {
+	if currency == r.Base {
+		return big.NewFloat(1), nil
+	}
+
+	rate, found := r.Rates[currency]
+	if !found || rate <= 0 {
+		return nil, fmt.Errorf("%w: %q relative to %q", ErrExchangeRateNotFound, currency, r.Base)
+	}
+
+	return big.NewFloat(rate), nil
+}
+
+// ConvertTo returns a new price in the given currency, with the amount multiplied by the given exchange rate
+func (p Price) ConvertTo(currency string, rate *big.Float) Price {
+	newPrice := Price{
+		currency: currency,
+	}
+	newPrice.amount.Mul(&p.amount, rate)
+	return newPrice
+}
@@ -0,0 +1,60 @@
+package domain_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+func TestExchangeRates_Rate(t *testing.T) {
+	rates := domain.ExchangeRates{
+		Base: "EUR",
+		Rates: map[string]float64{
+			"USD": 1.25,
+			"CHF": 1,
+			"GBP": 0.8,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		want    float64
+		wantErr bool
+	}{
+		{name: "same currency", from: "JPY", to: "JPY", want: 1},
+		{name: "base to currency", from: "EUR", to: "USD", want: 1.25},
+		{name: "currency to base", from: "USD", to: "EUR", want: 0.8},
+		{name: "cross rate", from: "GBP", to: "USD", want: 1.5625},
+		{name: "unknown currency", from: "EUR", to: "JPY", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := rates.Rate(tt.from, tt.to)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, domain.ErrExchangeRateNotFound))
+				return
+			}
+
+			assert.NoError(t, err)
+			got, _ := rate.Float64()
+			assert.InDelta(t, tt.want, got, 0.000001)
+		})
+	}
+}
+
+func TestPrice_ConvertTo(t *testing.T) {
+	price := domain.NewFromFloat(10.5, "EUR")
+
+	converted := price.ConvertTo("USD", big.NewFloat(1.2))
+
+	assert.Equal(t, "USD", converted.Currency())
+	assert.Equal(t, 12.6, converted.GetPayable().FloatAmount())
+	assert.Equal(t, "EUR", price.Currency(), "original price must not be changed")
+}
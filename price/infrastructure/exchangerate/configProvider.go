@@ -0,0 +1,45 @@
+package exchangerate
+
+import (
+	"context"
+	"math/big"
+
+	"flamingo.me/flamingo/v3/framework/config"
+
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+type (
+	// ConfigProvider provides fixed exchange rates from the configuration commerce.price.currencyConversion.rates
+	ConfigProvider struct {
+		rates domain.ExchangeRates
+	}
+)
+
+var (
+	_ domain.ExchangeRateProvider = new(ConfigProvider)
+)
+
+// Inject dependencies
+func (p *ConfigProvider) Inject(
+	config *struct {
+		BaseCurrency string     `inject:"config:commerce.price.currencyConversion.baseCurrency,optional"`
+		Rates        config.Map `inject:"config:commerce.price.currencyConversion.rates,optional"`
+	},
+) *ConfigProvider {
+	p.rates = domain.ExchangeRates{Rates: make(map[string]float64)}
+
+	if config != nil {
+		p.rates.Base = config.BaseCurrency
+		if config.Rates != nil {
+			config.Rates.MapInto(&p.rates.Rates)
+		}
+	}
+
+	return p
+}
+
+// GetRate returns the rate based on the configured rates
+func (p *ConfigProvider) GetRate(_ context.Context, from string, to string) (*big.Float, error) {
+	return p.rates.Rate(from, to)
+}
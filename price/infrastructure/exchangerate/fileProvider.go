@@ -0,0 +1,93 @@
+package exchangerate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+)
+
+type (
+	// FileProvider reads exchange rates from a JSON file, e.g. {"base": "EUR", "rates": {"USD": 1.09}}
+	// The file is read again as soon as it has been modified, so that the rates can be updated by an external job
+	FileProvider struct {
+		file    string
+		mutex   sync.Mutex
+		rates   domain.ExchangeRates
+		modTime time.Time
+	}
+
+	fileRates struct {
+		Base  string             `json:"base"`
+		Rates map[string]float64 `json:"rates"`
+	}
+)
+
+var (
+	_ domain.ExchangeRateProvider = new(FileProvider)
+
+	// ErrNoRatesFile is returned if the file provider is used without configuring a file
+	ErrNoRatesFile = errors.New("no exchange rates file configured")
+)
+
+// Inject dependencies
+func (p *FileProvider) Inject(
+	config *struct {
+		RatesFile string `inject:"config:commerce.price.currencyConversion.ratesFile,optional"`
+	},
+) *FileProvider {
+	if config != nil {
+		p.file = config.RatesFile
+	}
+
+	return p
+}
+
+// GetRate returns the rate based on the current content of the rates file
+func (p *FileProvider) GetRate(_ context.Context, from string, to string) (*big.Float, error) {
+	rates, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return rates.Rate(from, to)
+}
+
+// load returns the rates of the file and reads it again if it has been modified since the last read
+func (p *FileProvider) load() (domain.ExchangeRates, error) {
+	if p.file == "" {
+		return domain.ExchangeRates{}, ErrNoRatesFile
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	info, err := os.Stat(p.file)
+	if err != nil {
+		return domain.ExchangeRates{}, err
+	}
+
+	if !info.ModTime().Equal(p.modTime) {
+		content, err := ioutil.ReadFile(p.file)
+		if err != nil {
+			return domain.ExchangeRates{}, err
+		}
+
+		var parsed fileRates
+		err = json.Unmarshal(content, &parsed)
+		if err != nil {
+			return domain.ExchangeRates{}, err
+		}
+
+		p.rates = domain.ExchangeRates{Base: parsed.Base, Rates: parsed.Rates}
+		p.modTime = info.ModTime()
+	}
+
+	return p.rates, nil
+}
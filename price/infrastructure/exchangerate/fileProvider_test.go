@@ -0,0 +1,48 @@
+package exchangerate_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/price/infrastructure/exchangerate"
+)
+
+func TestFileProvider_GetRate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "exchangerates")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "rates.json")
+	assert.NoError(t, ioutil.WriteFile(file, []byte(`{"base": "EUR", "rates": {"USD": 1.25}}`), 0644))
+
+	provider := new(exchangerate.FileProvider).Inject(&struct {
+		RatesFile string `inject:"config:commerce.price.currencyConversion.ratesFile,optional"`
+	}{RatesFile: file})
+
+	rate, err := provider.GetRate(context.Background(), "EUR", "USD")
+	assert.NoError(t, err)
+	value, _ := rate.Float64()
+	assert.Equal(t, 1.25, value)
+
+	// updated files are read again
+	assert.NoError(t, ioutil.WriteFile(file, []byte(`{"base": "EUR", "rates": {"USD": 2}}`), 0644))
+	assert.NoError(t, os.Chtimes(file, time.Now(), time.Now().Add(time.Minute)))
+
+	rate, err = provider.GetRate(context.Background(), "USD", "EUR")
+	assert.NoError(t, err)
+	value, _ = rate.Float64()
+	assert.Equal(t, 0.5, value)
+}
+
+func TestFileProvider_NoFile(t *testing.T) {
+	provider := new(exchangerate.FileProvider).Inject(nil)
+
+	_, err := provider.GetRate(context.Background(), "EUR", "USD")
+	assert.Equal(t, exchangerate.ErrNoRatesFile, err)
+}
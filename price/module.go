@@ -2,6 +2,8 @@ package price
 
 import (
 	"flamingo.me/dingo"
+	"flamingo.me/flamingo-commerce/v3/price/domain"
+	"flamingo.me/flamingo-commerce/v3/price/infrastructure/exchangerate"
 	pricegraphql "flamingo.me/flamingo-commerce/v3/price/interfaces/graphql"
 	"flamingo.me/flamingo-commerce/v3/price/interfaces/templatefunctions"
 	"flamingo.me/flamingo/v3/core/locale"
@@ -11,13 +13,49 @@ import (
 
 type (
 	// Module registers our profiler
-	Module struct{}
+	Module struct {
+		rateProvider string
+	}
 )
 
+// Inject dependencies
+func (m *Module) Inject(
+	config *struct {
+		RateProvider string `inject:"config:commerce.price.currencyConversion.rateProvider,optional"`
+	},
+) {
+	if config != nil {
+		m.rateProvider = config.RateProvider
+	}
+}
+
 // Configure the product URL
-func (m Module) Configure(injector *dingo.Injector) {
+func (m *Module) Configure(injector *dingo.Injector) {
 	flamingo.BindTemplateFunc(injector, "commercePriceFormat", new(templatefunctions.CommercePriceFormatFunc))
 	injector.BindMulti(new(graphql.Service)).To(pricegraphql.Service{})
+
+	switch m.rateProvider {
+	case "config":
+		injector.Bind((*domain.ExchangeRateProvider)(nil)).To(exchangerate.ConfigProvider{})
+	case "file":
+		injector.Bind((*domain.ExchangeRateProvider)(nil)).To(exchangerate.FileProvider{}).In(dingo.Singleton)
+	}
+}
+
+// CueConfig defines the price module configuration
+func (*Module) CueConfig() string {
+	return `
+commerce: {
+	price: {
+		currencyConversion: {
+			baseCurrency: string | *""
+			rateProvider: *"" | "config" | "file"
+			rates: {[string]: number}
+			ratesFile: string | *""
+		}
+	}
+}
+`
 }
 
 // Depends adds our dependencies
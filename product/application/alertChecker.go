@@ -0,0 +1,135 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+
+	"flamingo.me/flamingo-commerce/v3/product/domain/alert"
+)
+
+type (
+	// AlertChecker runs the alert check periodically while the server is running
+	AlertChecker struct {
+		alertService *AlertService
+		logger       flamingo.Logger
+		interval     time.Duration
+		mx           sync.Mutex
+		stop         chan struct{}
+	}
+
+	// AlertMailer sends the triggered alerts with the optional alert.Mailer
+	AlertMailer struct {
+		logger flamingo.Logger
+		mailer alert.Mailer
+	}
+)
+
+// Inject dependencies
+func (c *AlertChecker) Inject(
+	alertService *AlertService,
+	logger flamingo.Logger,
+	config *struct {
+		CheckInterval float64 `inject:"config:commerce.product.alerts.checkInterval,optional"`
+	},
+) *AlertChecker {
+	c.alertService = alertService
+	c.logger = logger.WithField(flamingo.LogKeyModule, "product").WithField(flamingo.LogKeyCategory, "alert")
+	c.interval = 15 * time.Minute
+
+	if config != nil && config.CheckInterval > 0 {
+		c.interval = time.Duration(config.CheckInterval) * time.Second
+	}
+
+	return c
+}
+
+// Notify starts the checks on server start and stops them on shutdown
+func (c *AlertChecker) Notify(_ context.Context, event flamingo.Event) {
+	switch event.(type) {
+	case *flamingo.ServerStartEvent:
+		c.start()
+	case *flamingo.ServerShutdownEvent:
+		c.shutdown()
+	}
+}
+
+func (c *AlertChecker) start() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.stop != nil {
+		return
+	}
+
+	c.stop = make(chan struct{})
+	go func(stop chan struct{}) {
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := c.alertService.Check(context.Background()); err != nil {
+					c.logger.Error("alert check failed: ", err)
+				}
+			case <-stop:
+				return
+			}
+		}
+	}(c.stop)
+}
+
+func (c *AlertChecker) shutdown() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.stop == nil {
+		return
+	}
+
+	close(c.stop)
+	c.stop = nil
+}
+
+// Inject dependencies
+func (m *AlertMailer) Inject(
+	logger flamingo.Logger,
+	optionals *struct {
+		Mailer alert.Mailer `inject:",optional"`
+	},
+) *AlertMailer {
+	m.logger = logger.WithField(flamingo.LogKeyModule, "product").WithField(flamingo.LogKeyCategory, "alert")
+
+	if optionals != nil {
+		m.mailer = optionals.Mailer
+	}
+
+	return m
+}
+
+// Notify sends the alert of an alert.Event and the confirmation of an alert.ConfirmationRequestedEvent
+func (m *AlertMailer) Notify(ctx context.Context, event flamingo.Event) {
+	switch currentEvent := event.(type) {
+	case *alert.Event:
+		if m.mailer == nil {
+			m.logger.WithContext(ctx).Info("no alert mailer bound, ", currentEvent.Alert.Subscription.Type, " alert for ", currentEvent.Alert.Subscription.MarketplaceCode, " not sent")
+			return
+		}
+
+		if err := m.mailer.SendAlert(ctx, currentEvent.Alert); err != nil {
+			m.logger.WithContext(ctx).Error("alert not sent: ", err)
+		}
+	case *alert.ConfirmationRequestedEvent:
+		if m.mailer == nil {
+			m.logger.WithContext(ctx).Info("no alert mailer bound, confirmation of subscription ", currentEvent.Subscription.ID, " not sent")
+			return
+		}
+
+		if err := m.mailer.SendConfirmation(ctx, currentEvent.Subscription, currentEvent.Token); err != nil {
+			m.logger.WithContext(ctx).Error("alert subscription confirmation not sent: ", err)
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package application
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/google/uuid"
+
+	"flamingo.me/flamingo-commerce/v3/product/domain"
+	"flamingo.me/flamingo-commerce/v3/product/domain/alert"
+)
+
+const (
+	tokenPurposeConfirm     = "confirm"
+	tokenPurposeUnsubscribe = "unsubscribe"
+)
+
+type (
+	// AlertService manages the back in stock and price drop alert subscriptions
+	AlertService struct {
+		productService domain.ProductService
+		store          alert.Store
+		eventRouter    flamingo.EventRouter
+		logger         flamingo.Logger
+		tokenSecret    []byte
+	}
+)
+
+// Inject dependencies
+func (s *AlertService) Inject(
+	productService domain.ProductService,
+	store alert.Store,
+	eventRouter flamingo.EventRouter,
+	logger flamingo.Logger,
+	config *struct {
+		TokenSecret string `inject:"config:commerce.product.alerts.tokenSecret,optional"`
+	},
+) *AlertService {
+	s.productService = productService
+	s.store = store
+	s.eventRouter = eventRouter
+	s.logger = logger.WithField(flamingo.LogKeyModule, "product").WithField(flamingo.LogKeyCategory, "alert")
+
+	if config != nil {
+		s.tokenSecret = []byte(config.TokenSecret)
+	}
+
+	return s
+}
+
+// Subscribe the contact to the alert of the product, subscribing twice returns the existing subscription.
+// The contact must be the authenticated customer or the email of a guest, guest subscriptions need the token secret and
+// are only active after they have been confirmed with the token of the alert.ConfirmationRequestedEvent
+func (s *AlertService) Subscribe(ctx context.Context, alertType string, marketplaceCode string, variantMarketplaceCode string, contact alert.Contact) (*alert.Subscription, error) {
+	if !alert.IsValidType(alertType) {
+		return nil, alert.ErrInvalidType
+	}
+
+	if contact.IsEmpty() {
+		return nil, alert.ErrMissingContact
+	}
+
+	isGuest := contact.CustomerID == ""
+	if isGuest && len(s.tokenSecret) == 0 {
+		return nil, alert.ErrLoginRequired
+	}
+
+	product, err := s.getProduct(ctx, marketplaceCode, variantMarketplaceCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if alertType == alert.TypeBackInStock && product.BaseData().IsInStock() {
+		return nil, alert.ErrInStock
+	}
+
+	subscriptions, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, subscription := range subscriptions {
+		if subscription.Type == alertType && subscription.IsFor(marketplaceCode, variantMarketplaceCode) && subscription.Contact.Matches(contact) {
+			if !subscription.Confirmed {
+				s.requestConfirmation(ctx, subscription)
+			}
+
+			return &subscription, nil
+		}
+	}
+
+	subscription := alert.Subscription{
+		ID:                     uuid.New().String(),
+		Type:                   alertType,
+		MarketplaceCode:        marketplaceCode,
+		VariantMarketplaceCode: variantMarketplaceCode,
+		Contact:                contact,
+		CreatedAt:              time.Now(),
+		ReferencePrice:         product.SaleableData().ActivePrice.GetFinalPrice(),
+		Confirmed:              !isGuest,
+	}
+
+	if err := s.store.Save(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	if isGuest {
+		s.requestConfirmation(ctx, subscription)
+	}
+
+	return &subscription, nil
+}
+
+// Confirm the subscription of a guest with the token of the alert.ConfirmationRequestedEvent
+func (s *AlertService) Confirm(ctx context.Context, id string, token string) (*alert.Subscription, error) {
+	subscription, err := s.subscriptionWithToken(ctx, id, tokenPurposeConfirm, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if subscription.Confirmed {
+		return subscription, nil
+	}
+
+	subscription.Confirmed = true
+	if err := s.store.Save(ctx, *subscription); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+// UnsubscribeWithToken removes the subscription with the unsubscribe token of its alerts, no login is needed
+func (s *AlertService) UnsubscribeWithToken(ctx context.Context, id string, token string) error {
+	subscription, err := s.subscriptionWithToken(ctx, id, tokenPurposeUnsubscribe, token)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Delete(ctx, subscription.ID)
+}
+
+// Unsubscribe the authenticated customer from the alerts of the product, an empty alert type removes all alerts of the product.
+// Guests unsubscribe with UnsubscribeWithToken
+func (s *AlertService) Unsubscribe(ctx context.Context, alertType string, marketplaceCode string, variantMarketplaceCode string, contact alert.Contact) error {
+	if alertType != "" && !alert.IsValidType(alertType) {
+		return alert.ErrInvalidType
+	}
+
+	if contact.CustomerID == "" {
+		return alert.ErrLoginRequired
+	}
+
+	subscriptions, err := s.store.All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, subscription := range subscriptions {
+		if alertType != "" && subscription.Type != alertType {
+			continue
+		}
+
+		if subscription.IsFor(marketplaceCode, variantMarketplaceCode) && subscription.Contact.Matches(contact) {
+			if err := s.store.Delete(ctx, subscription.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Subscriptions returns all subscriptions of the authenticated customer
+func (s *AlertService) Subscriptions(ctx context.Context, contact alert.Contact) ([]alert.Subscription, error) {
+	if contact.CustomerID == "" {
+		return nil, alert.ErrLoginRequired
+	}
+
+	subscriptions, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]alert.Subscription, 0)
+	for _, subscription := range subscriptions {
+		if subscription.Contact.Matches(contact) {
+			result = append(result, subscription)
+		}
+	}
+
+	return result, nil
+}
+
+// Check compares all confirmed subscriptions with the current product data and dispatches an alert.Event for each triggered alert,
+// back in stock subscriptions are removed after their alert
+func (s *AlertService) Check(ctx context.Context) error {
+	subscriptions, err := s.store.All(ctx)
+	if err != nil {
+		return err
+	}
+
+	// fetch every product only once
+	products := make(map[string]domain.BasicProduct)
+	for _, subscription := range subscriptions {
+		if !subscription.Confirmed {
+			continue
+		}
+
+		key := subscription.MarketplaceCode + "/" + subscription.VariantMarketplaceCode
+		product, found := products[key]
+		if !found {
+			product, err = s.getProduct(ctx, subscription.MarketplaceCode, subscription.VariantMarketplaceCode)
+			if err != nil {
+				s.logger.WithContext(ctx).Warn("product of alert subscription not available: ", err)
+				continue
+			}
+			products[key] = product
+		}
+
+		if err := s.checkSubscription(ctx, subscription, product); err != nil {
+			s.logger.WithContext(ctx).Error("alert subscription not checked: ", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *AlertService) checkSubscription(ctx context.Context, subscription alert.Subscription, product domain.BasicProduct) error {
+	switch subscription.Type {
+	case alert.TypeBackInStock:
+		if !product.BaseData().IsInStock() {
+			return nil
+		}
+
+		s.dispatch(ctx, alert.Alert{Subscription: subscription, Product: product})
+
+		return s.store.Delete(ctx, subscription.ID)
+	case alert.TypePriceDrop:
+		currentPrice := product.SaleableData().ActivePrice.GetFinalPrice()
+		if currentPrice.Equal(subscription.ReferencePrice) {
+			return nil
+		}
+
+		if currentPrice.IsLessThen(subscription.ReferencePrice) {
+			s.dispatch(ctx, alert.Alert{
+				Subscription:  subscription,
+				Product:       product,
+				PreviousPrice: subscription.ReferencePrice,
+				CurrentPrice:  currentPrice,
+			})
+		}
+
+		// the next drop is measured from the current price
+		subscription.ReferencePrice = currentPrice
+
+		return s.store.Save(ctx, subscription)
+	}
+
+	return nil
+}
+
+func (s *AlertService) dispatch(ctx context.Context, triggered alert.Alert) {
+	if len(s.tokenSecret) > 0 {
+		triggered.UnsubscribeToken = s.token(tokenPurposeUnsubscribe, triggered.Subscription.ID)
+	}
+
+	if s.eventRouter != nil {
+		s.eventRouter.Dispatch(ctx, &alert.Event{Alert: triggered})
+	}
+}
+
+func (s *AlertService) requestConfirmation(ctx context.Context, subscription alert.Subscription) {
+	if s.eventRouter != nil {
+		s.eventRouter.Dispatch(ctx, &alert.ConfirmationRequestedEvent{Subscription: subscription, Token: s.token(tokenPurposeConfirm, subscription.ID)})
+	}
+}
+
+// subscriptionWithToken returns the subscription if the token has been signed for the purpose and the subscription
+func (s *AlertService) subscriptionWithToken(ctx context.Context, id string, purpose string, token string) (*alert.Subscription, error) {
+	if len(s.tokenSecret) == 0 || !hmac.Equal([]byte(s.token(purpose, id)), []byte(token)) {
+		return nil, alert.ErrInvalidToken
+	}
+
+	subscriptions, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, subscription := range subscriptions {
+		if subscription.ID == id {
+			return &subscription, nil
+		}
+	}
+
+	return nil, alert.ErrNotFound
+}
+
+// token signs the purpose and the subscription id with the configured secret
+func (s *AlertService) token(purpose string, id string) string {
+	mac := hmac.New(sha256.New, s.tokenSecret)
+	_, _ = mac.Write([]byte(purpose + ":" + id))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// getProduct returns the product with the active variant if a variant is given
+func (s *AlertService) getProduct(ctx context.Context, marketplaceCode string, variantMarketplaceCode string) (domain.BasicProduct, error) {
+	product, err := s.productService.Get(ctx, marketplaceCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if variantMarketplaceCode == "" {
+		return product, nil
+	}
+
+	if configurableProduct, ok := product.(domain.ConfigurableProduct); ok {
+		return configurableProduct.GetConfigurableWithActiveVariant(variantMarketplaceCode)
+	}
+
+	return product, nil
+}
@@ -0,0 +1,221 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+	"flamingo.me/flamingo-commerce/v3/product/application"
+	"flamingo.me/flamingo-commerce/v3/product/domain"
+	"flamingo.me/flamingo-commerce/v3/product/domain/alert"
+)
+
+type (
+	alertProductService struct {
+		products map[string]domain.BasicProduct
+	}
+
+	alertStore struct {
+		subscriptions []alert.Subscription
+	}
+
+	alertEventRouter struct {
+		events        []*alert.Event
+		confirmations []*alert.ConfirmationRequestedEvent
+	}
+)
+
+func (s *alertProductService) Get(_ context.Context, marketplaceCode string) (domain.BasicProduct, error) {
+	product, ok := s.products[marketplaceCode]
+	if !ok {
+		return nil, domain.ProductNotFound{MarketplaceCode: marketplaceCode}
+	}
+
+	return product, nil
+}
+
+func (s *alertStore) Save(_ context.Context, subscription alert.Subscription) error {
+	for i := range s.subscriptions {
+		if s.subscriptions[i].ID == subscription.ID {
+			s.subscriptions[i] = subscription
+			return nil
+		}
+	}
+	s.subscriptions = append(s.subscriptions, subscription)
+
+	return nil
+}
+
+func (s *alertStore) Delete(_ context.Context, id string) error {
+	var subscriptions []alert.Subscription
+	for _, subscription := range s.subscriptions {
+		if subscription.ID != id {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	s.subscriptions = subscriptions
+
+	return nil
+}
+
+func (s *alertStore) All(_ context.Context) ([]alert.Subscription, error) {
+	return append([]alert.Subscription(nil), s.subscriptions...), nil
+}
+
+func (r *alertEventRouter) Dispatch(_ context.Context, event flamingo.Event) {
+	switch currentEvent := event.(type) {
+	case *alert.Event:
+		r.events = append(r.events, currentEvent)
+	case *alert.ConfirmationRequestedEvent:
+		r.confirmations = append(r.confirmations, currentEvent)
+	}
+}
+
+func alertServiceConfig(tokenSecret string) *struct {
+	TokenSecret string `inject:"config:commerce.product.alerts.tokenSecret,optional"`
+} {
+	return &struct {
+		TokenSecret string `inject:"config:commerce.product.alerts.tokenSecret,optional"`
+	}{TokenSecret: tokenSecret}
+}
+
+func alertProduct(marketplaceCode string, stockLevel string, price float64) domain.SimpleProduct {
+	return domain.SimpleProduct{
+		BasicProductData: domain.BasicProductData{MarketPlaceCode: marketplaceCode, StockLevel: stockLevel},
+		Saleable: domain.Saleable{
+			ActivePrice: domain.PriceInfo{Default: priceDomain.NewFromFloat(price, "EUR")},
+		},
+	}
+}
+
+func TestAlertService_Subscribe(t *testing.T) {
+	productService := &alertProductService{products: map[string]domain.BasicProduct{
+		"sold-out": alertProduct("sold-out", domain.StockLevelOutOfStock, 10),
+		"in-stock": alertProduct("in-stock", domain.StockLevelInStock, 10),
+	}}
+	store := &alertStore{}
+	eventRouter := &alertEventRouter{}
+	service := new(application.AlertService).Inject(productService, store, eventRouter, flamingo.NullLogger{}, alertServiceConfig("secret"))
+	ctx := context.Background()
+	contact := alert.Contact{Email: "a@example.com"}
+
+	_, err := service.Subscribe(ctx, "unknown", "sold-out", "", contact)
+	assert.Equal(t, alert.ErrInvalidType, err)
+
+	_, err = service.Subscribe(ctx, alert.TypeBackInStock, "sold-out", "", alert.Contact{})
+	assert.Equal(t, alert.ErrMissingContact, err)
+
+	_, err = service.Subscribe(ctx, alert.TypeBackInStock, "in-stock", "", contact)
+	assert.Equal(t, alert.ErrInStock, err)
+
+	_, err = service.Subscribe(ctx, alert.TypeBackInStock, "missing", "", contact)
+	assert.IsType(t, domain.ProductNotFound{}, err)
+
+	subscription, err := service.Subscribe(ctx, alert.TypeBackInStock, "sold-out", "", contact)
+	require.NoError(t, err)
+	again, err := service.Subscribe(ctx, alert.TypeBackInStock, "sold-out", "", contact)
+	require.NoError(t, err)
+	assert.Equal(t, subscription.ID, again.ID, "subscribing twice should return the existing subscription")
+	assert.False(t, subscription.Confirmed, "guest subscriptions need a confirmation")
+	require.Len(t, eventRouter.confirmations, 2, "the confirmation is requested again for unconfirmed subscriptions")
+
+	_, err = service.Confirm(ctx, subscription.ID, "forged")
+	assert.Equal(t, alert.ErrInvalidToken, err)
+	confirmed, err := service.Confirm(ctx, subscription.ID, eventRouter.confirmations[0].Token)
+	require.NoError(t, err)
+	assert.True(t, confirmed.Confirmed)
+
+	customerSubscription, err := service.Subscribe(ctx, alert.TypePriceDrop, "in-stock", "", alert.Contact{CustomerID: "customer"})
+	require.NoError(t, err)
+	assert.True(t, customerSubscription.Confirmed)
+	assert.Len(t, store.subscriptions, 2)
+
+	subscriptions, err := service.Subscriptions(ctx, alert.Contact{CustomerID: "customer"})
+	require.NoError(t, err)
+	require.Len(t, subscriptions, 1)
+	assert.True(t, priceDomain.NewFromFloat(10, "EUR").Equal(subscriptions[0].ReferencePrice))
+
+	_, err = service.Subscriptions(ctx, contact)
+	assert.Equal(t, alert.ErrLoginRequired, err, "guests can't list subscriptions by email")
+
+	// guests are only unsubscribed with the token, customers only by their identity
+	assert.Equal(t, alert.ErrLoginRequired, service.Unsubscribe(ctx, "", "sold-out", "", contact))
+	require.NoError(t, service.Unsubscribe(ctx, "", "sold-out", "", alert.Contact{CustomerID: "other"}))
+	assert.Len(t, store.subscriptions, 2)
+
+	assert.Equal(t, alert.ErrInvalidToken, service.UnsubscribeWithToken(ctx, subscription.ID, eventRouter.confirmations[0].Token))
+	require.NoError(t, service.Unsubscribe(ctx, "", "in-stock", "", alert.Contact{CustomerID: "customer"}))
+	assert.Len(t, store.subscriptions, 1)
+}
+
+func TestAlertService_SubscribeGuestWithoutTokenSecret(t *testing.T) {
+	productService := &alertProductService{products: map[string]domain.BasicProduct{
+		"sold-out": alertProduct("sold-out", domain.StockLevelOutOfStock, 10),
+	}}
+	store := &alertStore{}
+	service := new(application.AlertService).Inject(productService, store, nil, flamingo.NullLogger{}, nil)
+	ctx := context.Background()
+
+	_, err := service.Subscribe(ctx, alert.TypeBackInStock, "sold-out", "", alert.Contact{Email: "a@example.com"})
+	assert.Equal(t, alert.ErrLoginRequired, err)
+
+	_, err = service.Subscribe(ctx, alert.TypeBackInStock, "sold-out", "", alert.Contact{CustomerID: "customer"})
+	require.NoError(t, err)
+	assert.Equal(t, alert.ErrInvalidToken, service.UnsubscribeWithToken(ctx, store.subscriptions[0].ID, ""))
+}
+
+func TestAlertService_Check(t *testing.T) {
+	productService := &alertProductService{products: map[string]domain.BasicProduct{
+		"sold-out": alertProduct("sold-out", domain.StockLevelOutOfStock, 10),
+		"watched":  alertProduct("watched", domain.StockLevelInStock, 100),
+	}}
+	store := &alertStore{}
+	eventRouter := &alertEventRouter{}
+	service := new(application.AlertService).Inject(productService, store, eventRouter, flamingo.NullLogger{}, alertServiceConfig("secret"))
+	ctx := context.Background()
+
+	_, err := service.Subscribe(ctx, alert.TypeBackInStock, "sold-out", "", alert.Contact{CustomerID: "customer"})
+	require.NoError(t, err)
+	_, err = service.Subscribe(ctx, alert.TypePriceDrop, "watched", "", alert.Contact{CustomerID: "customer"})
+	require.NoError(t, err)
+	_, err = service.Subscribe(ctx, alert.TypePriceDrop, "watched", "", alert.Contact{Email: "unconfirmed@example.com"})
+	require.NoError(t, err)
+
+	require.NoError(t, service.Check(ctx))
+	assert.Len(t, eventRouter.events, 0, "nothing changed")
+
+	// price increases are no alert but raise the reference price
+	productService.products["watched"] = alertProduct("watched", domain.StockLevelInStock, 120)
+	require.NoError(t, service.Check(ctx))
+	assert.Len(t, eventRouter.events, 0)
+
+	productService.products["sold-out"] = alertProduct("sold-out", domain.StockLevelLowStock, 10)
+	productService.products["watched"] = alertProduct("watched", domain.StockLevelInStock, 110)
+	require.NoError(t, service.Check(ctx))
+	require.Len(t, eventRouter.events, 2)
+
+	backInStock := eventRouter.events[0].Alert
+	assert.Equal(t, alert.TypeBackInStock, backInStock.Subscription.Type)
+	assert.Equal(t, "sold-out", backInStock.Product.BaseData().MarketPlaceCode)
+
+	priceDrop := eventRouter.events[1].Alert
+	assert.Equal(t, alert.TypePriceDrop, priceDrop.Subscription.Type)
+	assert.True(t, priceDomain.NewFromFloat(120, "EUR").Equal(priceDrop.PreviousPrice))
+	assert.True(t, priceDomain.NewFromFloat(110, "EUR").Equal(priceDrop.CurrentPrice))
+
+	// the back in stock subscription is fulfilled, the price drop subscription is kept
+	require.Len(t, store.subscriptions, 2)
+	assert.Equal(t, alert.TypePriceDrop, store.subscriptions[0].Type)
+
+	require.NoError(t, service.Check(ctx))
+	assert.Len(t, eventRouter.events, 2, "the same price should not alert twice")
+
+	// the unsubscribe token of the alert removes the subscription without login
+	require.NoError(t, service.UnsubscribeWithToken(ctx, priceDrop.Subscription.ID, priceDrop.UnsubscribeToken))
+	require.Len(t, store.subscriptions, 1)
+	assert.False(t, store.subscriptions[0].Confirmed, "only the unconfirmed guest subscription is left")
+}
@@ -0,0 +1,117 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+	"flamingo.me/flamingo-commerce/v3/product/domain"
+)
+
+const (
+	// TypeBackInStock alerts are sent once the out of stock product is available again
+	TypeBackInStock = "back_in_stock"
+	// TypePriceDrop alerts are sent whenever the active price of the product drops
+	TypePriceDrop = "price_drop"
+)
+
+type (
+	// Store is the secondary port that persists the alert subscriptions
+	Store interface {
+		// Save adds or replaces the subscription
+		Save(ctx context.Context, subscription Subscription) error
+		// Delete removes the subscription, nop if it does not exist
+		Delete(ctx context.Context, id string) error
+		// All returns all subscriptions
+		All(ctx context.Context) ([]Subscription, error)
+	}
+
+	// Mailer is the secondary port that notifies the subscriber about the alert
+	Mailer interface {
+		SendAlert(ctx context.Context, alert Alert) error
+		// SendConfirmation asks the guest to confirm the subscription of the email with the token
+		SendConfirmation(ctx context.Context, subscription Subscription, token string) error
+	}
+
+	// Contact of the subscriber, either the email or the customer identity is set
+	Contact struct {
+		Email      string
+		CustomerID string
+	}
+
+	// Subscription to an alert for a product
+	Subscription struct {
+		ID                     string
+		Type                   string
+		MarketplaceCode        string
+		VariantMarketplaceCode string
+		Contact                Contact
+		CreatedAt              time.Time
+		// Confirmed subscriptions receive alerts, subscriptions of guests have to be confirmed with the token sent to their email
+		Confirmed bool
+		// ReferencePrice is the active price at subscription time or of the last price drop alert
+		ReferencePrice priceDomain.Price
+	}
+
+	// Alert that is sent to the subscriber
+	Alert struct {
+		Subscription Subscription
+		Product      domain.BasicProduct
+		// PreviousPrice and CurrentPrice are set for price drop alerts
+		PreviousPrice priceDomain.Price
+		CurrentPrice  priceDomain.Price
+		// UnsubscribeToken removes the subscription without login, e.g. as link in the alert mail
+		UnsubscribeToken string
+	}
+
+	// Event is dispatched for each alert that has been triggered
+	Event struct {
+		Alert Alert
+	}
+
+	// ConfirmationRequestedEvent is dispatched when a guest subscribed with an email, the token confirms the subscription
+	ConfirmationRequestedEvent struct {
+		Subscription Subscription
+		Token        string
+	}
+)
+
+var (
+	// ErrInvalidType is returned for unknown alert types
+	ErrInvalidType = errors.New("invalid alert type")
+	// ErrMissingContact is returned if the subscription has neither an email nor a customer identity
+	ErrMissingContact = errors.New("alert subscription needs an email or a customer")
+	// ErrInStock is returned when subscribing to the back in stock alert of a product that is in stock
+	ErrInStock = errors.New("product is in stock")
+	// ErrLoginRequired is returned if the action needs the authenticated customer, e.g. guest subscriptions are not enabled
+	ErrLoginRequired = errors.New("alert subscription needs a logged in customer")
+	// ErrInvalidToken is returned if the confirmation or unsubscribe token does not belong to the subscription
+	ErrInvalidToken = errors.New("invalid alert subscription token")
+	// ErrNotFound is returned for unknown subscriptions
+	ErrNotFound = errors.New("alert subscription not found")
+)
+
+// IsValidType checks if the alert type is known
+func IsValidType(alertType string) bool {
+	return alertType == TypeBackInStock || alertType == TypePriceDrop
+}
+
+// IsEmpty checks if no contact information is set
+func (c Contact) IsEmpty() bool {
+	return c.Email == "" && c.CustomerID == ""
+}
+
+// Matches checks if the contacts belong to the same subscriber, customers are only matched by their identity and guests by their email
+func (c Contact) Matches(other Contact) bool {
+	if c.CustomerID != "" || other.CustomerID != "" {
+		return c.CustomerID == other.CustomerID
+	}
+
+	return c.Email != "" && c.Email == other.Email
+}
+
+// IsFor checks if the subscription is for the product
+func (s Subscription) IsFor(marketplaceCode string, variantMarketplaceCode string) bool {
+	return s.MarketplaceCode == marketplaceCode && s.VariantMarketplaceCode == variantMarketplaceCode
+}
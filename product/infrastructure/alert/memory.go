@@ -0,0 +1,61 @@
+package alert
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	alertDomain "flamingo.me/flamingo-commerce/v3/product/domain/alert"
+)
+
+type (
+	// Memory saves all alert subscriptions in a simple map, the subscriptions are lost on restart
+	Memory struct {
+		mx            sync.RWMutex
+		subscriptions map[string]alertDomain.Subscription
+	}
+)
+
+var _ alertDomain.Store = new(Memory)
+
+// Inject dependencies
+func (m *Memory) Inject() *Memory {
+	m.subscriptions = make(map[string]alertDomain.Subscription)
+
+	return m
+}
+
+// Save a given subscription
+func (m *Memory) Save(_ context.Context, subscription alertDomain.Subscription) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	m.subscriptions[subscription.ID] = subscription
+
+	return nil
+}
+
+// Delete a stored subscription, nop if it doesn't exist
+func (m *Memory) Delete(_ context.Context, id string) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	delete(m.subscriptions, id)
+
+	return nil
+}
+
+// All stored subscriptions ordered by creation
+func (m *Memory) All(_ context.Context) ([]alertDomain.Subscription, error) {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	subscriptions := make([]alertDomain.Subscription, 0, len(m.subscriptions))
+	for _, subscription := range m.subscriptions {
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].CreatedAt.Before(subscriptions[j].CreatedAt)
+	})
+
+	return subscriptions, nil
+}
@@ -0,0 +1,248 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	"flamingo.me/flamingo/v3/framework/web"
+	"github.com/pkg/errors"
+
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+	"flamingo.me/flamingo-commerce/v3/product/application"
+	"flamingo.me/flamingo-commerce/v3/product/domain"
+	"flamingo.me/flamingo-commerce/v3/product/domain/alert"
+)
+
+type (
+	// AlertAPIController for back in stock and price drop alerts
+	AlertAPIController struct {
+		responder          *web.Responder
+		alertService       *application.AlertService
+		webIdentityService *auth.WebIdentityService
+	}
+
+	// AlertAPIResult view data
+	AlertAPIResult struct {
+		Error         *resultError
+		Success       bool
+		Subscriptions []AlertSubscription
+	}
+
+	// AlertSubscription view data
+	AlertSubscription struct {
+		ID                     string
+		Type                   string
+		MarketplaceCode        string
+		VariantMarketplaceCode string
+		Email                  string
+		CreatedAt              time.Time
+		ReferencePrice         priceDomain.Price
+		Confirmed              bool
+	}
+)
+
+// Inject dependencies
+func (c *AlertAPIController) Inject(
+	responder *web.Responder,
+	alertService *application.AlertService,
+	webIdentityService *auth.WebIdentityService,
+) *AlertAPIController {
+	c.responder = responder
+	c.alertService = alertService
+	c.webIdentityService = webIdentityService
+
+	return c
+}
+
+// Get returns the alert subscriptions of the logged in customer for the product
+// @Summary Gets the alert subscriptions of the logged in customer for the product
+// @Tags v1 Product API
+// @Produce json
+// @Success 200 {object} AlertAPIResult
+// @Failure 401 {object} AlertAPIResult
+// @Failure 500 {object} AlertAPIResult
+// @Param marketplacecode path string true "the marketplace code (idendifier) for the product"
+// @Param variantMarketplaceCode query string false "the marketplace code of the variant"
+// @Router /api/v1/products/{marketplacecode}/alerts [get]
+func (c *AlertAPIController) Get(ctx context.Context, r *web.Request) web.Result {
+	identity := c.webIdentityService.Identify(ctx, r)
+	if identity == nil {
+		return c.errorResult(http.StatusUnauthorized, errors.New("customer not logged in"))
+	}
+
+	subscriptions, err := c.alertService.Subscriptions(ctx, alert.Contact{CustomerID: identity.Subject()})
+	if err != nil {
+		return c.errorResult(http.StatusInternalServerError, err)
+	}
+
+	variantMarketplaceCode, _ := r.Query1("variantMarketplaceCode")
+	result := AlertAPIResult{Success: true, Subscriptions: make([]AlertSubscription, 0)}
+	for _, subscription := range subscriptions {
+		if subscription.IsFor(r.Params["marketplacecode"], variantMarketplaceCode) {
+			result.Subscriptions = append(result.Subscriptions, mapAlertSubscription(subscription))
+		}
+	}
+
+	return c.responder.Data(result)
+}
+
+// Subscribe to an alert of the product
+// @Summary Subscribes to the back in stock or price drop alert of the product
+// @Description Logged in customers are subscribed with their identity, guests have to provide an email and confirm the subscription with the token sent to it
+// @Tags v1 Product API
+// @Produce json
+// @Success 200 {object} AlertAPIResult
+// @Failure 400 {object} AlertAPIResult
+// @Failure 401 {object} AlertAPIResult "401 if guest subscriptions are not enabled"
+// @Failure 404 {object} AlertAPIResult
+// @Failure 409 {object} AlertAPIResult "409 if a back in stock alert is requested for a product in stock"
+// @Failure 500 {object} AlertAPIResult
+// @Param marketplacecode path string true "the marketplace code (idendifier) for the product"
+// @Param type query string true "the alert type: back_in_stock or price_drop"
+// @Param variantMarketplaceCode query string false "the marketplace code of the variant"
+// @Param email query string false "the email of the guest that should be notified, ignored for logged in customers"
+// @Router /api/v1/products/{marketplacecode}/alerts [put]
+func (c *AlertAPIController) Subscribe(ctx context.Context, r *web.Request) web.Result {
+	alertType, _ := r.Query1("type")
+	variantMarketplaceCode, _ := r.Query1("variantMarketplaceCode")
+
+	subscription, err := c.alertService.Subscribe(ctx, alertType, r.Params["marketplacecode"], variantMarketplaceCode, c.contact(ctx, r))
+	if err != nil {
+		return c.errorResult(errorStatus(err), err)
+	}
+
+	return c.responder.Data(AlertAPIResult{
+		Success:       true,
+		Subscriptions: []AlertSubscription{mapAlertSubscription(*subscription)},
+	})
+}
+
+// Unsubscribe the logged in customer from the alerts of the product
+// @Summary Unsubscribes the logged in customer from the alerts of the product
+// @Description Guests unsubscribe with the token of the alert, see /api/v1/product-alerts/{subscriptionID}
+// @Tags v1 Product API
+// @Produce json
+// @Success 200 {object} AlertAPIResult
+// @Failure 400 {object} AlertAPIResult
+// @Failure 401 {object} AlertAPIResult
+// @Failure 500 {object} AlertAPIResult
+// @Param marketplacecode path string true "the marketplace code (idendifier) for the product"
+// @Param type query string false "the alert type: back_in_stock or price_drop, all alerts of the product are removed if empty"
+// @Param variantMarketplaceCode query string false "the marketplace code of the variant"
+// @Router /api/v1/products/{marketplacecode}/alerts [delete]
+func (c *AlertAPIController) Unsubscribe(ctx context.Context, r *web.Request) web.Result {
+	alertType, _ := r.Query1("type")
+	variantMarketplaceCode, _ := r.Query1("variantMarketplaceCode")
+
+	err := c.alertService.Unsubscribe(ctx, alertType, r.Params["marketplacecode"], variantMarketplaceCode, c.contact(ctx, r))
+	if err != nil {
+		return c.errorResult(errorStatus(err), err)
+	}
+
+	return c.responder.Data(AlertAPIResult{Success: true})
+}
+
+// Confirm the alert subscription of a guest
+// @Summary Confirms the alert subscription of a guest with the token sent to the email
+// @Tags v1 Product API
+// @Produce json
+// @Success 200 {object} AlertAPIResult
+// @Failure 403 {object} AlertAPIResult
+// @Failure 404 {object} AlertAPIResult
+// @Failure 500 {object} AlertAPIResult
+// @Param subscriptionID path string true "the id of the subscription"
+// @Param token query string true "the confirmation token"
+// @Router /api/v1/product-alerts/{subscriptionID}/confirm [post]
+func (c *AlertAPIController) Confirm(ctx context.Context, r *web.Request) web.Result {
+	token, _ := r.Query1("token")
+
+	subscription, err := c.alertService.Confirm(ctx, r.Params["subscriptionID"], token)
+	if err != nil {
+		return c.errorResult(errorStatus(err), err)
+	}
+
+	return c.responder.Data(AlertAPIResult{
+		Success:       true,
+		Subscriptions: []AlertSubscription{mapAlertSubscription(*subscription)},
+	})
+}
+
+// UnsubscribeWithToken removes the alert subscription without login
+// @Summary Removes the alert subscription with the unsubscribe token of its alerts
+// @Tags v1 Product API
+// @Produce json
+// @Success 200 {object} AlertAPIResult
+// @Failure 403 {object} AlertAPIResult
+// @Failure 404 {object} AlertAPIResult
+// @Failure 500 {object} AlertAPIResult
+// @Param subscriptionID path string true "the id of the subscription"
+// @Param token query string true "the unsubscribe token"
+// @Router /api/v1/product-alerts/{subscriptionID} [delete]
+func (c *AlertAPIController) UnsubscribeWithToken(ctx context.Context, r *web.Request) web.Result {
+	token, _ := r.Query1("token")
+
+	err := c.alertService.UnsubscribeWithToken(ctx, r.Params["subscriptionID"], token)
+	if err != nil {
+		return c.errorResult(errorStatus(err), err)
+	}
+
+	return c.responder.Data(AlertAPIResult{Success: true})
+}
+
+// contact of the logged in customer, guests are identified by the given email
+func (c *AlertAPIController) contact(ctx context.Context, r *web.Request) alert.Contact {
+	if identity := c.webIdentityService.Identify(ctx, r); identity != nil {
+		return alert.Contact{CustomerID: identity.Subject()}
+	}
+
+	email, _ := r.Query1("email")
+
+	return alert.Contact{Email: email}
+}
+
+func (c *AlertAPIController) errorResult(status int, err error) web.Result {
+	response := c.responder.Data(AlertAPIResult{
+		Success: false,
+		Error:   &resultError{Code: strconv.Itoa(status), Message: err.Error()},
+	})
+	response.Status(uint(status))
+
+	return response
+}
+
+func errorStatus(err error) int {
+	if _, ok := errors.Cause(err).(domain.ProductNotFound); ok {
+		return http.StatusNotFound
+	}
+
+	switch err {
+	case alert.ErrInvalidType, alert.ErrMissingContact:
+		return http.StatusBadRequest
+	case alert.ErrLoginRequired:
+		return http.StatusUnauthorized
+	case alert.ErrInvalidToken:
+		return http.StatusForbidden
+	case alert.ErrNotFound:
+		return http.StatusNotFound
+	case alert.ErrInStock:
+		return http.StatusConflict
+	}
+
+	return http.StatusInternalServerError
+}
+
+func mapAlertSubscription(subscription alert.Subscription) AlertSubscription {
+	return AlertSubscription{
+		ID:                     subscription.ID,
+		Type:                   subscription.Type,
+		MarketplaceCode:        subscription.MarketplaceCode,
+		VariantMarketplaceCode: subscription.VariantMarketplaceCode,
+		Email:                  subscription.Contact.Email,
+		CreatedAt:              subscription.CreatedAt,
+		ReferencePrice:         subscription.ReferencePrice,
+		Confirmed:              subscription.Confirmed,
+	}
+}
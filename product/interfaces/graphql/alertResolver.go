@@ -0,0 +1,165 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"flamingo.me/flamingo/v3/core/auth"
+	"flamingo.me/flamingo/v3/framework/web"
+
+	priceDomain "flamingo.me/flamingo-commerce/v3/price/domain"
+	"flamingo.me/flamingo-commerce/v3/product/application"
+	"flamingo.me/flamingo-commerce/v3/product/domain/alert"
+)
+
+type (
+	// CommerceProductAlertResolver resolves the back in stock and price drop alert queries and mutations
+	CommerceProductAlertResolver struct {
+		alertService       *application.AlertService
+		webIdentityService *auth.WebIdentityService
+		enabled            bool
+	}
+
+	// AlertSubscriptionDTO is the graphql representation of an alert subscription
+	AlertSubscriptionDTO struct {
+		ID                     string
+		Type                   string
+		MarketplaceCode        string
+		VariantMarketplaceCode string
+		Email                  string
+		CreatedAt              time.Time
+		ReferencePrice         priceDomain.Price
+		Confirmed              bool
+	}
+)
+
+// errAlertsDisabled is returned if the alerts are not enabled
+var errAlertsDisabled = errors.New("product alerts are disabled")
+
+// Inject dependencies
+func (r *CommerceProductAlertResolver) Inject(
+	alertService *application.AlertService,
+	webIdentityService *auth.WebIdentityService,
+	config *struct {
+		Enabled bool `inject:"config:commerce.product.alerts.enabled,optional"`
+	},
+) *CommerceProductAlertResolver {
+	r.alertService = alertService
+	r.webIdentityService = webIdentityService
+
+	if config != nil {
+		r.enabled = config.Enabled
+	}
+
+	return r
+}
+
+// CommerceProductAlerts returns the alert subscriptions of the logged in customer
+func (r *CommerceProductAlertResolver) CommerceProductAlerts(ctx context.Context) ([]*AlertSubscriptionDTO, error) {
+	if !r.enabled {
+		return nil, errAlertsDisabled
+	}
+
+	identity := r.webIdentityService.Identify(ctx, web.RequestFromContext(ctx))
+	if identity == nil {
+		return []*AlertSubscriptionDTO{}, nil
+	}
+
+	subscriptions, err := r.alertService.Subscriptions(ctx, alert.Contact{CustomerID: identity.Subject()})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*AlertSubscriptionDTO, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		result = append(result, mapAlertSubscription(subscription))
+	}
+
+	return result, nil
+}
+
+// CommerceProductSubscribeAlert subscribes the logged in customer or the email of a guest to the alert of the product
+func (r *CommerceProductAlertResolver) CommerceProductSubscribeAlert(ctx context.Context, alertType string, marketplaceCode string, variantMarketplaceCode *string, email *string) (*AlertSubscriptionDTO, error) {
+	if !r.enabled {
+		return nil, errAlertsDisabled
+	}
+
+	subscription, err := r.alertService.Subscribe(ctx, alertType, marketplaceCode, stringValue(variantMarketplaceCode), r.contact(ctx, email))
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAlertSubscription(*subscription), nil
+}
+
+// CommerceProductConfirmAlert confirms the subscription of a guest with the token sent to the email
+func (r *CommerceProductAlertResolver) CommerceProductConfirmAlert(ctx context.Context, subscriptionID string, token string) (*AlertSubscriptionDTO, error) {
+	if !r.enabled {
+		return nil, errAlertsDisabled
+	}
+
+	subscription, err := r.alertService.Confirm(ctx, subscriptionID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapAlertSubscription(*subscription), nil
+}
+
+// CommerceProductUnsubscribeAlert unsubscribes the logged in customer from the alerts of the product
+func (r *CommerceProductAlertResolver) CommerceProductUnsubscribeAlert(ctx context.Context, alertType *string, marketplaceCode string, variantMarketplaceCode *string) (bool, error) {
+	if !r.enabled {
+		return false, errAlertsDisabled
+	}
+
+	err := r.alertService.Unsubscribe(ctx, stringValue(alertType), marketplaceCode, stringValue(variantMarketplaceCode), r.contact(ctx, nil))
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CommerceProductUnsubscribeAlertWithToken removes the subscription with the unsubscribe token of its alerts
+func (r *CommerceProductAlertResolver) CommerceProductUnsubscribeAlertWithToken(ctx context.Context, subscriptionID string, token string) (bool, error) {
+	if !r.enabled {
+		return false, errAlertsDisabled
+	}
+
+	if err := r.alertService.UnsubscribeWithToken(ctx, subscriptionID, token); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// contact of the logged in customer, guests are identified by the given email
+func (r *CommerceProductAlertResolver) contact(ctx context.Context, email *string) alert.Contact {
+	if identity := r.webIdentityService.Identify(ctx, web.RequestFromContext(ctx)); identity != nil {
+		return alert.Contact{CustomerID: identity.Subject()}
+	}
+
+	return alert.Contact{Email: stringValue(email)}
+}
+
+func mapAlertSubscription(subscription alert.Subscription) *AlertSubscriptionDTO {
+	return &AlertSubscriptionDTO{
+		ID:                     subscription.ID,
+		Type:                   subscription.Type,
+		MarketplaceCode:        subscription.MarketplaceCode,
+		VariantMarketplaceCode: subscription.VariantMarketplaceCode,
+		Email:                  subscription.Contact.Email,
+		CreatedAt:              subscription.CreatedAt,
+		ReferencePrice:         subscription.ReferencePrice,
+		Confirmed:              subscription.Confirmed,
+	}
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
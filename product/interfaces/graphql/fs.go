@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\xcd\x6e\xdb\x38\x10\xbe\xe7\x29\x18\xef\x25\x05\x8a\x3e\x80\x6f\x89\xdb\x2c\x82\xc6\x45\xb6\xf6\xee\x65\x11\x04\x63\x6a\x2c\x13\xa1\x48\x2d\x49\x39\x11\x16\xfb\xee\x0b\xfe\x48\x26\x45\x49\x76\x6f\x3d\x34\xa7\x78\xe6\x9b\x1f\xce\xbf\x98\x30\xa8\xf6\x40\x91\xac\x64\x55\xa1\xa2\xf8\xf2\xa4\x64\xd1\x50\x43\xfe\xbd\x22\x84\x90\x1d\x68\xfc\x0c\x06\x96\x27\xc0\x1d\x68\x46\x03\xca\xb2\xae\x1d\xd0\x20\x68\x54\x03\x68\x40\x6d\x7b\x9e\xc7\xea\x1a\x29\xdb\x33\x0a\x86\x49\xa1\x73\xfc\x26\xe1\x7b\x19\xa6\x37\xc0\x11\x76\x1c\x97\xe4\x4e\x4a\x8e\x20\x82\xb2\x40\x1e\x37\xdd\x09\x05\x27\xdb\x1a\x97\x64\x63\x14\x13\xa5\xa7\x94\x68\x1e\x0a\x14\x86\xed\x19\xaa\x94\x75\x00\xbd\xc6\x82\xc1\x4d\xa9\x64\x53\xf7\xbc\x8f\xa4\xd1\x50\x9e\xd4\x7c\x18\xf8\x53\xa2\xb9\x50\x6c\xe8\xab\x13\xbb\xbe\xfa\xef\xea\xca\xfa\x79\x62\x6f\x58\x55\x73\xec\xf2\xe2\x7e\x54\x28\x8c\xfe\x95\xb3\x9f\x29\x67\x83\xa4\xad\xa4\xd8\xb3\xb2\x51\xf6\x21\xbf\x52\xf7\x13\xa7\xce\x2a\x39\x82\x62\x20\x8c\x5e\x92\xbf\x87\xa8\x97\xbf\x3c\xef\xfa\x39\x46\x3a\xa2\x0d\xd7\xad\x31\x8a\xed\x1a\x83\x56\x36\xd8\x7a\xce\x5b\x78\xa0\xec\x47\xb3\x7d\x69\xd0\x33\xbb\x43\x75\xc1\xb0\x61\xc6\x26\x36\xfa\x8b\x33\x01\xd1\x9b\xfc\xdf\xd0\xe2\xe9\xd5\xde\xbd\x83\x54\xe6\x33\x6a\xaa\x58\x6d\x83\x92\x26\xb6\x88\x19\x99\xb1\xca\x66\x21\x71\x25\x4b\x81\x4f\xd4\xf3\x95\xc7\x83\x7a\x45\xf3\xc4\x81\xe2\x4a\x16\x83\xf2\x52\x68\x80\x71\x54\x9e\x33\xb0\xd4\x31\x37\xaf\xcd\x32\x73\xa3\x63\x7e\x83\x2a\x95\x74\x5c\xaa\x10\x0c\x16\xb7\xc6\xb2\xb6\xac\x42\x47\x6d\xea\x62\x84\x7a\x64\x9a\xed\x38\xde\x2b\x59\x2d\x33\xea\x56\xf6\x58\x47\x5e\x81\xc1\x52\x2a\xe6\x43\x7d\x7a\x79\xa0\xb7\xbe\x95\x43\xed\xad\x81\x89\x8e\x11\x55\xc1\x00\x1b\x3c\xee\x88\xd2\xc6\x62\x0d\x75\xcd\x44\x19\x97\xa8\x4f\x9c\x91\xf4\xf5\x11\x8f\xc8\x97\xe9\x83\x5f\xb1\x7d\x93\xaa\x48\x8a\xda\x8f\x85\x6f\xf8\xe6\xe2\xd3\x37\xe1\x54\xad\x9f\xa6\x50\x28\x3a\x57\x25\x5b\x5f\x79\x5e\xe9\x6c\xf1\x44\x63\xee\x49\x31\x8a\x24\x2f\x7c\x47\x7f\x10\x7b\x39\xc4\x3e\x68\x1b\x7e\xf7\x6f\x3f\x2e\x1c\xa6\x56\xb8\x41\x8e\xd4\x60\x11\x5a\xd1\x55\x43\x64\xd1\xd5\x23\x19\x19\x04\x5d\x15\xce\x14\x61\xe4\xc6\xed\x11\x18\xf7\xb3\x9f\x51\xd4\x63\x0a\x7b\xef\x83\x52\x2f\xf8\x28\x5b\xe0\xa6\xed\x99\xf9\xab\x87\x08\x27\xbc\x48\xa4\xbf\x80\x12\x4c\x94\x96\x4b\xf6\x52\x11\x73\x40\x42\x1b\xa5\x50\x18\x52\x7b\x2d\x8b\xdc\x66\x24\x35\x69\x35\xc2\x4c\x66\x7e\xe8\x60\x37\x74\xb2\x6d\x50\xe0\x1e\x1a\x6e\x12\x63\x8c\x62\xb7\x81\x3e\x33\x4d\x65\x23\x0c\x16\x83\x99\x5f\x44\x8c\x31\xd1\x8e\xbf\xc5\x77\x93\x5a\xac\x98\x78\x92\x4c\x18\xbd\x95\x9b\x1a\x85\x59\x92\x7b\x2e\xc1\x04\x26\xbc\x4f\x33\xa9\x14\xc6\xa9\x4b\x0d\xae\x3c\xd9\xb5\xc1\x62\x24\xfa\xfa\x20\xdf\xb4\x8b\xbf\x0b\x15\x88\xc2\xfd\xa8\x9d\x1d\x82\xa0\x04\x16\x8b\xd9\x30\xc6\xda\x7c\x20\x17\xdb\x4e\x9d\xdc\x3b\x6d\x39\xf2\x23\xc1\x4f\xe5\x27\xb2\x66\x1c\xf5\xad\x28\xd6\x52\xe1\x62\x22\x09\x4e\xdb\x11\x78\x33\xab\xce\x57\x0f\x6d\x09\x05\x41\x76\xe8\xd5\x87\x57\x48\x45\x2a\x6b\x68\x31\x9f\xd4\x91\x72\x39\x05\x30\x3c\x8d\x36\xda\xc8\x0a\xd5\xef\xc9\x32\xf7\xac\x03\x08\x81\x3c\x1f\xfa\x5c\x52\xe0\x11\x6d\xaa\x2c\xd3\x33\x27\x18\x74\x57\xc3\xd8\xde\x4f\xd0\xce\x9d\x99\xbd\x9e\x83\xd3\x45\x1b\xbb\x8b\xc2\xf8\x79\x3f\x6f\xf2\x8b\x30\xaa\xbd\xd4\xa4\x03\x07\x93\x1c\x76\xf1\x38\xf7\x47\x0b\x6f\x2e\x3b\x50\xba\x53\x22\xe8\x3a\x7b\x05\x0e\xb6\x5c\x47\xb6\x6b\xae\x27\x02\x35\xec\x88\x61\x18\xcf\x8f\x70\x48\xa7\xe6\x05\x43\x93\x47\xb3\x66\x0c\x3f\x9c\x45\x41\x6c\xc1\x7f\x70\x50\xa6\xf8\x19\x4b\x91\xc6\x99\x38\xbb\x65\x92\xcc\xc5\xfc\x30\x62\x15\x6e\x3d\x2b\x26\x87\xb3\x76\x88\x8e\x2e\xba\xf4\xac\xd9\xa3\xed\xdb\x0b\xda\xe3\x74\xd3\x05\xbf\xfa\x33\xf0\x2b\xb6\xd9\x21\x10\xdf\x88\x59\x28\x7a\x55\x01\x7c\x00\xdd\x93\x6e\x5e\xb1\x1d\x39\xe6\xbb\x5b\x7e\x12\x37\x69\x23\x93\xd4\x77\xed\x57\x6c\xad\x7c\xec\xf5\x87\x33\x7e\x9e\x0d\x4b\x37\xa1\xb2\xf9\x63\x29\x8f\x79\xd3\x8d\xf4\x61\x23\x98\xc9\xe7\xd7\x05\xdd\x99\x9e\x78\x93\x9e\xd4\x60\x0e\x29\x45\xb8\x73\x36\xc5\x28\xb7\xda\x26\x74\x4f\xc6\x61\xb8\xcd\x27\xc6\xfc\x99\xfd\x7c\x66\x3d\xfb\x51\x71\x07\x1a\x93\xdd\x7b\x22\xdf\x56\x56\x70\x82\xf9\xa7\x60\x26\x39\xc5\x46\x4f\x88\x70\x1e\x57\x35\xb0\x52\x7c\x6f\x38\x66\xb5\x5d\xa0\x68\xed\xc6\xec\x84\x75\x2a\xfb\xdb\xf9\x73\xc0\xb7\x24\xbc\xaf\x38\x68\x1d\x9d\xd6\x93\xdf\x85\x1b\x04\x45\x0f\xdf\x51\x37\xbc\x5b\x85\x61\xfc\x8c\xf5\x57\xf0\x73\x0f\x14\x53\xbe\x57\xf3\x72\x6f\x19\xd7\xcf\x61\x54\x37\x65\x89\x3a\x7c\xfa\x67\xd0\x4d\xcf\x0d\x4a\xb5\xa3\xaf\x31\xf9\xd0\x0c\x60\x4b\xed\x3f\xd6\xbb\x3b\xda\x19\x5b\x26\xdf\x03\xf8\x6e\xd0\x1e\x3a\xf6\xa9\x7f\x34\xd8\xaf\xa6\xe1\x3b\x6e\xfc\x1d\x5d\x67\x1f\x73\x23\xfd\x3e\xaa\x20\x38\x76\xa3\x43\xf8\xfe\x69\x50\x9b\xdc\xef\xc0\x18\xd1\x9a\x04\xde\xfa\xfe\x7f\x00\x00\x00\xff\xff\x9e\x2b\x07\xe4\x11\x15\x00\x00")
+var _schemaGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xed\x58\xcd\x6e\xdb\x38\x10\xbe\xe7\x29\x14\xe7\x92\x02\x46\x1f\xc0\xb7\xc4\x69\x17\xc1\xc6\x45\xb6\x76\x77\x0f\x45\x60\xd0\xd2\xd8\x26\x22\x89\x5a\x92\x72\x62\x2c\xf6\xdd\x77\x48\x8e\x64\x52\x94\x64\x77\x4f\x3d\xd4\x27\x7b\x66\x38\xbf\x1f\x67\x86\xe6\xa5\x06\xb9\x65\x29\x24\x73\x51\x14\x20\x53\x58\x3f\x4b\x91\xd5\xa9\x4e\xfe\xb9\x4a\xf0\xb3\x61\x0a\x1e\x98\x66\xb3\x93\xc0\x3d\x53\x3c\x25\x29\xc3\xba\xb6\x82\x1a\x50\x52\x76\x44\x49\x6a\xd5\xf2\x9c\xac\xaa\x20\xe5\x5b\x9e\x32\xcd\x45\xa9\x62\xf9\x65\xc0\x77\x67\xb8\x5a\xb2\x1c\xd8\x26\x87\x59\x72\x2f\x04\x7e\x2d\x49\x19\x91\xfb\x4d\x37\x87\xc8\xc9\x63\x85\xc7\x97\x5a\xf2\x72\xe7\x28\x3b\xd0\x8f\x19\x94\x1a\xcd\x81\x0c\x59\x7b\xa6\x16\x90\x71\x76\xbb\x93\xa2\xae\x5a\xde\x34\xa9\x15\xdb\x9d\xd4\x7c\xe8\xf8\x83\x1a\x2f\x3c\xd6\xf5\xd5\x1e\xbb\xbe\xfa\xf7\xea\xca\xf8\x79\x62\x2f\x79\x51\xe5\xd0\xd4\xc5\xfe\x28\xd0\x65\xf5\xab\x66\x3f\x53\xcd\x3a\x45\x9b\x8b\x72\xcb\x77\xb5\x34\x81\xfc\x2a\xdd\x4f\x5c\x3a\xa3\xe4\xc0\x24\x67\x58\x96\x59\xf2\xbd\x2b\xb5\xfe\xd3\xf1\xae\x5f\x7c\x49\x4b\x34\xe9\xba\xd3\xa8\x7f\x53\x6b\x30\x67\xc9\xd6\x4b\x7c\x85\x3b\xca\x7e\xb4\xda\x97\x26\x3d\xb2\xdb\x55\x47\x86\x35\xd7\xa6\xb0\xde\xc7\xaf\x04\xf3\x62\x72\x9f\xae\xc5\x53\xd4\xce\xbd\xbd\x90\xfa\x01\x54\x2a\x79\x65\x92\x12\x16\x36\xf3\x19\x91\xb1\xc2\x54\x21\x70\xe5\x7b\x7f\xa1\x5e\xae\x9c\x3c\x93\xaf\xa0\x9f\x73\x9c\x59\x73\x91\x75\xe0\x25\x41\x33\x9e\x83\x74\x9c\x8e\xa5\x86\xb9\x7c\xad\x67\xc9\x10\xf3\x0b\x2b\xc2\x93\x96\x9b\x4a\x60\x1a\xb2\x3b\x6d\x58\x2b\x5e\x80\xa5\xd6\x55\xd6\x43\x3d\x70\xc5\xb1\x18\x9f\xa5\x28\x66\x11\x75\x25\x5a\x59\x4b\x9e\xa3\x82\x9d\x90\xdc\xa5\xfa\x14\x39\xd1\x8f\xee\x2a\x13\xf6\x16\x8c\x97\x0d\xc3\x43\x41\x47\x96\x3c\x6e\x88\xc2\xe4\x62\xc1\xaa\x0a\xa3\xf1\x21\xea\x0a\xa7\x45\xfa\xfa\x04\x07\xc8\x67\x61\xc0\xaf\x70\x7c\x13\x32\x0b\x40\xed\xda\xc2\x17\x78\xb3\xf9\x69\x2f\xe1\x10\xd6\x4f\x5d\x88\x40\x67\x51\xb2\x72\xc8\x73\x4a\x47\xc1\xe3\xb5\xb9\x67\xc9\x71\x43\x89\x81\x6f\xe9\x8f\xe5\x56\x74\x65\x1f\x95\x49\xbf\xfd\xda\xb6\x0b\x2b\x53\x49\x58\x42\x0e\x29\x96\x8d\xae\xa2\x45\x83\x67\xd1\xe2\x31\x99\x0d\xa3\x70\x04\x84\x9e\x1b\x77\x07\x84\x93\xeb\xfd\xe8\x84\xea\x53\xd8\x7a\x4f\x4a\xdd\xc1\x27\x71\x64\xb9\x3e\xb6\xcc\x38\xea\xae\x84\x3d\x3c\x09\x4e\x7f\x62\xb2\x44\x7f\x0c\x37\xd9\x0a\x99\xe8\x3d\x24\x69\x2d\x25\x76\x5e\x4c\x81\xd5\x32\x89\x6d\x7a\xa7\x06\xad\x7a\x32\x83\x95\xef\x3a\xd8\x34\x9d\x68\x1a\x64\xb0\x65\x75\xae\x03\x63\x78\xa6\x99\x40\x0f\x5c\xa5\xa2\xc6\x0d\x35\xeb\xf4\xfc\xcc\x63\xf4\x1d\x6d\xf8\x2b\x78\xd7\xa1\xc5\x82\x97\xcf\x82\x63\xa7\x5f\x09\x9c\x7a\x25\x72\x3f\xe7\x82\x69\x62\xb2\xf7\x61\x66\x2a\xd0\xde\x7b\xe4\xeb\xdc\x91\xed\x35\x98\xf4\x64\x1f\xd1\xfd\xa6\x6c\xfe\x6d\xaa\x58\x99\xd9\x1f\x95\xb5\x93\x00\x4a\x42\x36\x19\x4d\xa3\xaf\xcd\x25\x72\xb2\x6a\xd4\x89\xad\xd5\x16\x4b\x4e\x13\xf8\xb8\xfb\x98\x2c\xb0\xa5\xa9\xbb\x32\x5b\x08\x09\x93\x81\x22\x58\x6d\x07\x96\xd7\xa3\xea\x1c\x7a\xd2\x23\x36\x96\x32\xd9\x80\x53\x4f\x51\x20\xc0\x0a\x63\x68\x32\x5e\xd4\x1e\xb8\x9c\x12\x48\xa1\xa5\x35\xb6\x24\xe4\xfe\x16\x0c\x73\xc7\xda\xb3\xb2\x84\x3c\x6e\xfa\xb9\x48\x59\xee\xd1\x86\x60\x19\xae\x39\x64\xd0\x6e\x0d\x7d\x73\x3f\x90\xb6\xee\x8c\xcc\xf5\x58\x38\x1c\xb4\xbe\xbb\x08\x2c\xd7\xef\xc7\x4d\x7e\x42\xb1\xe3\xa5\x26\xad\x30\x99\xc4\xae\xe3\xb7\x73\xb7\xb4\x60\x75\x2f\x5a\x50\x9a\x55\x82\x74\x9d\xdd\x02\x3b\x53\xae\x21\x9b\x31\xd7\x12\x59\xaa\xf9\x01\xa8\x19\x8f\xb7\x70\x16\x76\xcd\x0b\x9a\x66\xee\xf5\x9a\x3e\xf9\x6e\x2f\xa2\x63\x93\xfc\x07\x1b\x65\x28\x3f\x62\xc9\xd3\x38\x92\x67\x3b\x4c\x82\xbe\x18\x2f\x46\x98\xbf\x95\x63\xf9\x64\x5a\x6b\xbb\xd2\xde\x46\x17\xae\x35\x5b\x30\xf7\xf6\x82\xeb\x71\xda\xe9\xc8\xaf\x76\x0d\xfc\x1d\x8e\xd1\x22\xe0\xef\x88\xdf\x07\x55\x91\x30\xae\xf6\x2d\xe9\x16\x57\x8b\x9e\x65\xbe\xd9\xe5\x07\xe5\x06\x6d\x44\x27\xd5\xfd\x11\x3d\x36\xe7\x7d\xaf\x3f\x9c\xf1\xf3\x6c\x5a\x9a\x0e\x15\xf5\x1f\x43\x79\x8a\x2f\x5d\xcf\x3d\xac\x4b\xae\xe3\xfe\x75\xc1\xed\x0c\x57\xbc\x41\x4f\x2a\xa6\xf7\x21\xa5\xb4\xeb\x6c\x28\x23\xed\x68\x1b\xd0\x3d\x98\x87\xee\x34\x1f\x68\xf3\x67\xe6\xf3\x99\xf1\xec\x5a\x05\x3e\x5a\x20\x98\xbd\x27\xf2\x5d\x61\x0e\x0e\x30\xbf\x61\x7a\x83\x55\xac\x77\x85\xa0\xf5\xb8\xa8\x18\xdf\x95\x5f\xeb\x1c\x22\x6c\xe3\x03\xf5\x68\x26\x66\x73\x58\x85\x67\x6f\xce\xaf\x03\xee\x4a\xb2\xf7\x79\xce\x94\xf2\x56\xeb\xc1\x77\xe1\x12\x37\x81\x74\xff\x15\x14\xa6\x94\x12\x4c\xed\xa7\xef\x7e\x91\x9f\xe6\x7f\xbb\x90\xef\xd4\xac\x3f\x1b\xc6\xf5\x0b\xb5\xea\x7a\xb7\x03\x45\x4f\xff\x48\x74\xd9\x72\x49\xa9\xb2\xf4\x05\x04\x0f\x4d\x12\x36\xd4\xf6\xb1\xde\xec\xd1\xd6\xd8\xec\xfc\x7b\x60\x7d\x87\xcf\x2b\xbd\xac\x37\xed\xa6\xdf\x0c\x99\x2c\x04\xc1\x0d\xbe\x8b\xd3\xd7\x35\x2f\xd7\xf6\x79\x62\xd6\x8b\xca\xe4\x76\x9d\x49\x51\x0d\x2c\x31\x6e\x21\xaf\xfa\x5f\x85\xf4\x66\x5f\x8c\xc9\x40\x81\x93\xa7\x73\xaf\x4f\x8f\x3e\x33\xcb\x1a\xe7\xcc\x88\x70\x98\x73\x6e\x61\x2b\xc4\x1c\x7b\x51\x69\x14\x36\x4e\xd3\x36\x85\x10\xd0\x24\xd9\x06\xd0\xb6\xe5\x78\x2a\xb6\x4b\xec\x4d\xa0\x55\x19\x75\x3b\xec\x13\xb8\x6f\x49\x48\xc1\x98\x67\x26\xa1\x2a\x61\x5b\x0d\x76\x72\x1d\xb1\x2c\x48\xde\x00\x94\x06\x9f\x5b\x2e\xf1\x31\xd3\x2c\xaf\xee\x57\x58\x27\x44\x2a\x98\x85\xd4\x94\xeb\x8f\x1a\xda\x15\xa2\x5b\xba\xdb\xa1\xf4\xf6\xf4\xe5\x5e\x05\x04\xa0\x5b\x45\x30\xff\xdb\x04\x12\xe3\x8b\x18\x3d\x5a\x83\x0b\xd2\xe4\xc7\xc6\x1f\x67\xc9\x26\x5d\x20\xac\xb3\x84\x97\xed\x46\xd9\xef\x97\xc5\x64\xef\xdf\x3e\x11\x5a\xcd\x7d\xea\xe4\x6c\x51\x6b\xe6\x01\xb9\xad\xd8\x06\xd4\x80\x17\x09\xed\x18\x16\x6e\xc6\x59\xe6\x8a\x9a\x68\x61\xe9\x23\xc8\xa7\x70\x29\x40\xea\x0e\x53\x32\x4c\xc8\xb0\xf5\x47\x55\x54\x6f\x2b\x19\x60\xf3\x8d\xeb\xbd\x7b\x8d\x88\x57\x44\x89\x32\x7b\x0e\x99\xb6\x2e\x0d\x54\xaf\x09\xcb\x26\xe5\x36\xb8\x7e\xd3\xc1\xbb\x37\x3d\x73\xf1\xa6\xe1\xa5\xeb\xab\x7a\x5c\x04\x8a\x97\x02\x54\x71\x84\xa7\x9c\xf6\xc7\x32\x77\x27\x5d\x24\xfe\xc9\xc7\x07\xcf\x73\x9b\x9e\x31\x98\x0f\xbb\x56\x97\xe7\x51\xb0\xc5\xb5\xd9\x35\x92\xbe\x9a\x22\x35\x6f\x2e\x77\xc8\x4a\x70\x78\xe3\xf5\x2f\xc4\xc1\x28\xdc\x26\xa5\x70\x40\xe4\x2a\xd9\x61\x47\x28\xfb\x43\xfe\x76\xf2\x28\x2e\xe0\xff\xaf\x5f\xf7\x9f\xd8\x1b\xf2\x4c\x8d\xc0\xce\x4b\x0e\x41\x10\x03\xe4\xa6\x89\xd9\x70\xa7\x26\x22\xcc\x17\xe6\xaa\x04\xc8\xa8\x7b\x9d\x0d\xe8\x2f\x54\xbf\x32\xda\x7e\xa0\xa0\x5e\x1f\xfc\x0f\x05\xc8\x5d\xb8\x05\x1b\x00\x00")
 
 func schemaGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -45,7 +45,13 @@ func (*Service) Types(types *graphql.Types) {
 	types.Map("Commerce_ProductLoyaltyEarningInfo", domain.LoyaltyEarningInfo{})
 	types.Map("Commerce_PriceContext", domain.PriceContext{})
 	types.Map("Commerce_Product_SearchResult", SearchResultDTO{})
+	types.Map("Commerce_Product_AlertSubscription", AlertSubscriptionDTO{})
 
 	types.Resolve("Query", "Commerce_Product", CommerceProductQueryResolver{}, "CommerceProduct")
 	types.Resolve("Query", "Commerce_Product_Search", CommerceProductQueryResolver{}, "CommerceProductSearch")
+	types.Resolve("Query", "Commerce_Product_Alerts", CommerceProductAlertResolver{}, "CommerceProductAlerts")
+	types.Resolve("Mutation", "Commerce_Product_SubscribeAlert", CommerceProductAlertResolver{}, "CommerceProductSubscribeAlert")
+	types.Resolve("Mutation", "Commerce_Product_ConfirmAlert", CommerceProductAlertResolver{}, "CommerceProductConfirmAlert")
+	types.Resolve("Mutation", "Commerce_Product_UnsubscribeAlert", CommerceProductAlertResolver{}, "CommerceProductUnsubscribeAlert")
+	types.Resolve("Mutation", "Commerce_Product_UnsubscribeAlertWithToken", CommerceProductAlertResolver{}, "CommerceProductUnsubscribeAlertWithToken")
 }
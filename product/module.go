@@ -3,7 +3,10 @@ package product
 import (
 	"flamingo.me/dingo"
 	"flamingo.me/flamingo-commerce/v3/price"
+	"flamingo.me/flamingo-commerce/v3/product/application"
 	"flamingo.me/flamingo-commerce/v3/product/domain"
+	alertDomain "flamingo.me/flamingo-commerce/v3/product/domain/alert"
+	"flamingo.me/flamingo-commerce/v3/product/infrastructure/alert"
 	"flamingo.me/flamingo-commerce/v3/product/infrastructure/fake"
 	"flamingo.me/flamingo-commerce/v3/product/interfaces/controller"
 	productgraphql "flamingo.me/flamingo-commerce/v3/product/interfaces/graphql"
@@ -16,20 +19,26 @@ import (
 
 // Module represents the product module
 type Module struct {
-	fakeService bool
-	api         bool
+	fakeService   bool
+	api           bool
+	alertsEnabled bool
+	alertStore    string
 }
 
 // Inject module configuration
 func (m *Module) Inject(
 	cfg *struct {
-		FakeService bool `inject:"config:commerce.product.fakeservice.enabled,optional"`
-		API         bool `inject:"config:commerce.product.api.enabled,optional"`
+		FakeService   bool   `inject:"config:commerce.product.fakeservice.enabled,optional"`
+		API           bool   `inject:"config:commerce.product.api.enabled,optional"`
+		AlertsEnabled bool   `inject:"config:commerce.product.alerts.enabled,optional"`
+		AlertStore    string `inject:"config:commerce.product.alerts.store,optional"`
 	},
 ) *Module {
 	if cfg != nil {
 		m.api = cfg.API
 		m.fakeService = cfg.FakeService
+		m.alertsEnabled = cfg.AlertsEnabled
+		m.alertStore = cfg.AlertStore
 	}
 
 	return m
@@ -52,6 +61,18 @@ func (m *Module) Configure(injector *dingo.Injector) {
 		injector.Bind((*domain.SearchService)(nil)).To(fake.SearchService{})
 	}
 
+	if m.alertStore == "memory" {
+		injector.Bind(new(alertDomain.Store)).To(alert.Memory{}).In(dingo.Singleton)
+	}
+
+	if m.alertsEnabled {
+		injector.Bind(new(application.AlertChecker)).In(dingo.Singleton)
+		flamingo.BindEventSubscriber(injector).To(new(application.AlertChecker))
+		flamingo.BindEventSubscriber(injector).To(application.AlertMailer{})
+		if m.api {
+			web.BindRoutes(injector, new(alertAPIRoutes))
+		}
+	}
 }
 
 // Depends adds our dependencies
@@ -80,6 +101,12 @@ commerce: {
 		api: {
 			enabled: bool | *true
 		}
+		alerts: {
+			enabled:       bool | *false
+			store:         *"memory" | "custom"
+			checkInterval: number | *900
+			tokenSecret:   string | *""
+		}
 		pagination: defaultPageSize: number | *commerce.pagination.defaultPageSize
 	}
 }`
@@ -113,3 +140,23 @@ func (r *apiRoutes) Routes(registry *web.RouterRegistry) {
 	registry.Route("/api/v1/products/:marketplacecode", "products.api.get")
 	registry.HandleGet("products.api.get", r.apiController.Get)
 }
+
+type alertAPIRoutes struct {
+	alertAPIController *controller.AlertAPIController
+}
+
+func (r *alertAPIRoutes) Inject(alertAPIController *controller.AlertAPIController) {
+	r.alertAPIController = alertAPIController
+}
+
+func (r *alertAPIRoutes) Routes(registry *web.RouterRegistry) {
+	registry.Route("/api/v1/products/:marketplacecode/alerts", "products.api.alerts")
+	registry.HandleGet("products.api.alerts", r.alertAPIController.Get)
+	registry.HandlePut("products.api.alerts", r.alertAPIController.Subscribe)
+	registry.HandleDelete("products.api.alerts", r.alertAPIController.Unsubscribe)
+
+	registry.Route("/api/v1/product-alerts/:subscriptionID", "products.api.alerts.subscription")
+	registry.HandleDelete("products.api.alerts.subscription", r.alertAPIController.UnsubscribeWithToken)
+	registry.Route("/api/v1/product-alerts/:subscriptionID/confirm", "products.api.alerts.confirm")
+	registry.HandlePost("products.api.alerts.confirm", r.alertAPIController.Confirm)
+}
@@ -73,11 +73,14 @@ func (s *Service) GetAvailableSources(ctx context.Context, session *web.Session,
 		return nil, errors.New("no product given for GetAvailableSources")
 	}
 
-	deliveryInfo, _, err := s.getDeliveryInfo(ctx, session, deliveryCode)
+	deliveryInfo, decoratedCart, err := s.getDeliveryInfo(ctx, session, deliveryCode)
 	if err != nil {
 		return nil, err
 	}
 
+	// stock held by the current cart is available for it
+	ctx = domain.ContextWithReservingCartID(ctx, decoratedCart.Cart.ID)
+
 	return s.sourcingService.GetAvailableSources(ctx, product, deliveryInfo, nil)
 }
 
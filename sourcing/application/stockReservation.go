@@ -0,0 +1,163 @@
+package application
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"flamingo.me/flamingo/v3/framework/web"
+
+	cartApplication "flamingo.me/flamingo-commerce/v3/cart/application"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
+	"flamingo.me/flamingo-commerce/v3/cart/domain/events"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+	"flamingo.me/flamingo-commerce/v3/sourcing/domain"
+)
+
+type (
+	// StockReservationService holds the allocated stock of carts in the StockReservationStore
+	StockReservationService struct {
+		logger           flamingo.Logger
+		sourcingService  domain.SourcingService
+		reservationStore domain.StockReservationStore
+		holdLifetime     time.Duration
+	}
+
+	// StockReservationEventSubscriber keeps the stock holds in sync with the cart
+	StockReservationEventSubscriber struct {
+		logger                  flamingo.Logger
+		stockReservationService *StockReservationService
+		cartReceiverService     *cartApplication.CartReceiverService
+	}
+)
+
+// Inject dependencies
+func (s *StockReservationService) Inject(
+	logger flamingo.Logger,
+	sourcingService domain.SourcingService,
+	reservationStore domain.StockReservationStore,
+	config *struct {
+		HoldLifetime float64 `inject:"config:commerce.sourcing.stockReservation.holdLifetime"` // in seconds
+	},
+) *StockReservationService {
+	s.logger = logger.WithField(flamingo.LogKeyModule, "sourcing").WithField(flamingo.LogKeyCategory, "StockReservationService")
+	s.sourcingService = sourcingService
+	s.reservationStore = reservationStore
+	if config != nil {
+		s.holdLifetime = time.Duration(config.HoldLifetime) * time.Second
+	}
+
+	return s
+}
+
+// HoldCart allocates the items of the cart and replaces the holds of the cart with the allocated qtys,
+// all holds of the cart are valid for the configured lifetime again
+func (s *StockReservationService) HoldCart(ctx context.Context, decoratedCart *decorator.DecoratedCart) error {
+	if decoratedCart == nil {
+		return nil
+	}
+
+	cartID := decoratedCart.Cart.ID
+	if len(decoratedCart.GetAllDecoratedItems()) == 0 {
+		return s.reservationStore.ReleaseCart(ctx, cartID)
+	}
+
+	allocations, err := s.sourcingService.AllocateItems(ctx, decoratedCart)
+	if err != nil {
+		return err
+	}
+
+	expiresAt := time.Now().Add(s.holdLifetime)
+	var holds []domain.StockHold
+	for _, decoratedItem := range decoratedCart.GetAllDecoratedItems() {
+		allocation, found := allocations[domain.ItemID(decoratedItem.Item.ID)]
+		if !found || len(allocation.AllocatedQtys) == 0 || decoratedItem.Product == nil {
+			continue
+		}
+
+		holds = append(holds, domain.StockHold{
+			CartID:        cartID,
+			ItemID:        decoratedItem.Item.ID,
+			ProductID:     decoratedItem.Product.GetIdentifier(),
+			AllocatedQtys: allocation.AllocatedQtys,
+			ExpiresAt:     expiresAt,
+		})
+	}
+
+	return s.reservationStore.HoldCart(ctx, cartID, holds)
+}
+
+// ExtendHolds keeps the holds of the cart for the configured lifetime, e.g. during checkout
+func (s *StockReservationService) ExtendHolds(ctx context.Context, cartID string) error {
+	return s.reservationStore.Extend(ctx, cartID, time.Now().Add(s.holdLifetime))
+}
+
+// ReleaseHolds frees the stock held by the cart
+func (s *StockReservationService) ReleaseHolds(ctx context.Context, cartID string) error {
+	return s.reservationStore.ReleaseCart(ctx, cartID)
+}
+
+// ConvertHolds hands over the stock held by the cart to the placed order
+func (s *StockReservationService) ConvertHolds(ctx context.Context, cartID string, orderReference string) error {
+	return s.reservationStore.Convert(ctx, cartID, orderReference)
+}
+
+// Inject dependencies
+func (e *StockReservationEventSubscriber) Inject(
+	logger flamingo.Logger,
+	stockReservationService *StockReservationService,
+	cartReceiverService *cartApplication.CartReceiverService,
+) *StockReservationEventSubscriber {
+	e.logger = logger.WithField(flamingo.LogKeyModule, "sourcing").WithField(flamingo.LogKeyCategory, "StockReservationEventSubscriber")
+	e.stockReservationService = stockReservationService
+	e.cartReceiverService = cartReceiverService
+
+	return e
+}
+
+// Notify should get called by flamingo Eventlogic
+func (e *StockReservationEventSubscriber) Notify(ctx context.Context, event flamingo.Event) {
+	var err error
+	switch currentEvent := event.(type) {
+	case *events.AddToCartEvent, *events.ChangedQtyInCartEvent:
+		// the events may contain an outdated cart, e.g. when all items are deleted, so the current cart is used
+		session := web.SessionFromContext(ctx)
+		if session == nil {
+			return
+		}
+
+		var decoratedCart *decorator.DecoratedCart
+		decoratedCart, err = e.cartReceiverService.ViewDecoratedCart(ctx, session)
+		if err == nil {
+			err = e.stockReservationService.HoldCart(ctx, decoratedCart)
+		}
+	case *events.OrderPlacedEvent:
+		if currentEvent.Cart == nil {
+			return
+		}
+
+		orderNumbers := make([]string, 0, len(currentEvent.PlacedOrderInfos))
+		for _, placedOrderInfo := range currentEvent.PlacedOrderInfos {
+			orderNumbers = append(orderNumbers, placedOrderInfo.OrderNumber)
+		}
+		err = e.stockReservationService.ConvertHolds(ctx, currentEvent.Cart.ID, strings.Join(orderNumbers, ","))
+	case *process.StateChangedEvent:
+		// the holds last as long as the checkout of the cart proceeds, a failed checkout frees the stock
+		cartID := currentEvent.Context.Cart.ID
+		if cartID == "" {
+			return
+		}
+
+		if currentEvent.Context.FailedReason != nil {
+			err = e.stockReservationService.ReleaseHolds(ctx, cartID)
+			break
+		}
+
+		err = e.stockReservationService.ExtendHolds(ctx, cartID)
+	}
+
+	if err != nil {
+		e.logger.WithContext(ctx).Error("stock holds not updated: ", err)
+	}
+}
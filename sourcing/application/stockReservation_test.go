@@ -0,0 +1,71 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"flamingo.me/flamingo/v3/framework/flamingo"
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
+	"flamingo.me/flamingo-commerce/v3/checkout/domain/placeorder/process"
+	"flamingo.me/flamingo-commerce/v3/sourcing/application"
+	"flamingo.me/flamingo-commerce/v3/sourcing/domain"
+)
+
+type stockReservationStoreRecorder struct {
+	extended []string
+	released []string
+}
+
+var _ domain.StockReservationStore = new(stockReservationStoreRecorder)
+
+func (s *stockReservationStoreRecorder) HoldCart(context.Context, string, []domain.StockHold) error {
+	return nil
+}
+
+func (s *stockReservationStoreRecorder) Extend(_ context.Context, cartID string, _ time.Time) error {
+	s.extended = append(s.extended, cartID)
+	return nil
+}
+
+func (s *stockReservationStoreRecorder) ReleaseCart(_ context.Context, cartID string) error {
+	s.released = append(s.released, cartID)
+	return nil
+}
+
+func (s *stockReservationStoreRecorder) Convert(context.Context, string, string) error {
+	return nil
+}
+
+func (s *stockReservationStoreRecorder) HeldQtys(context.Context, string, string) (domain.AllocatedQtys, error) {
+	return nil, nil
+}
+
+func TestStockReservationEventSubscriber_PlaceOrderStateChanged(t *testing.T) {
+	store := new(stockReservationStoreRecorder)
+	stockReservationService := new(application.StockReservationService).Inject(flamingo.NullLogger{}, nil, store, nil)
+	subscriber := new(application.StockReservationEventSubscriber).Inject(flamingo.NullLogger{}, stockReservationService, nil)
+
+	subscriber.Notify(context.Background(), &process.StateChangedEvent{
+		FromState: "New",
+		Context:   process.Context{CurrentStateName: "ValidateCart", Cart: cart.Cart{ID: "checkout-cart"}},
+	})
+	assert.Equal(t, []string{"checkout-cart"}, store.extended)
+	assert.Empty(t, store.released)
+
+	subscriber.Notify(context.Background(), &process.StateChangedEvent{
+		FromState: "ValidateCart",
+		Context: process.Context{
+			CurrentStateName: "Failed",
+			Cart:             cart.Cart{ID: "checkout-cart"},
+			FailedReason:     process.ErrorOccurredReason{Error: "failed"},
+		},
+	})
+	assert.Equal(t, []string{"checkout-cart"}, store.extended)
+	assert.Equal(t, []string{"checkout-cart"}, store.released)
+
+	subscriber.Notify(context.Background(), &process.StateChangedEvent{FromState: "New"})
+	assert.Len(t, store.extended, 1, "processes without cart are ignored")
+}
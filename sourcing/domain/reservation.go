@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// StockReservationStore is an optional secondary port that holds the allocated stock of carts for a limited time,
+	// if bound the DefaultSourcingService deducts the holds of other carts from the available stock
+	StockReservationStore interface {
+		// HoldCart replaces all holds of the cart with the given holds
+		HoldCart(ctx context.Context, cartID string, holds []StockHold) error
+		// Extend sets the expiry of all holds of the cart
+		Extend(ctx context.Context, cartID string, expiresAt time.Time) error
+		// ReleaseCart removes all holds of the cart
+		ReleaseCart(ctx context.Context, cartID string) error
+		// Convert hands over the holds of the cart to the placed order, afterwards the cart holds no stock anymore
+		Convert(ctx context.Context, cartID string, orderReference string) error
+		// HeldQtys returns the held qty per source of the product of all not expired holds, except the holds of the excluded cart
+		HeldQtys(ctx context.Context, productID string, excludedCartID string) (AllocatedQtys, error)
+	}
+
+	// StockHold is the reserved stock of a cart item
+	StockHold struct {
+		CartID        string
+		ItemID        string
+		ProductID     string
+		AllocatedQtys AllocatedQtys
+		ExpiresAt     time.Time
+	}
+
+	reservingCartIDKey struct{}
+)
+
+// ContextWithReservingCartID returns a context that excludes the holds of the given cart in GetAvailableSources without cart
+func ContextWithReservingCartID(ctx context.Context, cartID string) context.Context {
+	return context.WithValue(ctx, reservingCartIDKey{}, cartID)
+}
+
+// reservingCartID returns the cart id stored by ContextWithReservingCartID
+func reservingCartID(ctx context.Context) string {
+	cartID, _ := ctx.Value(reservingCartIDKey{}).(string)
+
+	return cartID
+}
+
+// IsExpired returns true if the hold is not valid anymore
+func (h StockHold) IsExpired(now time.Time) bool {
+	return !h.ExpiresAt.IsZero() && !now.Before(h.ExpiresAt)
+}
@@ -63,6 +63,7 @@ type (
 		availableSourcesProvider AvailableSourcesProvider
 		stockProvider            StockProvider
 		logger                   flamingo.Logger
+		stockReservationStore    StockReservationStore
 	}
 
 	// AvailableSourcesProvider interface for DefaultSourcingService
@@ -95,6 +96,8 @@ func (d *DefaultSourcingService) Inject(
 	dep *struct {
 		AvailableSourcesProvider AvailableSourcesProvider `inject:",optional"`
 		StockProvider            StockProvider            `inject:",optional"`
+		// StockReservationStore is optional, if bound the stock held by other carts is not available
+		StockReservationStore StockReservationStore `inject:",optional"`
 	},
 ) *DefaultSourcingService {
 	d.logger = logger.WithField(flamingo.LogKeyModule, "sourcing").WithField(flamingo.LogKeyCategory, "DefaultSourcingService")
@@ -102,6 +105,7 @@ func (d *DefaultSourcingService) Inject(
 	if dep != nil {
 		d.availableSourcesProvider = dep.AvailableSourcesProvider
 		d.stockProvider = dep.StockProvider
+		d.stockReservationStore = dep.StockReservationStore
 	}
 
 	return d
@@ -133,6 +137,13 @@ func (d *DefaultSourcingService) GetAvailableSources(ctx context.Context, produc
 		}
 	}
 
+	// deduct the stock held by other carts
+	cartID := reservingCartID(ctx)
+	if decoratedCart != nil {
+		cartID = decoratedCart.Cart.ID
+	}
+	availableSources = availableSources.Reduce(d.heldQtys(ctx, product.GetIdentifier(), cartID))
+
 	// if a cart is given we need to deduct the possible allocated items in the cart
 	if decoratedCart != nil {
 		allocatedSources, err := d.AllocateItems(ctx, decoratedCart)
@@ -181,7 +192,7 @@ func (d *DefaultSourcingService) AllocateItems(ctx context.Context, decoratedCar
 	for _, delivery := range decoratedCart.DecoratedDeliveries {
 		for _, decoratedItem := range delivery.DecoratedItems {
 			var itemAllocation ItemAllocation
-			itemAllocation, productSourcestock = d.allocateItem(ctx, productSourcestock, decoratedItem, delivery.Delivery.DeliveryInfo)
+			itemAllocation, productSourcestock = d.allocateItem(ctx, productSourcestock, decoratedItem, delivery.Delivery.DeliveryInfo, decoratedCart.Cart.ID)
 			resultItemAllocations[ItemID(decoratedItem.Item.ID)] = itemAllocation
 		}
 	}
@@ -214,7 +225,7 @@ func getItemIdsWithProduct(dc *decorator.DecoratedCart, product domain.BasicProd
 
 // allocateItem returns the itemAllocation and the remaining stock for the given item.
 // The passed productSourcestock is used - and the remaining productSourcestock is returned. In case a source is not yet given in productSourcestock it will be fetched
-func (d *DefaultSourcingService) allocateItem(ctx context.Context, productSourcestock map[string]map[Source]int, decoratedItem decorator.DecoratedCartItem, deliveryInfo cartDomain.DeliveryInfo) (ItemAllocation, map[string]map[Source]int) {
+func (d *DefaultSourcingService) allocateItem(ctx context.Context, productSourcestock map[string]map[Source]int, decoratedItem decorator.DecoratedCartItem, deliveryInfo cartDomain.DeliveryInfo, cartID string) (ItemAllocation, map[string]map[Source]int) {
 	var resultItemAllocation = ItemAllocation{
 		AllocatedQtys: make(AllocatedQtys),
 	}
@@ -246,6 +257,9 @@ func (d *DefaultSourcingService) allocateItem(ctx context.Context, productSource
 		productSourcestock[productID] = make(map[Source]int)
 	}
 
+	var heldQtys AllocatedQtys
+	heldQtysFetched := false
+
 	for _, source := range sources {
 		// if we have no stock given for source and productid we fetch it initially
 		if _, exists := remainingSourcestock[productID][source]; !exists {
@@ -254,6 +268,14 @@ func (d *DefaultSourcingService) allocateItem(ctx context.Context, productSource
 				d.logger.Error(err)
 				continue
 			}
+			// deduct the stock held by other carts
+			if !heldQtysFetched {
+				heldQtys = d.heldQtys(ctx, productID, cartID)
+				heldQtysFetched = true
+			}
+			if sourceStock -= heldQtys[source]; sourceStock < 0 {
+				sourceStock = 0
+			}
 			remainingSourcestock[productID][source] = sourceStock
 		}
 
@@ -283,6 +305,21 @@ func (d *DefaultSourcingService) allocateItem(ctx context.Context, productSource
 	return resultItemAllocation, remainingSourcestock
 }
 
+// heldQtys returns the stock of the product held by other carts than the given one, errors of the store are only logged
+func (d *DefaultSourcingService) heldQtys(ctx context.Context, productID string, excludedCartID string) AllocatedQtys {
+	if d.stockReservationStore == nil {
+		return nil
+	}
+
+	heldQtys, err := d.stockReservationStore.HeldQtys(ctx, productID, excludedCartID)
+	if err != nil {
+		d.logger.WithContext(ctx).Error(err)
+		return nil
+	}
+
+	return heldQtys
+}
+
 // QtySum returns the sum of all sourced items
 func (s AvailableSources) QtySum() int {
 	qty := 0
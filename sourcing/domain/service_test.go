@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"flamingo.me/flamingo-commerce/v3/cart/domain/cart"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/decorator"
@@ -70,6 +71,7 @@ func TestDefaultSourcingService_GetAvailableSources(t *testing.T) {
 		sourcingService.Inject(flamingo.NullLogger{}, &struct {
 			AvailableSourcesProvider domain.AvailableSourcesProvider `inject:",optional"`
 			StockProvider            domain.StockProvider            `inject:",optional"`
+			StockReservationStore    domain.StockReservationStore    `inject:",optional"`
 		}{
 			AvailableSourcesProvider: availableSourcesProviderMock{
 				Sources: nil,
@@ -255,16 +257,98 @@ func TestDefaultSourcingService_AllocateItems(t *testing.T) {
 }
 
 func newDefaultSourcingService(stockProvider domain.StockProvider, expectedSources []domain.Source) domain.DefaultSourcingService {
+	return newReservingSourcingService(stockProvider, expectedSources, nil)
+}
+
+func newReservingSourcingService(stockProvider domain.StockProvider, expectedSources []domain.Source, store domain.StockReservationStore) domain.DefaultSourcingService {
 	sourcingService := domain.DefaultSourcingService{}
 	availableSourcesProviderMock := availableSourcesProviderMock{Sources: expectedSources}
 
 	sourcingService.Inject(flamingo.NullLogger{}, &struct {
 		AvailableSourcesProvider domain.AvailableSourcesProvider `inject:",optional"`
 		StockProvider            domain.StockProvider            `inject:",optional"`
+		StockReservationStore    domain.StockReservationStore    `inject:",optional"`
 	}{
 		StockProvider:            stockProvider,
 		AvailableSourcesProvider: availableSourcesProviderMock,
+		StockReservationStore:    store,
 	})
 
 	return sourcingService
 }
+
+type stockReservationStoreMock struct {
+	// [productID] = held qtys of other carts
+	Held            map[string]domain.AllocatedQtys
+	ExcludedCartIDs []string
+}
+
+var _ domain.StockReservationStore = new(stockReservationStoreMock)
+
+func (s *stockReservationStoreMock) HoldCart(context.Context, string, []domain.StockHold) error {
+	return nil
+}
+
+func (s *stockReservationStoreMock) Extend(context.Context, string, time.Time) error {
+	return nil
+}
+
+func (s *stockReservationStoreMock) ReleaseCart(context.Context, string) error {
+	return nil
+}
+
+func (s *stockReservationStoreMock) Convert(context.Context, string, string) error {
+	return nil
+}
+
+func (s *stockReservationStoreMock) HeldQtys(_ context.Context, productID string, excludedCartID string) (domain.AllocatedQtys, error) {
+	s.ExcludedCartIDs = append(s.ExcludedCartIDs, excludedCartID)
+	return s.Held[productID], nil
+}
+
+func TestDefaultSourcingService_StockReservations(t *testing.T) {
+	source1 := domain.Source{LocationCode: "Source1"}
+	source2 := domain.Source{LocationCode: "Source2"}
+	stubbedProduct := productDomain.SimpleProduct{Identifier: "product1"}
+
+	t.Run("available sources are reduced by the holds of other carts", func(t *testing.T) {
+		store := &stockReservationStoreMock{Held: map[string]domain.AllocatedQtys{"product1": {source1: 4, source2: 10}}}
+		sourcingService := newReservingSourcingService(stockProviderMock{Qty: 10}, []domain.Source{source1, source2}, store)
+
+		ctx := domain.ContextWithReservingCartID(context.Background(), "own-cart")
+		sources, err := sourcingService.GetAvailableSources(ctx, stubbedProduct, nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.AvailableSources{source1: 6}, sources)
+		assert.Equal(t, []string{"own-cart"}, store.ExcludedCartIDs)
+	})
+
+	t.Run("allocation uses only stock not held by other carts", func(t *testing.T) {
+		store := &stockReservationStoreMock{Held: map[string]domain.AllocatedQtys{"product1": {source1: 8}}}
+		sourcingService := newReservingSourcingService(stockProviderMock{Qty: 10}, []domain.Source{source1, source2}, store)
+
+		testCart := &decorator.DecoratedCart{
+			Cart: cart.Cart{ID: "own-cart"},
+			DecoratedDeliveries: []decorator.DecoratedDelivery{
+				{
+					DecoratedItems: []decorator.DecoratedCartItem{
+						{
+							Product: stubbedProduct,
+							Item:    cart.Item{Qty: 12, ID: "item1"},
+						},
+					},
+				},
+			},
+		}
+
+		allocations, err := sourcingService.AllocateItems(context.Background(), testCart)
+		assert.NoError(t, err)
+		assert.NoError(t, allocations["item1"].Error)
+		assert.Equal(t, domain.AllocatedQtys{source1: 2, source2: 10}, allocations["item1"].AllocatedQtys)
+		assert.Equal(t, []string{"own-cart"}, store.ExcludedCartIDs)
+
+		testCart.DecoratedDeliveries[0].DecoratedItems[0].Item.Qty = 13
+		allocations, err = sourcingService.AllocateItems(context.Background(), testCart)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ErrInsufficientSourceQty, allocations["item1"].Error)
+	})
+}
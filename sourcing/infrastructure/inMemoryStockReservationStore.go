@@ -0,0 +1,116 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"flamingo.me/flamingo-commerce/v3/sourcing/domain"
+)
+
+type (
+	// InMemoryStockReservationStore keeps the stock holds in memory, useful for single instance setups and development
+	InMemoryStockReservationStore struct {
+		mx    sync.RWMutex
+		holds map[string][]domain.StockHold
+		now   func() time.Time
+	}
+)
+
+var _ domain.StockReservationStore = new(InMemoryStockReservationStore)
+
+// Inject dependencies
+func (s *InMemoryStockReservationStore) Inject() *InMemoryStockReservationStore {
+	s.holds = make(map[string][]domain.StockHold)
+	s.now = time.Now
+
+	return s
+}
+
+// HoldCart replaces all holds of the cart, expired holds of other carts are removed
+func (s *InMemoryStockReservationStore) HoldCart(_ context.Context, cartID string, holds []domain.StockHold) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.removeExpired()
+	if len(holds) == 0 {
+		delete(s.holds, cartID)
+		return nil
+	}
+
+	s.holds[cartID] = holds
+
+	return nil
+}
+
+// Extend sets the expiry of all holds of the cart
+func (s *InMemoryStockReservationStore) Extend(_ context.Context, cartID string, expiresAt time.Time) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	for i := range s.holds[cartID] {
+		s.holds[cartID][i].ExpiresAt = expiresAt
+	}
+
+	return nil
+}
+
+// ReleaseCart removes all holds of the cart
+func (s *InMemoryStockReservationStore) ReleaseCart(_ context.Context, cartID string) error {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	delete(s.holds, cartID)
+
+	return nil
+}
+
+// Convert removes the holds of the cart, the placed order is expected to be deducted by the StockProvider
+func (s *InMemoryStockReservationStore) Convert(ctx context.Context, cartID string, _ string) error {
+	return s.ReleaseCart(ctx, cartID)
+}
+
+// HeldQtys sums the not expired holds of the product per source, except the holds of the excluded cart
+func (s *InMemoryStockReservationStore) HeldQtys(_ context.Context, productID string, excludedCartID string) (domain.AllocatedQtys, error) {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	now := s.now()
+	result := make(domain.AllocatedQtys)
+	for cartID, holds := range s.holds {
+		if cartID == excludedCartID {
+			continue
+		}
+
+		for _, hold := range holds {
+			if hold.ProductID != productID || hold.IsExpired(now) {
+				continue
+			}
+
+			for source, qty := range hold.AllocatedQtys {
+				result[source] += qty
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// removeExpired deletes all expired holds, the caller must hold the lock
+func (s *InMemoryStockReservationStore) removeExpired() {
+	now := s.now()
+	for cartID, holds := range s.holds {
+		active := holds[:0]
+		for _, hold := range holds {
+			if !hold.IsExpired(now) {
+				active = append(active, hold)
+			}
+		}
+
+		if len(active) == 0 {
+			delete(s.holds, cartID)
+			continue
+		}
+		s.holds[cartID] = active
+	}
+}
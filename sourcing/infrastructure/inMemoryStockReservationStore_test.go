@@ -0,0 +1,57 @@
+package infrastructure_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"flamingo.me/flamingo-commerce/v3/sourcing/domain"
+	"flamingo.me/flamingo-commerce/v3/sourcing/infrastructure"
+)
+
+func TestInMemoryStockReservationStore(t *testing.T) {
+	ctx := context.Background()
+	source := domain.Source{LocationCode: "loc1"}
+	hold := func(cartID string, qty int, expiresAt time.Time) domain.StockHold {
+		return domain.StockHold{CartID: cartID, ItemID: "item", ProductID: "product", AllocatedQtys: domain.AllocatedQtys{source: qty}, ExpiresAt: expiresAt}
+	}
+
+	t.Run("holds of other carts are summed, expired holds are ignored", func(t *testing.T) {
+		store := new(infrastructure.InMemoryStockReservationStore).Inject()
+		assert.NoError(t, store.HoldCart(ctx, "cart1", []domain.StockHold{hold("cart1", 2, time.Now().Add(time.Minute))}))
+		assert.NoError(t, store.HoldCart(ctx, "cart2", []domain.StockHold{hold("cart2", 3, time.Now().Add(time.Minute))}))
+		assert.NoError(t, store.HoldCart(ctx, "cart3", []domain.StockHold{hold("cart3", 5, time.Now().Add(-time.Minute))}))
+
+		held, err := store.HeldQtys(ctx, "product", "cart1")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.AllocatedQtys{source: 3}, held)
+
+		held, err = store.HeldQtys(ctx, "other-product", "")
+		assert.NoError(t, err)
+		assert.Empty(t, held)
+	})
+
+	t.Run("extend, release and convert", func(t *testing.T) {
+		store := new(infrastructure.InMemoryStockReservationStore).Inject()
+		assert.NoError(t, store.HoldCart(ctx, "cart1", []domain.StockHold{hold("cart1", 2, time.Now().Add(time.Minute))}))
+		assert.NoError(t, store.HoldCart(ctx, "cart2", []domain.StockHold{hold("cart2", 3, time.Now().Add(time.Minute))}))
+
+		assert.NoError(t, store.Extend(ctx, "cart1", time.Now().Add(-time.Minute)))
+		held, _ := store.HeldQtys(ctx, "product", "")
+		assert.Equal(t, domain.AllocatedQtys{source: 3}, held)
+
+		assert.NoError(t, store.Extend(ctx, "cart1", time.Now().Add(time.Minute)))
+		held, _ = store.HeldQtys(ctx, "product", "")
+		assert.Equal(t, domain.AllocatedQtys{source: 5}, held)
+
+		assert.NoError(t, store.ReleaseCart(ctx, "cart1"))
+		held, _ = store.HeldQtys(ctx, "product", "")
+		assert.Equal(t, domain.AllocatedQtys{source: 3}, held)
+
+		assert.NoError(t, store.Convert(ctx, "cart2", "order-1"))
+		held, _ = store.HeldQtys(ctx, "product", "")
+		assert.Empty(t, held)
+	})
+}
@@ -4,10 +4,12 @@ import (
 	"flamingo.me/dingo"
 	"flamingo.me/flamingo-commerce/v3/cart/domain/validation"
 	restrictors "flamingo.me/flamingo-commerce/v3/sourcing/domain/restrictor"
+	"flamingo.me/flamingo/v3/framework/flamingo"
 
 	"flamingo.me/flamingo-commerce/v3/cart"
 	"flamingo.me/flamingo-commerce/v3/sourcing/application"
 	"flamingo.me/flamingo-commerce/v3/sourcing/domain"
+	"flamingo.me/flamingo-commerce/v3/sourcing/infrastructure"
 )
 
 type (
@@ -15,6 +17,7 @@ type (
 	Module struct {
 		useDefaultSourcingService bool
 		enableQtyRestrictor       bool
+		enableStockReservation    bool
 	}
 )
 
@@ -23,12 +26,14 @@ func (m *Module) Inject(
 	config *struct {
 		UseDefaultSourcingService bool `inject:"config:commerce.sourcing.useDefaultSourcingService,optional"`
 		EnableQtyRestrictor       bool `inject:"config:commerce.sourcing.enableQtyRestrictor,optional"`
+		EnableStockReservation    bool `inject:"config:commerce.sourcing.stockReservation.enabled,optional"`
 	},
 ) {
 
 	if config != nil {
 		m.useDefaultSourcingService = config.UseDefaultSourcingService
 		m.enableQtyRestrictor = config.EnableQtyRestrictor
+		m.enableStockReservation = config.EnableStockReservation
 	}
 
 }
@@ -43,6 +48,11 @@ func (m *Module) Configure(injector *dingo.Injector) {
 		injector.Bind(new(validation.MaxQuantityRestrictor)).To(restrictors.Restrictor{})
 	}
 
+	if m.enableStockReservation {
+		injector.Bind(new(domain.StockReservationStore)).To(infrastructure.InMemoryStockReservationStore{}).In(dingo.Singleton)
+		flamingo.BindEventSubscriber(injector).To(application.StockReservationEventSubscriber{})
+	}
+
 	injector.Bind(new(application.SourcingApplication)).To(application.Service{})
 }
 
@@ -60,6 +70,10 @@ commerce: {
 	sourcing: {
 		useDefaultSourcingService: bool | *true
 		enableQtyRestrictor: bool | *false
+		stockReservation: {
+			enabled: bool | *false
+			holdLifetime: number | *900
+		}
 	}
 }
 `
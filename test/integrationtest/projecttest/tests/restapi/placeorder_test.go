@@ -73,3 +73,59 @@ func Test_Checkout_SimplePlaceOrderProcess(t *testing.T) {
 	response.Status(200).JSON().Object().Value("FailedReason").String().Equal("")
 	response.Status(200).JSON().Object().Value("State").String().Equal(states.Success{}.Name())
 }
+
+func Test_Checkout_BuyNow(t *testing.T) {
+	e := integrationtest.NewHTTPExpect(t, "http://"+FlamingoURL)
+	// add something to the cart of the session
+	response := e.POST("/api/v1/cart/delivery/delivery/additem").WithQuery("deliveryCode", "delivery").WithQuery("marketplaceCode", "fake_simple").Expect()
+	response.Status(200).JSON().Object().Value("Success").Boolean().Equal(true)
+
+	response = e.GET("/api/v1/cart").Expect()
+	cartID := response.Status(200).JSON().Object().Value("Cart").Object().Value("ID").String().Raw()
+
+	// buy another qty of the product with an express cart
+	response = e.PUT("/api/v1/checkout/buynow").WithQuery("returnURL", "http://www.example.org").
+		WithFormField("marketplaceCode", "fake_simple").
+		WithFormField("qty", "2").
+		WithFormField("deliveryCode", "delivery").
+		WithFormField("workflow", "delivery").
+		WithFormField("delivery.deliveryAddress.firstname", "Max").
+		WithFormField("delivery.deliveryAddress.lastname", "Mustermann").
+		WithFormField("delivery.deliveryAddress.email", "test@test.de").
+		WithFormField("billingAddress.firstname", "Max").
+		WithFormField("billingAddress.lastname", "Mustermann").
+		WithFormField("billingAddress.email", "test@test.de").
+		WithFormField("paymentGateway", "fake_payment_gateway").
+		WithFormField("paymentMethod", "payment_completed").
+		Expect()
+	uuid := response.Status(201).JSON().Object().Value("UUID").String().NotEmpty().Raw()
+
+	// the cart of the session stays untouched while the express checkout runs
+	response = e.GET("/api/v1/cart").Expect()
+	cart := response.Status(200).JSON().Object().Value("Cart").Object()
+	cart.Value("ID").String().Equal(cartID)
+	cart.Value("Deliveries").Array().Element(0).Object().Value("Cartitems").Array().Element(0).Object().Value("Qty").Number().Equal(1)
+
+	// a regular add to cart doesn't reach the express cart
+	response = e.POST("/api/v1/cart/delivery/delivery/additem").WithQuery("deliveryCode", "delivery").WithQuery("marketplaceCode", "fake_simple_with_fixed_price").Expect()
+	response.Status(200).JSON().Object().Value("Success").Boolean().Equal(true)
+
+	// refresh place order
+	response = e.POST("/api/v1/checkout/placeorder/refreshblocking").Expect()
+	response.Status(200).JSON().Object().Value("State").String().NotEmpty()
+
+	// get last place order context
+	response = e.GET("/api/v1/checkout/placeorder").Expect()
+	response.Status(200).JSON().Object().Value("UUID").String().Equal(uuid)
+	response.Status(200).JSON().Object().Value("FailedReason").String().Equal("")
+	response.Status(200).JSON().Object().Value("State").String().Equal(states.Success{}.Name())
+	expressItems := response.Status(200).JSON().Object().Value("Cart").Object().Value("Deliveries").Array().Element(0).Object().Value("Cartitems").Array()
+	expressItems.Length().Equal(1)
+	expressItems.Element(0).Object().Value("Qty").Number().Equal(2)
+
+	// the cart of the session is still there with all items added in the meantime
+	response = e.GET("/api/v1/cart").Expect()
+	cart = response.Status(200).JSON().Object().Value("Cart").Object()
+	cart.Value("ID").String().Equal(cartID)
+	cart.Value("Deliveries").Array().Element(0).Object().Value("Cartitems").Array().Length().Equal(2)
+}
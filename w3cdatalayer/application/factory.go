@@ -259,6 +259,12 @@ func (s Factory) BuildTransactionData(ctx context.Context, cart decorator.Decora
 		itemData := s.buildCartItem(item, cart.Cart.GrandTotal().Currency())
 		transactionData.Item = append(transactionData.Item, itemData)
 	}
+	for _, earning := range cart.LoyaltyEarnings().Totals {
+		transactionData.LoyaltyEarnings = append(transactionData.LoyaltyEarnings, domain.LoyaltyEarning{
+			Type:   earning.Type,
+			Points: earning.Earning.FloatAmount(),
+		})
+	}
 	return &transactionData
 }
 
@@ -165,11 +165,18 @@ type (
 
 	// Transaction struct
 	Transaction struct {
-		TransactionID string                 `json:"transactionID,omitempty"`
-		Profile       *UserProfile           `json:"profile,omitempty"`
-		Price         *TransactionPrice      `json:"total,omitempty"`
-		Item          []CartItem             `json:"item,omitempty"`
-		Attributes    map[string]interface{} `json:"attributes,omitempty"`
+		TransactionID   string                 `json:"transactionID,omitempty"`
+		Profile         *UserProfile           `json:"profile,omitempty"`
+		Price           *TransactionPrice      `json:"total,omitempty"`
+		Item            []CartItem             `json:"item,omitempty"`
+		LoyaltyEarnings []LoyaltyEarning       `json:"loyaltyEarnings,omitempty"`
+		Attributes      map[string]interface{} `json:"attributes,omitempty"`
+	}
+
+	// LoyaltyEarning struct - the points of a loyalty type earned with the transaction
+	LoyaltyEarning struct {
+		Type   string  `json:"type"`
+		Points float64 `json:"points"`
 	}
 
 	// TransactionPrice struct